@@ -0,0 +1,221 @@
+package chrono
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DatePrecision is how much of an ApproxDate is known
+type DatePrecision int
+
+// Date precisions
+const (
+	PrecisionDay DatePrecision = iota
+	PrecisionMonth
+	PrecisionYear
+	PrecisionDecade
+	PrecisionCentury
+)
+
+// String returns the name of the precision, eg. "month"
+func (d DatePrecision) String() string {
+	switch d {
+	case PrecisionDay:
+		return "day"
+	case PrecisionMonth:
+		return "month"
+	case PrecisionYear:
+		return "year"
+	case PrecisionDecade:
+		return "decade"
+	case PrecisionCentury:
+		return "century"
+	default:
+		return "unknown"
+	}
+}
+
+// ApproxDate is a date that is only known to a precision and may be
+// uncertain or approximate, as found in archival and genealogy records, eg.
+// "about 1850" or "some time in the 1960s".
+type ApproxDate struct {
+	// Date is the first day covered, eg. 1960-01-01 for the 1960s
+	Date      Date
+	Precision DatePrecision
+	// Uncertain is true when the source is unsure of the date (? in EDTF)
+	Uncertain bool
+	// Approximate is true when the date is an estimate (~ in EDTF)
+	Approximate bool
+}
+
+// ApproxDateFromEDTF parses a date in the Extended Date/Time Format (ISO
+// 8601-2) levels 0 and 1: 1985-04-12, 1985-04 and 1985, followed by ? for
+// uncertain, ~ for approximate or % for both, and with unspecified digits
+// like 196X (a decade), 19XX (a century), 1985-XX and 1985-04-XX. Years may
+// be negative. Intervals and seasons are not supported.
+func ApproxDateFromEDTF(str string) (ApproxDate, error) {
+	a, err := parseEDTF(str)
+	if err != nil {
+		return ApproxDate{}, &ParseError{Op: "parse", Kind: "edtf date", Input: str, Err: err}
+	}
+	return a, nil
+}
+
+func parseEDTF(str string) (ApproxDate, error) {
+	var a ApproxDate
+	if n := len(str); n > 0 {
+		switch str[n-1] {
+		case '?':
+			a.Uncertain = true
+		case '~':
+			a.Approximate = true
+		case '%':
+			a.Uncertain, a.Approximate = true, true
+		}
+		if a.Uncertain || a.Approximate {
+			str = str[:n-1]
+		}
+	}
+
+	neg := strings.HasPrefix(str, "-")
+	if neg {
+		str = str[1:]
+	}
+	parts := strings.Split(str, "-")
+	if len(parts) > 3 || len(parts[0]) != 4 {
+		return ApproxDate{}, errors.New("expected yyyy, yyyy-mm or yyyy-mm-dd")
+	}
+
+	// Unspecified digits must all be at the end
+	yearDigits := strings.TrimRight(parts[0], "X")
+	switch len(yearDigits) {
+	case 4:
+		a.Precision = PrecisionYear
+	case 3:
+		a.Precision = PrecisionDecade
+	case 2:
+		a.Precision = PrecisionCentury
+	default:
+		return ApproxDate{}, errors.New("too many unspecified year digits")
+	}
+	year, ok := atoiN(yearDigits, len(yearDigits))
+	if !ok {
+		return ApproxDate{}, errors.New("invalid year")
+	}
+	for i := len(yearDigits); i < 4; i++ {
+		year *= 10
+	}
+	if neg {
+		year = -year
+	}
+
+	month, day := 1, 1
+	if len(parts) > 1 {
+		var err error
+		if month, err = edtfComponent(parts[1], "month", a.Precision == PrecisionYear, 12); err != nil {
+			return ApproxDate{}, err
+		}
+		if month != 0 {
+			a.Precision = PrecisionMonth
+		} else {
+			month = 1
+		}
+	}
+	if len(parts) > 2 {
+		var err error
+		if day, err = edtfComponent(parts[2], "day", a.Precision == PrecisionMonth, daysIn(time.Month(month), year)); err != nil {
+			return ApproxDate{}, err
+		}
+		if day != 0 {
+			a.Precision = PrecisionDay
+		} else {
+			day = 1
+		}
+	}
+
+	a.Date = NewDate(year, time.Month(month), day)
+	return a, nil
+}
+
+// edtfComponent parses a two digit month or day, XX is returned as 0 and is
+// the only thing allowed when the previous component wasn't specified
+func edtfComponent(str, component string, allowed bool, max int) (int, error) {
+	if str == "XX" {
+		return 0, nil
+	}
+	v, ok := atoiN(str, 2)
+	if !ok || len(str) != 2 {
+		return 0, errors.New("expected two digits or XX")
+	}
+	if !allowed {
+		return 0, errors.New("specified component after an unspecified one")
+	}
+	if v < 1 || v > max {
+		return 0, &RangeError{Component: component, Value: v, Min: 1, Max: max}
+	}
+	return v, nil
+}
+
+// Earliest returns the first day the date could be, ignoring Uncertain and
+// Approximate
+func (a ApproxDate) Earliest() Date {
+	return a.Date
+}
+
+// Latest returns the last day the date could be, ignoring Uncertain and
+// Approximate
+func (a ApproxDate) Latest() Date {
+	switch a.Precision {
+	case PrecisionMonth:
+		return a.Date.AddDate(0, 1, -1)
+	case PrecisionYear:
+		return a.Date.AddDate(1, 0, -1)
+	case PrecisionDecade:
+		return a.Date.AddDate(10, 0, -1)
+	case PrecisionCentury:
+		return a.Date.AddDate(100, 0, -1)
+	default:
+		return a.Date
+	}
+}
+
+// String returns the date in EDTF, eg. 1985-04~ or 196X
+func (a ApproxDate) String() string {
+	year, month, day := a.Date.Date()
+	var b []byte
+	if year < 0 {
+		b = append(b, '-')
+		year = -year
+	}
+
+	yearStr := strconv.Itoa(year)
+	for len(yearStr) < 4 {
+		yearStr = "0" + yearStr
+	}
+	switch a.Precision {
+	case PrecisionDecade:
+		yearStr = yearStr[:len(yearStr)-1] + "X"
+	case PrecisionCentury:
+		yearStr = yearStr[:len(yearStr)-2] + "XX"
+	}
+	b = append(b, yearStr...)
+
+	if a.Precision <= PrecisionMonth {
+		b = appendInt2(append(b, '-'), int(month))
+	}
+	if a.Precision == PrecisionDay {
+		b = appendInt2(append(b, '-'), day)
+	}
+
+	switch {
+	case a.Uncertain && a.Approximate:
+		b = append(b, '%')
+	case a.Uncertain:
+		b = append(b, '?')
+	case a.Approximate:
+		b = append(b, '~')
+	}
+	return string(b)
+}
@@ -0,0 +1,64 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestApproxDateFromEDTF(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In        string
+		Precision chrono.DatePrecision
+		Earliest  chrono.Date
+		Latest    chrono.Date
+		Uncertain bool
+		Approx    bool
+		String    string
+	}{
+		{"1985-04-12", chrono.PrecisionDay, chrono.NewDate(1985, 4, 12), chrono.NewDate(1985, 4, 12), false, false, "1985-04-12"},
+		{"1985-04", chrono.PrecisionMonth, chrono.NewDate(1985, 4, 1), chrono.NewDate(1985, 4, 30), false, false, "1985-04"},
+		{"1985", chrono.PrecisionYear, chrono.NewDate(1985, 1, 1), chrono.NewDate(1985, 12, 31), false, false, "1985"},
+		{"1985?", chrono.PrecisionYear, chrono.NewDate(1985, 1, 1), chrono.NewDate(1985, 12, 31), true, false, "1985?"},
+		{"1850-02~", chrono.PrecisionMonth, chrono.NewDate(1850, 2, 1), chrono.NewDate(1850, 2, 28), false, true, "1850-02~"},
+		{"2004-06-11%", chrono.PrecisionDay, chrono.NewDate(2004, 6, 11), chrono.NewDate(2004, 6, 11), true, true, "2004-06-11%"},
+		{"196X", chrono.PrecisionDecade, chrono.NewDate(1960, 1, 1), chrono.NewDate(1969, 12, 31), false, false, "196X"},
+		{"19XX~", chrono.PrecisionCentury, chrono.NewDate(1900, 1, 1), chrono.NewDate(1999, 12, 31), false, true, "19XX~"},
+		{"1985-XX", chrono.PrecisionYear, chrono.NewDate(1985, 1, 1), chrono.NewDate(1985, 12, 31), false, false, "1985"},
+		{"1985-04-XX", chrono.PrecisionMonth, chrono.NewDate(1985, 4, 1), chrono.NewDate(1985, 4, 30), false, false, "1985-04"},
+		{"196X-XX-XX", chrono.PrecisionDecade, chrono.NewDate(1960, 1, 1), chrono.NewDate(1969, 12, 31), false, false, "196X"},
+		{"-0044-03-15", chrono.PrecisionDay, chrono.NewDate(-44, 3, 15), chrono.NewDate(-44, 3, 15), false, false, "-0044-03-15"},
+	}
+
+	for _, test := range tests {
+		got, err := chrono.ApproxDateFromEDTF(test.In)
+		if err != nil {
+			t.Errorf("%s: %v", test.In, err)
+			continue
+		}
+		if got.Precision != test.Precision {
+			t.Errorf("%s: want precision %s, got %s", test.In, test.Precision, got.Precision)
+		}
+		if got.Earliest() != test.Earliest || got.Latest() != test.Latest {
+			t.Errorf("%s: want %v to %v, got %v to %v", test.In, test.Earliest, test.Latest, got.Earliest(), got.Latest())
+		}
+		if got.Uncertain != test.Uncertain || got.Approximate != test.Approx {
+			t.Errorf("%s: wrong qualifiers %t %t", test.In, got.Uncertain, got.Approximate)
+		}
+		if s := got.String(); s != test.String {
+			t.Errorf("%s: want string %s, got %s", test.In, test.String, s)
+		}
+	}
+
+	bad := []string{"", "?", "85", "1985-4", "1985-13", "1985-02-30", "1XXX", "1985-XX-12", "19X5", "1985-04-12-01", "1985/1990"}
+	for _, in := range bad {
+		_, err := chrono.ApproxDateFromEDTF(in)
+		var perr *chrono.ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("%q: expected a parse error, got: %v", in, err)
+		}
+	}
+}
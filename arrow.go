@@ -0,0 +1,61 @@
+package chrono
+
+import "time"
+
+// The functions in this file convert whole slices to and from the primitive
+// representations used by columnar formats like Arrow (date32 is days since
+// the epoch, timestamp[us] is microseconds since the epoch). They append to
+// dst like AppendFormat so a buffer can be reused between batches, and size
+// it once up front so the loops themselves don't branch.
+
+// AppendEpochDays appends the days since 1970-01-01 of each date to dst
+func AppendEpochDays(dst []int32, dates []Date) []int32 {
+	dst, out := growSlice(dst, len(dates))
+	for i := range dates {
+		out[i] = int32(dates[i].EpochDays())
+	}
+	return dst
+}
+
+// AppendDatesFromEpochDays appends a date for each number of days since
+// 1970-01-01 to dst
+func AppendDatesFromEpochDays(dst []Date, days []int32) []Date {
+	dst, out := growSlice(dst, len(days))
+	for i := range days {
+		out[i] = DateFromEpochDays(int(days[i]))
+	}
+	return dst
+}
+
+// AppendTimestampMicros appends the microseconds since the unix epoch of
+// each datetime to dst
+func AppendTimestampMicros(dst []int64, datetimes []DateTime) []int64 {
+	dst, out := growSlice(dst, len(datetimes))
+	for i := range datetimes {
+		out[i] = datetimes[i].t.UnixMicro()
+	}
+	return dst
+}
+
+// AppendDateTimesFromTimestampMicros appends a UTC datetime for each number of
+// microseconds since the unix epoch to dst
+func AppendDateTimesFromTimestampMicros(dst []DateTime, usecs []int64) []DateTime {
+	dst, out := growSlice(dst, len(usecs))
+	for i := range usecs {
+		out[i] = DateTime{t: time.UnixMicro(usecs[i]).UTC()}
+	}
+	return dst
+}
+
+// growSlice extends s by n elements, allocating at most once, and returns
+// the extended slice along with the new elements
+func growSlice[T any](s []T, n int) ([]T, []T) {
+	if cap(s)-len(s) < n {
+		grown := make([]T, len(s), len(s)+n)
+		copy(grown, s)
+		s = grown
+	}
+	l := len(s)
+	s = s[:l+n]
+	return s, s[l:]
+}
@@ -0,0 +1,86 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestAppendEpochDays(t *testing.T) {
+	t.Parallel()
+
+	dates := []chrono.Date{chrono.NewDate(1970, 1, 1), chrono.NewDate(2000, 1, 2), chrono.NewDate(1969, 12, 31)}
+	days := chrono.AppendEpochDays([]int32{7}, dates)
+	if len(days) != 4 || days[0] != 7 || days[1] != 0 || days[2] != 10958 || days[3] != -1 {
+		t.Error("days wrong:", days)
+	}
+
+	got := chrono.AppendDatesFromEpochDays(nil, days[1:])
+	if len(got) != len(dates) {
+		t.Fatal("length wrong:", len(got))
+	}
+	for i := range dates {
+		if !got[i].Equal(dates[i]) {
+			t.Errorf("%d: want: %v, got: %v", i, dates[i], got[i])
+		}
+	}
+}
+
+func TestAppendTimestampMicros(t *testing.T) {
+	t.Parallel()
+
+	datetimes := []chrono.DateTime{
+		chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 678912000, time.UTC),
+		chrono.NewDateTime(1969, 12, 31, 23, 59, 59, 999999000, time.FixedZone("", 60*60)),
+	}
+	usecs := chrono.AppendTimestampMicros(make([]int64, 0, 2), datetimes)
+	if len(usecs) != 2 || usecs[0] != 946782245678912 || usecs[1] != -3600000001 {
+		t.Error("micros wrong:", usecs)
+	}
+
+	got := chrono.AppendDateTimesFromTimestampMicros(nil, usecs)
+	for i := range datetimes {
+		if !got[i].Equal(datetimes[i]) || got[i].Location() != time.UTC {
+			t.Errorf("%d: want: %v, got: %v", i, datetimes[i], got[i])
+		}
+	}
+}
+
+func TestAppendEpochDaysReuse(t *testing.T) {
+	// AllocsPerRun cannot be used in parallel tests
+	dates := make([]chrono.Date, 64)
+	buf := make([]int32, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = chrono.AppendEpochDays(buf[:0], dates)
+	})
+	if allocs != 0 {
+		t.Error("reusing a buffer should not allocate:", allocs)
+	}
+}
+
+func BenchmarkAppendEpochDays(b *testing.B) {
+	dates := make([]chrono.Date, 1024)
+	for i := range dates {
+		dates[i] = chrono.NewDate(2000, 1, i)
+	}
+	buf := make([]int32, 0, len(dates))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = chrono.AppendEpochDays(buf[:0], dates)
+	}
+}
+
+func BenchmarkAppendTimestampMicros(b *testing.B) {
+	datetimes := make([]chrono.DateTime, 1024)
+	for i := range datetimes {
+		datetimes[i] = chrono.NewDateTime(2000, 1, 2, 3, 4, i, 0, time.UTC)
+	}
+	buf := make([]int64, 0, len(datetimes))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = chrono.AppendTimestampMicros(buf[:0], datetimes)
+	}
+}
@@ -0,0 +1,114 @@
+package chrono
+
+import "time"
+
+// The functions in this file convert to and from the logical types used by
+// Avro and Parquet:
+//
+//	Avro                   Parquet                          chrono
+//	date                   DATE                             Date.EpochDays
+//	time-millis            TIME(MILLIS)                     Time.ToTimeMillis
+//	time-micros            TIME(MICROS)                     Time.ToTimeMicros
+//	                       TIME(NANOS)                      Time.ToTimeNanos
+//	timestamp-millis       TIMESTAMP(MILLIS, utc=true)      DateTime.ToTimestampMillis
+//	timestamp-micros       TIMESTAMP(MICROS, utc=true)      DateTime.ToTimestampMicros
+//	                       TIMESTAMP(NANOS, utc=true)       DateTime.ToTimestampNanos
+//	local-timestamp-millis TIMESTAMP(MILLIS, utc=false)     LocalDateTime.ToLocalTimestampMillis
+//	local-timestamp-micros TIMESTAMP(MICROS, utc=false)     LocalDateTime.ToLocalTimestampMicros
+//
+// Dates are 32 bit in both formats, convert EpochDays with int32. Times of
+// day are the wall clock reading of the Time, its location is not part of
+// either format. Conversions to a smaller unit truncate.
+
+// TimeFromTimeMillis creates a UTC time from milliseconds since midnight.
+// Values outside of a day wrap around.
+func TimeFromTimeMillis(msec int32) Time {
+	return TimeFromTimeNanos(int64(msec) * int64(time.Millisecond))
+}
+
+// TimeFromTimeMicros creates a UTC time from microseconds since midnight.
+// Values outside of a day wrap around.
+func TimeFromTimeMicros(usec int64) Time {
+	return TimeFromTimeNanos(usec * int64(time.Microsecond))
+}
+
+// TimeFromTimeNanos creates a UTC time from nanoseconds since midnight.
+// Values outside of a day wrap around.
+func TimeFromTimeNanos(nsec int64) Time {
+	return TimeFromStdTime(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(nsec)))
+}
+
+// ToTimeMillis returns the milliseconds since midnight
+func (t Time) ToTimeMillis() int32 {
+	return int32(t.ToTimeNanos() / int64(time.Millisecond))
+}
+
+// ToTimeMicros returns the microseconds since midnight
+func (t Time) ToTimeMicros() int64 {
+	return t.ToTimeNanos() / int64(time.Microsecond)
+}
+
+// ToTimeNanos returns the nanoseconds since midnight
+func (t Time) ToTimeNanos() int64 {
+	hour, min, sec := t.t.Clock()
+	return int64(hour)*int64(time.Hour) + int64(min)*int64(time.Minute) +
+		int64(sec)*int64(time.Second) + int64(t.t.Nanosecond())
+}
+
+// DateTimeFromTimestampMillis creates a UTC datetime from milliseconds since
+// the unix epoch
+func DateTimeFromTimestampMillis(msec int64) DateTime {
+	return DateTime{t: time.UnixMilli(msec).UTC()}
+}
+
+// DateTimeFromTimestampMicros creates a UTC datetime from microseconds since
+// the unix epoch
+func DateTimeFromTimestampMicros(usec int64) DateTime {
+	return DateTime{t: time.UnixMicro(usec).UTC()}
+}
+
+// DateTimeFromTimestampNanos creates a UTC datetime from nanoseconds since
+// the unix epoch
+func DateTimeFromTimestampNanos(nsec int64) DateTime {
+	return DateTime{t: time.Unix(0, nsec).UTC()}
+}
+
+// ToTimestampMillis returns the milliseconds since the unix epoch
+func (d DateTime) ToTimestampMillis() int64 {
+	return d.t.UnixMilli()
+}
+
+// ToTimestampMicros returns the microseconds since the unix epoch
+func (d DateTime) ToTimestampMicros() int64 {
+	return d.t.UnixMicro()
+}
+
+// ToTimestampNanos returns the nanoseconds since the unix epoch, which only
+// covers the years 1678-2262
+func (d DateTime) ToTimestampNanos() int64 {
+	return d.t.UnixNano()
+}
+
+// LocalDateTimeFromLocalTimestampMillis creates a local datetime from the
+// milliseconds since 1970-01-01T00:00:00 on the wall clock
+func LocalDateTimeFromLocalTimestampMillis(msec int64) LocalDateTime {
+	return LocalDateTime{t: time.UnixMilli(msec).UTC()}
+}
+
+// LocalDateTimeFromLocalTimestampMicros creates a local datetime from the
+// microseconds since 1970-01-01T00:00:00 on the wall clock
+func LocalDateTimeFromLocalTimestampMicros(usec int64) LocalDateTime {
+	return LocalDateTime{t: time.UnixMicro(usec).UTC()}
+}
+
+// ToLocalTimestampMillis returns the milliseconds since 1970-01-01T00:00:00
+// on the wall clock
+func (l LocalDateTime) ToLocalTimestampMillis() int64 {
+	return l.t.UnixMilli()
+}
+
+// ToLocalTimestampMicros returns the microseconds since 1970-01-01T00:00:00
+// on the wall clock
+func (l LocalDateTime) ToLocalTimestampMicros() int64 {
+	return l.t.UnixMicro()
+}
@@ -0,0 +1,63 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestTimeOfDayUnits(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewTime(3, 4, 5, 678912345, time.FixedZone("", 60*60))
+	if got := ref.ToTimeMillis(); got != 11045678 {
+		t.Error("millis wrong:", got)
+	}
+	if got := ref.ToTimeMicros(); got != 11045678912 {
+		t.Error("micros wrong:", got)
+	}
+	if got := ref.ToTimeNanos(); got != 11045678912345 {
+		t.Error("nanos wrong:", got)
+	}
+
+	want := chrono.NewTime(3, 4, 5, 678912000, time.UTC)
+	if got := chrono.TimeFromTimeMicros(11045678912); !got.Equal(want) {
+		t.Error("from micros wrong:", got)
+	}
+	if got := chrono.TimeFromTimeMillis(11045678); !got.Equal(chrono.NewTime(3, 4, 5, 678000000, time.UTC)) {
+		t.Error("from millis wrong:", got)
+	}
+	if got := chrono.TimeFromTimeNanos(11045678912345); !got.Equal(chrono.NewTime(3, 4, 5, 678912345, time.UTC)) {
+		t.Error("from nanos wrong:", got)
+	}
+}
+
+func TestTimestampUnits(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 678912345, time.UTC)
+	if got := ref.ToTimestampMicros(); got != 946782245678912 {
+		t.Error("micros wrong:", got)
+	}
+	if got := chrono.DateTimeFromTimestampMicros(946782245678912); !got.Equal(ref.Truncate(time.Microsecond)) || got.Location() != time.UTC {
+		t.Error("from micros wrong:", got)
+	}
+	if got := chrono.DateTimeFromTimestampMillis(ref.ToTimestampMillis()); !got.Equal(ref.Truncate(time.Millisecond)) {
+		t.Error("millis round trip wrong:", got)
+	}
+	if got := chrono.DateTimeFromTimestampNanos(ref.ToTimestampNanos()); !got.Equal(ref) {
+		t.Error("nanos round trip wrong:", got)
+	}
+
+	local := chrono.NewLocalDateTime(2000, 1, 2, 3, 4, 5, 678912000)
+	if got := local.ToLocalTimestampMicros(); got != 946782245678912 {
+		t.Error("local micros wrong:", got)
+	}
+	if got := chrono.LocalDateTimeFromLocalTimestampMicros(946782245678912); got != local {
+		t.Error("local from micros wrong:", got)
+	}
+	if got := chrono.LocalDateTimeFromLocalTimestampMillis(local.ToLocalTimestampMillis()); got != chrono.NewLocalDateTime(2000, 1, 2, 3, 4, 5, 678000000) {
+		t.Error("local millis round trip wrong:", got)
+	}
+}
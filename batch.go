@@ -0,0 +1,141 @@
+package chrono
+
+import (
+	"fmt"
+	"time"
+)
+
+// IndexError is an error for a single element of a batch
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+// Error implements error
+func (i *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", i.Index, i.Err)
+}
+
+// Unwrap returns the underlying error
+func (i *IndexError) Unwrap() error {
+	return i.Err
+}
+
+// BatchError is returned by the batch parsing functions when any of the
+// inputs fail, it has an *IndexError for each of them in order.
+type BatchError struct {
+	Errors []*IndexError
+}
+
+// Error implements error
+func (b *BatchError) Error() string {
+	if len(b.Errors) == 1 {
+		return b.Errors[0].Error()
+	}
+	return fmt.Sprintf("%v (and %d more errors)", b.Errors[0], len(b.Errors)-1)
+}
+
+// Unwrap returns the errors of each failed input
+func (b *BatchError) Unwrap() []error {
+	errs := make([]error, len(b.Errors))
+	for i, err := range b.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// ParseDates parses each string with layout like DateFromLayout. The
+// returned slice always has an element for every input, failures are left
+// as the zero Date and reported together in a *BatchError. The common
+// layouts (2006-01-02) are parsed without going through time.Parse.
+func ParseDates(strs []string, layout string) ([]Date, error) {
+	fast := func(string) (time.Time, bool) { return time.Time{}, false }
+	if layout == dateLayout {
+		fast = parseSQLDate[string]
+	}
+
+	var batchErr *BatchError
+	dates := make([]Date, len(strs))
+	for i, str := range strs {
+		if t, ok := fast(str); ok {
+			dates[i] = Date{t: t}
+			continue
+		}
+
+		d, err := DateFromLayout(layout, str)
+		if err != nil {
+			batchErr = appendIndexError(batchErr, i, err)
+			continue
+		}
+		dates[i] = d
+	}
+
+	if batchErr != nil {
+		return dates, batchErr
+	}
+	return dates, nil
+}
+
+// ParseDateTimes parses each string with layout like DateTimeFromLayout.
+// The returned slice always has an element for every input, failures are
+// left as the zero DateTime and reported together in a *BatchError. The
+// common layouts (time.RFC3339, time.RFC3339Nano and DateTimeSQLLayout) are
+// parsed without going through time.Parse.
+func ParseDateTimes(strs []string, layout string) ([]DateTime, error) {
+	fast := func(string) (time.Time, bool) { return time.Time{}, false }
+	switch layout {
+	case time.RFC3339, time.RFC3339Nano:
+		fast = parseRFC3339[string]
+	case DateTimeSQLLayout:
+		fast = parseSQLDateTime[string]
+	}
+
+	var batchErr *BatchError
+	datetimes := make([]DateTime, len(strs))
+	for i, str := range strs {
+		if t, ok := fast(str); ok {
+			datetimes[i] = DateTime{t: t}
+			continue
+		}
+
+		d, err := DateTimeFromLayout(layout, str)
+		if err != nil {
+			batchErr = appendIndexError(batchErr, i, err)
+			continue
+		}
+		datetimes[i] = d
+	}
+
+	if batchErr != nil {
+		return datetimes, batchErr
+	}
+	return datetimes, nil
+}
+
+func appendIndexError(b *BatchError, index int, err error) *BatchError {
+	if b == nil {
+		b = &BatchError{}
+	}
+	b.Errors = append(b.Errors, &IndexError{Index: index, Err: err})
+	return b
+}
+
+// parseRFC3339 parses 2006-01-02T15:04:05.999999999Z07:00
+func parseRFC3339[T byteString](b T) (time.Time, bool) {
+	if len(b) < 11 || b[10] != 'T' {
+		return time.Time{}, false
+	}
+	year, month, day, ok := scanSQLDate(b)
+	if !ok {
+		return time.Time{}, false
+	}
+	hour, min, sec, nsec, i, ok := scanSQLClock(b, 11)
+	if !ok || (len(b)-i != 1 && len(b)-i != 6) {
+		return time.Time{}, false
+	}
+	offset, utc, ok := scanSQLOffset(b, i)
+	if !ok {
+		return time.Time{}, false
+	}
+	return sqlTime(year, month, day, hour, min, sec, nsec, offset, utc), true
+}
@@ -0,0 +1,127 @@
+package chrono_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestParseDates(t *testing.T) {
+	t.Parallel()
+
+	dates, err := chrono.ParseDates([]string{"2000-01-02", "2024-02-29"}, "2006-01-02")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dates) != 2 || !dates[0].Equal(chrono.NewDate(2000, 1, 2)) || !dates[1].Equal(chrono.NewDate(2024, 2, 29)) {
+		t.Error("dates wrong:", dates)
+	}
+
+	dates, err = chrono.ParseDates([]string{"02/01/2000", "bad", "31/12/1999", "30/02/2000"}, "02/01/2006")
+	if len(dates) != 4 || !dates[0].Equal(chrono.NewDate(2000, 1, 2)) || !dates[1].IsZero() || !dates[2].Equal(chrono.NewDate(1999, 12, 31)) {
+		t.Error("dates wrong:", dates)
+	}
+
+	var batchErr *chrono.BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatal("expected a batch error:", err)
+	}
+	if len(batchErr.Errors) != 2 || batchErr.Errors[0].Index != 1 || batchErr.Errors[1].Index != 3 {
+		t.Error("indexes wrong:", batchErr.Errors)
+	}
+	var perr *chrono.ParseError
+	if !errors.As(batchErr.Errors[0], &perr) || perr.Input != "bad" {
+		t.Error("expected a parse error:", batchErr.Errors[0])
+	}
+	if got := err.Error(); got != `index 1: failed to parse date ("bad"): `+perr.Err.Error()+" (and 1 more errors)" {
+		t.Error("message wrong:", got)
+	}
+
+	_, err = chrono.ParseDates([]string{"2000-02-30"}, "2006-01-02")
+	if !errors.As(err, &batchErr) || len(batchErr.Errors) != 1 {
+		t.Error("the fast path should still report invalid dates:", err)
+	}
+}
+
+func TestParseDateTimes(t *testing.T) {
+	t.Parallel()
+
+	offset := time.FixedZone("", 5*60*60+30*60)
+	strs := []string{"2000-01-02T03:04:05Z", "2000-01-02T03:04:05.123+05:30", "2000-01-02 03:04:05Z", "nope"}
+	want := []time.Time{
+		time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC),
+		time.Date(2000, 1, 2, 3, 4, 5, 123000000, offset),
+		{},
+		{},
+	}
+
+	datetimes, err := chrono.ParseDateTimes(strs, time.RFC3339)
+	for i := range want {
+		if !datetimes[i].ToStdTime().Equal(want[i]) {
+			t.Errorf("%d: want: %v, got: %v", i, want[i], datetimes[i])
+		}
+	}
+	if _, off := datetimes[1].Zone(); off != 5*60*60+30*60 {
+		t.Error("offset wrong:", off)
+	}
+
+	var batchErr *chrono.BatchError
+	if !errors.As(err, &batchErr) || len(batchErr.Errors) != 2 || batchErr.Errors[0].Index != 2 || batchErr.Errors[1].Index != 3 {
+		t.Fatal("errors wrong:", err)
+	}
+
+	datetimes, err = chrono.ParseDateTimes([]string{"2000-01-02 03:04:05-07"}, chrono.DateTimeSQLLayout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !datetimes[0].ToStdTime().Equal(time.Date(2000, 1, 2, 10, 4, 5, 0, time.UTC)) {
+		t.Error("sql datetime wrong:", datetimes[0])
+	}
+}
+
+func TestParseDateTimesMatchesLayout(t *testing.T) {
+	t.Parallel()
+
+	for _, str := range []string{"2000-01-02T03:04:05Z", "2000-01-02T03:04:05+00:00", "2000-01-02T03:04:05.999999999-07:00", "2000-01-02t03:04:05Z"} {
+		want, wantErr := chrono.DateTimeFromLayout(time.RFC3339, str)
+		got, err := chrono.ParseDateTimes([]string{str}, time.RFC3339)
+		if (err == nil) != (wantErr == nil) {
+			t.Errorf("%s: error mismatch: %v, %v", str, wantErr, err)
+			continue
+		}
+		if got[0].ToStdTime() != want.ToStdTime() {
+			t.Errorf("%s: want: %#v, got: %#v", str, want.ToStdTime(), got[0].ToStdTime())
+		}
+	}
+}
+
+func BenchmarkParseDates(b *testing.B) {
+	strs := make([]string, 1024)
+	for i := range strs {
+		strs[i] = fmt.Sprintf("2000-01-%02d", i%28+1)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := chrono.ParseDates(strs, "2006-01-02"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseDateTimes(b *testing.B) {
+	strs := make([]string, 1024)
+	for i := range strs {
+		strs[i] = fmt.Sprintf("2000-01-02T03:04:%02d.123456Z", i%60)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := chrono.ParseDateTimes(strs, time.RFC3339Nano); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package chrono
+
+// NextBillingDate returns the first date after after on which a subscription
+// anchored on anchor and billed every period renews. The anchor itself is
+// the first billing date. Each date is computed from the anchor rather than
+// the previous date and the years and months are added with
+// AddMonthsNoOverflow, so a monthly subscription anchored on the 31st renews
+// on the last day of shorter months and returns to the 31st afterwards. The
+// days of every are added after the months, its Duration is counted in whole
+// days.
+//
+// If every doesn't move the date forward the zero Date is returned.
+func NextBillingDate(anchor Date, every Period, after Date) Date {
+	months := every.Years*12 + every.Months
+	days := every.Days + int(every.Duration/dayDuration)
+	at := func(n int) Date {
+		return anchor.AddMonthsNoOverflow(n*months).AddDate(0, 0, n*days)
+	}
+
+	if !at(1).After(anchor) {
+		return Date{}
+	}
+	if after.Before(anchor) {
+		return anchor
+	}
+
+	// Jump close to the answer using the average length of a month then
+	// step to it
+	approx := float64(months)*30.436875 + float64(days)
+	if approx < 1 {
+		approx = 1
+	}
+	n := int(float64(after.EpochDays()-anchor.EpochDays())/approx) - 1
+	if n < 0 {
+		n = 0
+	}
+	for n > 0 && at(n).After(after) {
+		n--
+	}
+	for !at(n).After(after) {
+		n++
+	}
+	return at(n)
+}
@@ -0,0 +1,46 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestNextBillingDate(t *testing.T) {
+	t.Parallel()
+
+	jan31 := chrono.NewDate(2024, 1, 31)
+	monthly := chrono.NewPeriod(0, 1, 0)
+
+	tests := []struct {
+		Anchor chrono.Date
+		Every  chrono.Period
+		After  chrono.Date
+		Want   chrono.Date
+	}{
+		{jan31, monthly, chrono.NewDate(2023, 12, 1), jan31},
+		{jan31, monthly, jan31, chrono.NewDate(2024, 2, 29)},
+		{jan31, monthly, chrono.NewDate(2024, 2, 29), chrono.NewDate(2024, 3, 31)},
+		{jan31, monthly, chrono.NewDate(2024, 4, 1), chrono.NewDate(2024, 4, 30)},
+		{jan31, monthly, chrono.NewDate(2034, 5, 30), chrono.NewDate(2034, 5, 31)},
+		{jan31, chrono.NewPeriod(0, 3, 0), chrono.NewDate(2024, 2, 1), chrono.NewDate(2024, 4, 30)},
+		{chrono.NewDate(2024, 2, 29), chrono.NewPeriod(1, 0, 0), chrono.NewDate(2024, 3, 1), chrono.NewDate(2025, 2, 28)},
+		{chrono.NewDate(2024, 2, 29), chrono.NewPeriod(1, 0, 0), chrono.NewDate(2027, 12, 31), chrono.NewDate(2028, 2, 29)},
+		{chrono.NewDate(2024, 1, 1), chrono.NewPeriod(0, 0, 14), chrono.NewDate(2024, 1, 14), chrono.NewDate(2024, 1, 15)},
+		{chrono.NewDate(2024, 1, 1), chrono.NewPeriod(0, 0, 14), chrono.NewDate(2024, 1, 15), chrono.NewDate(2024, 1, 29)},
+		{chrono.NewDate(2024, 1, 1), chrono.Period{Duration: 7 * 24 * time.Hour}, chrono.NewDate(2100, 1, 1), chrono.NewDate(2100, 1, 4)},
+	}
+	for _, test := range tests {
+		if got := chrono.NextBillingDate(test.Anchor, test.Every, test.After); got != test.Want {
+			t.Errorf("%s every %s after %s: want %s, got %s", test.Anchor, test.Every, test.After, test.Want, got)
+		}
+	}
+
+	if got := chrono.NextBillingDate(jan31, chrono.Period{}, jan31); !got.IsZero() {
+		t.Error("zero period should be the zero date:", got)
+	}
+	if got := chrono.NextBillingDate(jan31, chrono.NewPeriod(0, -1, 0), jan31); !got.IsZero() {
+		t.Error("negative period should be the zero date:", got)
+	}
+}
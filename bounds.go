@@ -0,0 +1,55 @@
+package chrono
+
+import "time"
+
+// BoundType selects whether the start and end of a range are included by
+// the BetweenBounds methods. The zero value is the half-open range [start,
+// end) that range queries usually want.
+type BoundType int
+
+// Bound types
+const (
+	// BoundsClosedOpen is [start, end), the start is included and the end
+	// is not
+	BoundsClosedOpen BoundType = iota
+	// BoundsOpenClosed is (start, end]
+	BoundsOpenClosed
+	// BoundsClosed is [start, end], like BetweenOrEqual
+	BoundsClosed
+	// BoundsOpen is (start, end), like Between
+	BoundsOpen
+)
+
+// String returns the interval notation of the bounds, eg. "[)"
+func (b BoundType) String() string {
+	switch b {
+	case BoundsClosedOpen:
+		return "[)"
+	case BoundsOpenClosed:
+		return "(]"
+	case BoundsClosed:
+		return "[]"
+	case BoundsOpen:
+		return "()"
+	default:
+		return "BoundType(unknown)"
+	}
+}
+
+// inBounds returns true if t is in the range from start to end with bounds b
+func inBounds(t, start, end time.Time, b BoundType) bool {
+	var afterStart, beforeEnd bool
+	switch b {
+	case BoundsClosedOpen, BoundsClosed:
+		afterStart = !t.Before(start)
+	default:
+		afterStart = t.After(start)
+	}
+	switch b {
+	case BoundsOpenClosed, BoundsClosed:
+		beforeEnd = !t.After(end)
+	default:
+		beforeEnd = t.Before(end)
+	}
+	return afterStart && beforeEnd
+}
@@ -0,0 +1,72 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestBetweenBounds(t *testing.T) {
+	t.Parallel()
+
+	start, mid, end := chrono.NewDate(2000, 1, 1), chrono.NewDate(2000, 1, 2), chrono.NewDate(2000, 1, 3)
+	before, after := chrono.NewDate(1999, 12, 31), chrono.NewDate(2000, 1, 4)
+
+	tests := []struct {
+		Bounds                chrono.BoundType
+		String                string
+		Start, Mid, End, Outs bool
+	}{
+		{chrono.BoundsClosedOpen, "[)", true, true, false, false},
+		{chrono.BoundsOpenClosed, "(]", false, true, true, false},
+		{chrono.BoundsClosed, "[]", true, true, true, false},
+		{chrono.BoundsOpen, "()", false, true, false, false},
+	}
+	for _, test := range tests {
+		if got := test.Bounds.String(); got != test.String {
+			t.Error("wrong string:", got)
+		}
+
+		got := []bool{
+			start.BetweenBounds(start, end, test.Bounds),
+			mid.BetweenBounds(start, end, test.Bounds),
+			end.BetweenBounds(start, end, test.Bounds),
+			before.BetweenBounds(start, end, test.Bounds) || after.BetweenBounds(start, end, test.Bounds),
+		}
+		want := []bool{test.Start, test.Mid, test.End, test.Outs}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("%s %d: want %t, got %t", test.Bounds, i, want[i], got[i])
+			}
+		}
+
+		dtStart, dtEnd := start.AtClock(0, 0, 0, time.UTC), end.AtClock(0, 0, 0, time.UTC)
+		if got := dtStart.BetweenBounds(dtStart, dtEnd, test.Bounds); got != test.Start {
+			t.Errorf("%s datetime start: want %t, got %t", test.Bounds, test.Start, got)
+		}
+		if got := dtEnd.BetweenBounds(dtStart, dtEnd, test.Bounds); got != test.End {
+			t.Errorf("%s datetime end: want %t, got %t", test.Bounds, test.End, got)
+		}
+
+		tStart, tEnd := chrono.NewTime(9, 0, 0, 0, time.UTC), chrono.NewTime(17, 0, 0, 0, time.UTC)
+		if got := tStart.BetweenBounds(tStart, tEnd, test.Bounds); got != test.Start {
+			t.Errorf("%s time start: want %t, got %t", test.Bounds, test.Start, got)
+		}
+		if got := tEnd.BetweenBounds(tStart, tEnd, test.Bounds); got != test.End {
+			t.Errorf("%s time end: want %t, got %t", test.Bounds, test.End, got)
+		}
+
+		lStart, lEnd := chrono.NewLocalDateTime(2000, 1, 1, 0, 0, 0, 0), chrono.NewLocalDateTime(2000, 1, 3, 0, 0, 0, 0)
+		if got := lStart.BetweenBounds(lStart, lEnd, test.Bounds); got != test.Start {
+			t.Errorf("%s local start: want %t, got %t", test.Bounds, test.Start, got)
+		}
+		if got := lEnd.BetweenBounds(lStart, lEnd, test.Bounds); got != test.End {
+			t.Errorf("%s local end: want %t, got %t", test.Bounds, test.End, got)
+		}
+	}
+
+	if got := chrono.BoundType(99).String(); got != "BoundType(unknown)" {
+		t.Error("wrong unknown string:", got)
+	}
+}
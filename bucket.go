@@ -0,0 +1,58 @@
+package chrono
+
+import "time"
+
+// Buckets splits [start, end) into intervals aligned to unit in loc, eg. one
+// per day from midnight to midnight. The first bucket begins at the start of
+// the unit containing start and the last contains the instant before end, so
+// they may extend past the range. Buckets follow the calendar rather than
+// stepping by a duration so a day shortened or lengthened by DST is 23 or 25
+// hours long. It returns nil if end is not after start.
+func Buckets(start, end DateTime, unit CalendarUnit, loc *time.Location) []Interval {
+	if !end.After(start) {
+		return nil
+	}
+
+	var buckets []Interval
+	cur := startOf(start.t.In(loc), unit)
+	for cur.Before(end.t) {
+		next := nextBucket(cur, unit)
+		buckets = append(buckets, Interval{Start: DateTime{t: cur}, End: DateTime{t: next}})
+		cur = next
+	}
+	return buckets
+}
+
+// nextBucket returns the start of the unit after the one starting at t. Units
+// of a day or longer are found from the next calendar date because the start
+// of a day isn't always midnight, eg. when DST skips it, and adding to a time
+// that isn't midnight can land on the wrong date.
+func nextBucket(t time.Time, unit CalendarUnit) time.Time {
+	year, month, day := t.Date()
+	loc := t.Location()
+
+	switch unit {
+	case Second, Minute, Hour:
+		return startOf(addUnits(t, unit, 1), unit)
+	case Day:
+		return startOfDay(year, month, day+1, loc)
+	case Week:
+		return startOfDay(year, month, day+7, loc)
+	case Month:
+		return startOfDay(year, month+1, 1, loc)
+	default:
+		return startOfDay(year+1, 1, 1, loc)
+	}
+}
+
+// GroupByBucket groups samples by the bucket of unit in loc that at(sample)
+// falls in. The keys are the starts of the buckets, the same as StartOf
+// returns, and the order of samples within a bucket is kept.
+func GroupByBucket[T any](samples []T, at func(T) DateTime, unit CalendarUnit, loc *time.Location) map[DateTime][]T {
+	groups := make(map[DateTime][]T)
+	for _, s := range samples {
+		key := at(s).StartOf(unit, loc)
+		groups[key] = append(groups[key], s)
+	}
+	return groups
+}
@@ -0,0 +1,123 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestBuckets(t *testing.T) {
+	t.Parallel()
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Spans the spring forward on 2024-03-10
+	start := chrono.NewDateTime(2024, 3, 9, 12, 0, 0, 0, ny)
+	end := chrono.NewDateTime(2024, 3, 11, 6, 0, 0, 0, ny)
+	buckets := chrono.Buckets(start, end, chrono.Day, ny)
+	if len(buckets) != 3 {
+		t.Fatal("wrong number of buckets:", buckets)
+	}
+	wantLens := []time.Duration{24 * time.Hour, 23 * time.Hour, 24 * time.Hour}
+	for i, b := range buckets {
+		if b.Duration() != wantLens[i] {
+			t.Errorf("%d: want %v, got %v", i, wantLens[i], b.Duration())
+		}
+		if h, m, s := b.Start.In(ny).Clock(); h != 0 || m != 0 || s != 0 {
+			t.Errorf("%d: should start at midnight: %v", i, b.Start)
+		}
+		if i > 0 && !b.Start.Equal(buckets[i-1].End) {
+			t.Errorf("%d: buckets should be contiguous", i)
+		}
+	}
+
+	months := chrono.Buckets(
+		chrono.NewDateTime(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		chrono.NewDateTime(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		chrono.Month, time.UTC,
+	)
+	if len(months) != 3 || !months[0].Start.Equal(chrono.NewDateTime(2024, 1, 1, 0, 0, 0, 0, time.UTC)) ||
+		!months[2].End.Equal(chrono.NewDateTime(2024, 4, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("wrong month buckets:", months)
+	}
+	if months[1].Duration() != 29*24*time.Hour {
+		t.Error("february should be 29 days:", months[1].Duration())
+	}
+
+	// 2024-01-01 is a Monday
+	weeks := chrono.Buckets(
+		chrono.NewDateTime(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		chrono.NewDateTime(2024, 1, 8, 0, 0, 0, 1, time.UTC),
+		chrono.Week, time.UTC,
+	)
+	if len(weeks) != 2 || !weeks[0].Start.Equal(chrono.NewDateTime(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("wrong week buckets:", weeks)
+	}
+
+	if got := chrono.Buckets(end, start, chrono.Day, ny); got != nil {
+		t.Error("expected no buckets:", got)
+	}
+}
+
+func TestBucketsSkippedMidnight(t *testing.T) {
+	t.Parallel()
+
+	santiago, err := time.LoadLocation("America/Santiago")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Midnight doesn't exist on 2024-09-08 in Santiago, the day starts at 1am
+	start := chrono.NewDateTime(2024, 9, 7, 12, 0, 0, 0, santiago)
+	end := chrono.NewDateTime(2024, 9, 9, 12, 0, 0, 0, santiago)
+	buckets := chrono.Buckets(start, end, chrono.Day, santiago)
+	if len(buckets) != 3 {
+		t.Fatal("wrong number of buckets:", buckets)
+	}
+	wantLens := []time.Duration{24 * time.Hour, 23 * time.Hour, 24 * time.Hour}
+	for i, b := range buckets {
+		if b.Duration() != wantLens[i] {
+			t.Errorf("%d: want %v, got %v", i, wantLens[i], b.Duration())
+		}
+		if y, m, d := b.Start.In(santiago).Date(); y != 2024 || m != 9 || d != 7+i {
+			t.Errorf("%d: wrong start: %v", i, b.Start)
+		}
+	}
+	if h, _, _ := buckets[1].Start.In(santiago).Clock(); h != 1 {
+		t.Error("2024-09-08 should start at 1am:", buckets[1].Start)
+	}
+}
+
+func TestGroupByBucket(t *testing.T) {
+	t.Parallel()
+
+	type sample struct {
+		At    chrono.DateTime
+		Value int
+	}
+
+	loc := time.FixedZone("", -5*60*60)
+	samples := []sample{
+		{chrono.NewDateTime(2024, 1, 1, 23, 0, 0, 0, time.UTC), 1},
+		{chrono.NewDateTime(2024, 1, 2, 1, 0, 0, 0, time.UTC), 2},
+		{chrono.NewDateTime(2024, 1, 2, 6, 0, 0, 0, time.UTC), 3},
+		{chrono.NewDateTime(2024, 1, 1, 12, 0, 0, 0, time.UTC), 4},
+	}
+	groups := chrono.GroupByBucket(samples, func(s sample) chrono.DateTime { return s.At }, chrono.Day, loc)
+	if len(groups) != 2 {
+		t.Fatal("wrong number of groups:", groups)
+	}
+
+	jan1 := chrono.NewDateTime(2024, 1, 1, 0, 0, 0, 0, loc).StartOf(chrono.Day, loc)
+	jan2 := chrono.NewDateTime(2024, 1, 2, 0, 0, 0, 0, loc).StartOf(chrono.Day, loc)
+	if g := groups[jan1]; len(g) != 3 || g[0].Value != 1 || g[1].Value != 2 || g[2].Value != 4 {
+		t.Error("wrong jan 1 group:", g)
+	}
+	if g := groups[jan2]; len(g) != 1 || g[0].Value != 3 {
+		t.Error("wrong jan 2 group:", g)
+	}
+}
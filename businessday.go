@@ -0,0 +1,63 @@
+package chrono
+
+import "time"
+
+// Calendar reports which dates are holidays. It is used by the business day
+// calculations to skip over days that would otherwise be working days. See
+// the holidays subpackage for implementations covering common regions.
+type Calendar interface {
+	IsHoliday(d Date) bool
+}
+
+// CalendarFunc is an adapter to allow the use of an ordinary function as a
+// Calendar.
+type CalendarFunc func(d Date) bool
+
+// IsHoliday calls f(d)
+func (f CalendarFunc) IsHoliday(d Date) bool {
+	return f(d)
+}
+
+// IsBusinessDay returns true if d is a weekday that is not a holiday in cal.
+// cal may be nil in which case only weekends are considered.
+func (d Date) IsBusinessDay(cal Calendar) bool {
+	switch d.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+	return cal == nil || !cal.IsHoliday(d)
+}
+
+// AddBusinessDays adds n business days to d. If n is negative it moves
+// backwards. When n is 0 d is returned even if it's not a business day.
+func (d Date) AddBusinessDays(n int, cal Calendar) Date {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	for n > 0 {
+		d = d.AddDate(0, 0, step)
+		if d.IsBusinessDay(cal) {
+			n--
+		}
+	}
+	return d
+}
+
+// BusinessDaysBetween counts the business days in the half-open range
+// [start, end). If end is before start the result is negative.
+func BusinessDaysBetween(start, end Date, cal Calendar) int {
+	sign := 1
+	if end.Before(start) {
+		start, end, sign = end, start, -1
+	}
+
+	count := 0
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		if d.IsBusinessDay(cal) {
+			count++
+		}
+	}
+	return sign * count
+}
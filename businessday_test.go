@@ -0,0 +1,48 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestBusinessDays(t *testing.T) {
+	t.Parallel()
+
+	// 2022-07-04 is a Monday and a holiday
+	holiday := chrono.NewDate(2022, 7, 4)
+	cal := chrono.CalendarFunc(func(d chrono.Date) bool {
+		return d.Equal(holiday)
+	})
+
+	if chrono.NewDate(2022, 7, 2).IsBusinessDay(nil) {
+		t.Error("saturday is not a business day")
+	}
+	if !holiday.IsBusinessDay(nil) {
+		t.Error("monday is a business day without a calendar")
+	}
+	if holiday.IsBusinessDay(cal) {
+		t.Error("holiday is not a business day")
+	}
+
+	friday := chrono.NewDate(2022, 7, 1)
+	if d := friday.AddBusinessDays(1, cal); !d.Equal(chrono.NewDate(2022, 7, 5)) {
+		t.Error("value wrong", d)
+	}
+	if d := friday.AddBusinessDays(1, nil); !d.Equal(holiday) {
+		t.Error("value wrong", d)
+	}
+	if d := chrono.NewDate(2022, 7, 5).AddBusinessDays(-1, cal); !d.Equal(friday) {
+		t.Error("value wrong", d)
+	}
+	if d := friday.AddBusinessDays(0, cal); !d.Equal(friday) {
+		t.Error("value wrong", d)
+	}
+
+	if n := chrono.BusinessDaysBetween(friday, chrono.NewDate(2022, 7, 8), cal); n != 4 {
+		t.Error("value wrong", n)
+	}
+	if n := chrono.BusinessDaysBetween(chrono.NewDate(2022, 7, 8), friday, cal); n != -4 {
+		t.Error("value wrong", n)
+	}
+}
@@ -0,0 +1,23 @@
+// Package calendars converts chrono dates to and from calendar systems
+// other than the Gregorian calendar: the Hebrew calendar, the Umm al-Qura
+// Islamic calendar, the era based Japanese calendar and the Chinese
+// lunisolar calendar.
+//
+// Each calendar has a date type with a constructor from chrono.Date and a
+// Date method to convert back:
+//
+//	h := calendars.ToHebrew(chrono.NewDate(2024, 10, 3))
+//	fmt.Println(h.Format(calendars.English)) // 1 Tishri 5785 AM
+//	d := h.Date()
+package calendars
+
+// Language selects the language the names of months and eras are written in
+// by Format
+type Language int
+
+// Languages Format supports, Native is the calendar's own language: Hebrew,
+// Arabic, Japanese or Chinese.
+const (
+	English Language = iota
+	Native
+)
@@ -0,0 +1,220 @@
+package calendars
+
+import (
+	"fmt"
+
+	"github.com/aarondl/chrono"
+)
+
+// Zodiac is an animal of the Chinese zodiac, each lunar year is associated
+// with one in a 12 year cycle
+type Zodiac int
+
+// Zodiac animals in the order of the cycle
+const (
+	Rat Zodiac = iota
+	Ox
+	Tiger
+	Rabbit
+	Dragon
+	Snake
+	Horse
+	Goat
+	Monkey
+	Rooster
+	Dog
+	Pig
+)
+
+var zodiacNames = [...]string{
+	"Rat", "Ox", "Tiger", "Rabbit", "Dragon", "Snake",
+	"Horse", "Goat", "Monkey", "Rooster", "Dog", "Pig",
+}
+
+// String returns the English name of the animal
+func (z Zodiac) String() string {
+	if z < Rat || z > Pig {
+		return fmt.Sprintf("%%!Zodiac(%d)", int(z))
+	}
+	return zodiacNames[z]
+}
+
+// ZodiacOf returns the zodiac animal of the lunar year that begins in the
+// Gregorian year, eg. 2024 is the year of the Dragon. Dates in January and
+// February can still be in the previous lunar year, use ChineseDate.Zodiac
+// for them.
+func ZodiacOf(year int) Zodiac {
+	return Zodiac(mod(year-4, 12))
+}
+
+const (
+	heavenlyStems   = "甲乙丙丁戊己庚辛壬癸"
+	earthlyBranches = "子丑寅卯辰巳午未申酉戌亥"
+)
+
+var (
+	chineseMonthNames = [...]string{"正", "二", "三", "四", "五", "六", "七", "八", "九", "十", "冬", "腊"}
+	chineseDigits     = [...]string{"", "一", "二", "三", "四", "五", "六", "七", "八", "九", "十"}
+)
+
+// ChineseDate is a date in the Chinese lunisolar calendar. Years are
+// numbered by the Gregorian year in which they begin.
+type ChineseDate struct {
+	Year  int
+	Month int
+	// LeapMonth is true for the leap month which follows the month of the
+	// same number
+	LeapMonth bool
+	Day       int
+}
+
+// chineseYearStarts is the first day of each year in the table and the
+// year after it in days since 1970-01-01
+var chineseYearStarts [len(chineseYears) + 1]int
+
+func init() {
+	chineseYearStarts[0] = chineseEpochDays
+	for i := range chineseYears {
+		days := 0
+		year := chineseFirstYear + i
+		for idx := 0; idx < chineseMonthCount(year); idx++ {
+			days += chineseMonthDays(year, idx)
+		}
+		chineseYearStarts[i+1] = chineseYearStarts[i] + days
+	}
+}
+
+// ToChinese converts d to the Chinese calendar. It returns false for dates
+// outside of the lunar years 1900 to 2100.
+func ToChinese(d chrono.Date) (ChineseDate, bool) {
+	days := d.EpochDays()
+	if days < chineseEpochDays || days >= chineseYearStarts[len(chineseYears)] {
+		return ChineseDate{}, false
+	}
+
+	// Years with a leap month are up to 385 days long
+	i := (days - chineseEpochDays) / 385
+	for chineseYearStarts[i+1] <= days {
+		i++
+	}
+
+	year := chineseFirstYear + i
+	days -= chineseYearStarts[i]
+	idx := 0
+	for days >= chineseMonthDays(year, idx) {
+		days -= chineseMonthDays(year, idx)
+		idx++
+	}
+
+	month, leap := idx+1, false
+	if leapMonth := chineseLeapMonth(year); leapMonth != 0 && idx >= leapMonth {
+		month, leap = idx, idx == leapMonth
+	}
+	return ChineseDate{Year: year, Month: month, LeapMonth: leap, Day: days + 1}, true
+}
+
+// LunarNewYear returns the first day of the lunar year that begins in the
+// Gregorian year. It returns false for years outside of 1900 to 2100.
+func LunarNewYear(year int) (chrono.Date, bool) {
+	return ChineseDate{Year: year, Month: 1, Day: 1}.Date()
+}
+
+// Date converts c to a chrono.Date. It returns false if c is outside of the
+// lunar years 1900 to 2100 or doesn't exist, eg. a leap month in a year
+// without one or a 30th day in a 29 day month.
+func (c ChineseDate) Date() (chrono.Date, bool) {
+	if c.Year < chineseFirstYear || c.Year > chineseLastYear || c.Month < 1 || c.Month > 12 {
+		return chrono.Date{}, false
+	}
+
+	idx := c.Month - 1
+	if leapMonth := chineseLeapMonth(c.Year); c.LeapMonth {
+		if leapMonth != c.Month {
+			return chrono.Date{}, false
+		}
+		idx = c.Month
+	} else if leapMonth != 0 && c.Month > leapMonth {
+		idx = c.Month
+	}
+	if c.Day < 1 || c.Day > chineseMonthDays(c.Year, idx) {
+		return chrono.Date{}, false
+	}
+
+	days := chineseYearStarts[c.Year-chineseFirstYear] + c.Day - 1
+	for i := 0; i < idx; i++ {
+		days += chineseMonthDays(c.Year, i)
+	}
+	return chrono.DateFromEpochDays(days), true
+}
+
+// Zodiac returns the zodiac animal of c's year
+func (c ChineseDate) Zodiac() Zodiac {
+	return ZodiacOf(c.Year)
+}
+
+// IsLeapYear returns true if c is in a year with a leap month
+func (c ChineseDate) IsLeapYear() bool {
+	return c.Year >= chineseFirstYear && c.Year <= chineseLastYear && chineseLeapMonth(c.Year) != 0
+}
+
+// Format writes the date, eg. "Month 8 Day 15, Year of the Dragon (2024)" in
+// English or "甲辰年八月十五" in Chinese where the year is named by its
+// sexagenary cycle.
+func (c ChineseDate) Format(lang Language) string {
+	if lang != Native || c.Month < 1 || c.Month > 12 || c.Day < 1 || c.Day > 30 {
+		leap := ""
+		if c.LeapMonth {
+			leap = "Leap "
+		}
+		return fmt.Sprintf("%sMonth %d Day %d, Year of the %s (%d)", leap, c.Month, c.Day, c.Zodiac(), c.Year)
+	}
+
+	stems, branches := []rune(heavenlyStems), []rune(earthlyBranches)
+	year := string(stems[mod(c.Year-4, 10)]) + string(branches[mod(c.Year-4, 12)])
+
+	month := chineseMonthNames[c.Month-1] + "月"
+	if c.LeapMonth {
+		month = "闰" + month
+	}
+
+	var day string
+	switch {
+	case c.Day <= 10:
+		day = "初" + chineseDigits[c.Day]
+	case c.Day < 20:
+		day = "十" + chineseDigits[c.Day-10]
+	case c.Day == 20:
+		day = "二十"
+	case c.Day < 30:
+		day = "廿" + chineseDigits[c.Day-20]
+	default:
+		day = "三十"
+	}
+
+	return year + "年" + month + day
+}
+
+// String returns the date in English
+func (c ChineseDate) String() string {
+	return c.Format(English)
+}
+
+func chineseLeapMonth(year int) int {
+	return int(chineseYears[year-chineseFirstYear] >> 13)
+}
+
+func chineseMonthCount(year int) int {
+	if chineseLeapMonth(year) != 0 {
+		return 13
+	}
+	return 12
+}
+
+// chineseMonthDays returns the length of the month at idx in the year
+// counting the leap month
+func chineseMonthDays(year, idx int) int {
+	if chineseYears[year-chineseFirstYear]&(1<<idx) != 0 {
+		return 30
+	}
+	return 29
+}
@@ -0,0 +1,124 @@
+package calendars_test
+
+import (
+	"testing"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/calendars"
+)
+
+func TestChinese(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Date    chrono.Date
+		Chinese calendars.ChineseDate
+	}{
+		{chrono.NewDate(1900, 1, 31), calendars.ChineseDate{Year: 1900, Month: 1, Day: 1}},
+		{chrono.NewDate(2024, 2, 10), calendars.ChineseDate{Year: 2024, Month: 1, Day: 1}},
+		{chrono.NewDate(2024, 9, 17), calendars.ChineseDate{Year: 2024, Month: 8, Day: 15}},
+		{chrono.NewDate(2025, 1, 28), calendars.ChineseDate{Year: 2024, Month: 12, Day: 29}},
+		{chrono.NewDate(2023, 3, 21), calendars.ChineseDate{Year: 2023, Month: 2, Day: 30}},
+		{chrono.NewDate(2023, 3, 22), calendars.ChineseDate{Year: 2023, Month: 2, LeapMonth: true, Day: 1}},
+		{chrono.NewDate(2023, 4, 20), calendars.ChineseDate{Year: 2023, Month: 3, Day: 1}},
+		{chrono.NewDate(2101, 1, 28), calendars.ChineseDate{Year: 2100, Month: 12, Day: 29}},
+	}
+
+	for _, test := range tests {
+		got, ok := calendars.ToChinese(test.Date)
+		if !ok || got != test.Chinese {
+			t.Errorf("%v: want: %v, got: %v", test.Date, test.Chinese, got)
+		}
+		if got, ok := test.Chinese.Date(); !ok || !got.Equal(test.Date) {
+			t.Errorf("%v: want: %v, got: %v", test.Chinese, test.Date, got)
+		}
+	}
+
+	if _, ok := calendars.ToChinese(chrono.NewDate(1900, 1, 30)); ok {
+		t.Error("dates before the table should not convert")
+	}
+	if _, ok := (calendars.ChineseDate{Year: 2024, Month: 2, LeapMonth: true, Day: 1}).Date(); ok {
+		t.Error("2024 has no leap month")
+	}
+	if _, ok := (calendars.ChineseDate{Year: 2024, Month: 1, Day: 30}).Date(); ok {
+		t.Error("the first month of 2024 has 29 days")
+	}
+}
+
+func TestChineseRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for d := chrono.NewDate(1900, 1, 31); d.Before(chrono.NewDate(2101, 1, 29)); d = d.AddDate(0, 0, 1) {
+		c, ok := calendars.ToChinese(d)
+		if !ok {
+			t.Fatalf("%v: should convert", d)
+		}
+		if got, ok := c.Date(); !ok || !got.Equal(d) {
+			t.Fatalf("%v: round trip wrong: %v", d, got)
+		}
+	}
+}
+
+func TestLunarNewYear(t *testing.T) {
+	t.Parallel()
+
+	tests := map[int]chrono.Date{
+		1900: chrono.NewDate(1900, 1, 31),
+		2000: chrono.NewDate(2000, 2, 5),
+		2023: chrono.NewDate(2023, 1, 22),
+		2024: chrono.NewDate(2024, 2, 10),
+		2025: chrono.NewDate(2025, 1, 29),
+	}
+	for year, want := range tests {
+		if got, ok := calendars.LunarNewYear(year); !ok || !got.Equal(want) {
+			t.Errorf("%d: want: %v, got: %v", year, want, got)
+		}
+	}
+	if _, ok := calendars.LunarNewYear(2101); ok {
+		t.Error("2101 is outside of the table")
+	}
+}
+
+func TestZodiac(t *testing.T) {
+	t.Parallel()
+
+	if got := calendars.ZodiacOf(2024); got != calendars.Dragon {
+		t.Error("2024 wrong:", got)
+	}
+	if got := calendars.ZodiacOf(1900); got != calendars.Rat {
+		t.Error("1900 wrong:", got)
+	}
+
+	c, _ := calendars.ToChinese(chrono.NewDate(2024, 2, 9))
+	if got := c.Zodiac(); got != calendars.Rabbit {
+		t.Error("the day before new year should still be the rabbit:", got)
+	}
+	if got := calendars.Pig.String(); got != "Pig" {
+		t.Error("name wrong:", got)
+	}
+}
+
+func TestChineseFormat(t *testing.T) {
+	t.Parallel()
+
+	c := calendars.ChineseDate{Year: 2024, Month: 8, Day: 15}
+	if got := c.Format(calendars.English); got != "Month 8 Day 15, Year of the Dragon (2024)" {
+		t.Error("english wrong:", got)
+	}
+	if got := c.Format(calendars.Native); got != "甲辰年八月十五" {
+		t.Error("chinese wrong:", got)
+	}
+
+	c = calendars.ChineseDate{Year: 2023, Month: 2, LeapMonth: true, Day: 1}
+	if got := c.String(); got != "Leap Month 2 Day 1, Year of the Rabbit (2023)" {
+		t.Error("leap english wrong:", got)
+	}
+	if got := c.Format(calendars.Native); got != "癸卯年闰二月初一" {
+		t.Error("leap chinese wrong:", got)
+	}
+
+	c = calendars.ChineseDate{Year: 2024, Month: 12, Day: 21}
+	if got := c.Format(calendars.Native); got != "甲辰年腊月廿一" {
+		t.Error("twelfth month wrong:", got)
+	}
+}
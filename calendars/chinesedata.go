@@ -0,0 +1,45 @@
+package calendars
+
+// The Chinese calendar's months begin on the new moon and leap months are
+// placed by the solar terms, both as observed in China (UTC+8). That makes it
+// astronomical, so the months are tabulated here for the lunar years 1900 to
+// 2100. Bit n of each entry is set when month n+1 of the year (counting the
+// leap month) has 30 days, otherwise it has 29. Bits 13-16 are the month the
+// leap month follows, or 0 if the year has none.
+
+const (
+	chineseFirstYear = 1900
+	chineseLastYear  = 2100
+	// chineseEpochDays is the first day of the lunar year 1900, 1900-01-31,
+	// in days since 1970-01-01
+	chineseEpochDays = -25537
+)
+
+var chineseYears = [chineseLastYear - chineseFirstYear + 1]uint32{
+	0x116d2, 0x00752, 0x00ea5, 0x0b64a, 0x0064b, 0x00a9b, 0x09556, 0x0056a,
+	0x00b59, 0x05752, 0x00752, 0x0db25, 0x00b25, 0x00a4b, 0x0b4ab, 0x002ad,
+	0x0056b, 0x06b69, 0x00da9, 0x0fd92, 0x00e92, 0x00d25, 0x0da4d, 0x00a56,
+	0x002b6, 0x095b5, 0x006d4, 0x00ea9, 0x05e92, 0x00e92, 0x0cd26, 0x0052b,
+	0x00a57, 0x0b2b6, 0x00b5a, 0x006d4, 0x06ec9, 0x00749, 0x0f693, 0x00a93,
+	0x0052b, 0x0ca5b, 0x00aad, 0x0056a, 0x09b55, 0x00ba4, 0x00b49, 0x05a93,
+	0x00a95, 0x0f52d, 0x00536, 0x00aad, 0x0b5aa, 0x00db2, 0x00da4, 0x07d49,
+	0x00d4a, 0x10a95, 0x00a97, 0x00556, 0x0cab5, 0x00ad5, 0x006d2, 0x08ea5,
+	0x00ea5, 0x0064a, 0x06c97, 0x00a9b, 0x0f55a, 0x0056a, 0x00b69, 0x0b752,
+	0x00b52, 0x00b25, 0x0964b, 0x00a4b, 0x114ab, 0x002ad, 0x0056d, 0x0cb69,
+	0x00da9, 0x00d92, 0x09d25, 0x00d25, 0x15a4d, 0x00a56, 0x002b6, 0x0e5b5,
+	0x006d5, 0x00ea9, 0x0be92, 0x00e92, 0x00d26, 0x06a56, 0x00a57, 0x114d6,
+	0x0035a, 0x006d5, 0x0aec9, 0x00749, 0x00693, 0x0952b, 0x0052b, 0x00a5b,
+	0x0555a, 0x0056a, 0x0fb55, 0x00ba4, 0x00b49, 0x0ba93, 0x00a95, 0x0052d,
+	0x08a6d, 0x00ab5, 0x135aa, 0x005d2, 0x00da5, 0x0dd4a, 0x00e4a, 0x00c95,
+	0x0952e, 0x00556, 0x00ab5, 0x055b2, 0x006d2, 0x0cea5, 0x00f25, 0x0064a,
+	0x0ac97, 0x004ab, 0x0055b, 0x06ad6, 0x00b69, 0x17752, 0x00b52, 0x00b25,
+	0x0da4b, 0x00a4b, 0x004ab, 0x0a55b, 0x005ad, 0x00b6a, 0x05b52, 0x00d92,
+	0x0fd25, 0x00d25, 0x00a55, 0x0b4ad, 0x004b6, 0x005b5, 0x06daa, 0x00ec9,
+	0x11e92, 0x00e92, 0x00d26, 0x0ca56, 0x00a57, 0x004d6, 0x086d5, 0x00755,
+	0x00749, 0x06e93, 0x00693, 0x0f52b, 0x0052b, 0x00a5b, 0x0b55a, 0x0056a,
+	0x00b65, 0x0974a, 0x00b49, 0x11a95, 0x00a95, 0x0052d, 0x0caad, 0x00ab5,
+	0x005aa, 0x08ba5, 0x00da5, 0x00d4a, 0x07c95, 0x00c96, 0x0f94e, 0x00556,
+	0x00ab5, 0x0b5b2, 0x006d2, 0x00ea5, 0x08e4a, 0x0068b, 0x10c97, 0x004ab,
+	0x0055b, 0x0cad6, 0x00b6a, 0x00752, 0x09725, 0x00b45, 0x00a8b, 0x0549b,
+	0x004ab,
+}
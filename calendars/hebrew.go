@@ -0,0 +1,228 @@
+package calendars
+
+import (
+	"fmt"
+
+	"github.com/aarondl/chrono"
+)
+
+// HebrewMonth is a month of the Hebrew calendar. Months are numbered from
+// Nisan as in the Torah, the year itself begins on 1 Tishri.
+type HebrewMonth int
+
+// Hebrew months, AdarII only exists in leap years during which Adar is
+// known as Adar I
+const (
+	Nisan HebrewMonth = 1 + iota
+	Iyyar
+	Sivan
+	Tammuz
+	Av
+	Elul
+	Tishri
+	Marheshvan
+	Kislev
+	Tevet
+	Shevat
+	Adar
+	AdarII
+)
+
+var hebrewMonthNames = [...][2]string{
+	{"Nisan", "ניסן"},
+	{"Iyyar", "אייר"},
+	{"Sivan", "סיון"},
+	{"Tammuz", "תמוז"},
+	{"Av", "אב"},
+	{"Elul", "אלול"},
+	{"Tishri", "תשרי"},
+	{"Marheshvan", "חשון"},
+	{"Kislev", "כסלו"},
+	{"Tevet", "טבת"},
+	{"Shevat", "שבט"},
+	{"Adar", "אדר"},
+	{"Adar II", "אדר ב׳"},
+}
+
+// String returns the English name of the month
+func (m HebrewMonth) String() string {
+	if m < Nisan || m > AdarII {
+		return fmt.Sprintf("%%!HebrewMonth(%d)", int(m))
+	}
+	return hebrewMonthNames[m-1][0]
+}
+
+const (
+	// hebrewEpochRataDie is 1 Tishri AM 1 in the proleptic Julian calendar
+	// (3761 BCE) as a Rata Die
+	hebrewEpochRataDie = -1373427
+	// hebrewPartsPerDay divide the day into 1080 parts per hour
+	hebrewPartsPerDay = 25920
+)
+
+// HebrewDate is a date in the Hebrew calendar
+type HebrewDate struct {
+	Year  int
+	Month HebrewMonth
+	Day   int
+}
+
+// ToHebrew converts d to the Hebrew calendar
+func ToHebrew(d chrono.Date) HebrewDate {
+	rd := d.RataDie()
+
+	// Underestimate the year using the mean year length then correct it
+	year := floorDiv((rd-hebrewEpochRataDie)*98496, 35975351) - 1
+	for hebrewNewYear(year+1) <= rd {
+		year++
+	}
+
+	month := Tishri
+	if rd >= hebrewToRataDie(year, Nisan, 1) {
+		month = Nisan
+	}
+	for rd > hebrewToRataDie(year, month, hebrewMonthDays(year, month)) {
+		month++
+	}
+
+	return HebrewDate{
+		Year:  year,
+		Month: month,
+		Day:   rd - hebrewToRataDie(year, month, 1) + 1,
+	}
+}
+
+// Date converts h to a chrono.Date
+func (h HebrewDate) Date() chrono.Date {
+	return chrono.DateFromRataDie(hebrewToRataDie(h.Year, h.Month, h.Day))
+}
+
+// IsLeapYear returns true if h is in a year with 13 months
+func (h HebrewDate) IsLeapYear() bool {
+	return hebrewLeapYear(h.Year)
+}
+
+// Format writes the date as day, month and year followed by the era, eg.
+// "1 Tishri 5785 AM". In leap years Adar is written as Adar I.
+func (h HebrewDate) Format(lang Language) string {
+	if h.Month < Nisan || h.Month > AdarII {
+		return fmt.Sprintf("%d %s %d", h.Day, h.Month, h.Year)
+	}
+
+	i := 0
+	if lang == Native {
+		i = 1
+	}
+	name := hebrewMonthNames[h.Month-1][i]
+	if h.Month == Adar && hebrewLeapYear(h.Year) {
+		name = [2]string{"Adar I", "אדר א׳"}[i]
+	}
+
+	if lang == Native {
+		return fmt.Sprintf("%d ב%s %d", h.Day, name, h.Year)
+	}
+	return fmt.Sprintf("%d %s %d AM", h.Day, name, h.Year)
+}
+
+// String returns the date in English
+func (h HebrewDate) String() string {
+	return h.Format(English)
+}
+
+// hebrewLeapYear returns true for the 7 years of each 19 year cycle with
+// an extra month
+func hebrewLeapYear(year int) bool {
+	return mod(7*year+1, 19) < 7
+}
+
+// hebrewElapsedDays returns the days from the epoch to the molad of Tishri
+// of year, postponed if it falls on a Sunday, Wednesday or Friday
+func hebrewElapsedDays(year int) int {
+	monthsElapsed := floorDiv(235*year-234, 19)
+	partsElapsed := 12084 + 13753*monthsElapsed
+	days := 29*monthsElapsed + floorDiv(partsElapsed, hebrewPartsPerDay)
+	if mod(3*(days+1), 7) < 3 {
+		return days + 1
+	}
+	return days
+}
+
+// hebrewNewYear returns the Rata Die of 1 Tishri of year, including the
+// postponements that keep the year lengths valid
+func hebrewNewYear(year int) int {
+	prev, cur, next := hebrewElapsedDays(year-1), hebrewElapsedDays(year), hebrewElapsedDays(year+1)
+	delay := 0
+	switch {
+	case next-cur == 356:
+		delay = 2
+	case cur-prev == 382:
+		delay = 1
+	}
+	return hebrewEpochRataDie + cur + delay
+}
+
+func hebrewYearDays(year int) int {
+	return hebrewNewYear(year+1) - hebrewNewYear(year)
+}
+
+func hebrewMonthDays(year int, month HebrewMonth) int {
+	switch month {
+	case Iyyar, Tammuz, Elul, Tevet, AdarII:
+		return 29
+	case Adar:
+		if !hebrewLeapYear(year) {
+			return 29
+		}
+	case Marheshvan:
+		// Long in complete years
+		if days := hebrewYearDays(year); days != 355 && days != 385 {
+			return 29
+		}
+	case Kislev:
+		// Short in deficient years
+		if days := hebrewYearDays(year); days == 353 || days == 383 {
+			return 29
+		}
+	}
+	return 30
+}
+
+func hebrewLastMonth(year int) HebrewMonth {
+	if hebrewLeapYear(year) {
+		return AdarII
+	}
+	return Adar
+}
+
+func hebrewToRataDie(year int, month HebrewMonth, day int) int {
+	rd := hebrewNewYear(year) + day - 1
+	if month < Tishri {
+		for m := Tishri; m <= hebrewLastMonth(year); m++ {
+			rd += hebrewMonthDays(year, m)
+		}
+		for m := Nisan; m < month; m++ {
+			rd += hebrewMonthDays(year, m)
+		}
+	} else {
+		for m := Tishri; m < month; m++ {
+			rd += hebrewMonthDays(year, m)
+		}
+	}
+	return rd
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+func mod(a, b int) int {
+	m := a % b
+	if m < 0 {
+		m += b
+	}
+	return m
+}
@@ -0,0 +1,75 @@
+package calendars_test
+
+import (
+	"testing"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/calendars"
+)
+
+func TestHebrew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Date   chrono.Date
+		Hebrew calendars.HebrewDate
+	}{
+		{chrono.NewDate(2024, 10, 3), calendars.HebrewDate{Year: 5785, Month: calendars.Tishri, Day: 1}},
+		{chrono.NewDate(2024, 3, 11), calendars.HebrewDate{Year: 5784, Month: calendars.AdarII, Day: 1}},
+		{chrono.NewDate(2024, 4, 9), calendars.HebrewDate{Year: 5784, Month: calendars.Nisan, Day: 1}},
+		{chrono.NewDate(2023, 10, 16), calendars.HebrewDate{Year: 5784, Month: calendars.Marheshvan, Day: 1}},
+		{chrono.NewDate(2022, 7, 30), calendars.HebrewDate{Year: 5782, Month: calendars.Av, Day: 2}},
+		{chrono.NewDate(1900, 1, 1), calendars.HebrewDate{Year: 5660, Month: calendars.Shevat, Day: 1}},
+		{chrono.NewDate(1850, 1, 1), calendars.HebrewDate{Year: 5610, Month: calendars.Tevet, Day: 17}},
+		// The molad of Tishri 5807 is on a Sunday so Rosh Hashanah is
+		// postponed to Monday
+		{chrono.NewDate(2046, 10, 1), calendars.HebrewDate{Year: 5807, Month: calendars.Tishri, Day: 1}},
+	}
+
+	for _, test := range tests {
+		if got := calendars.ToHebrew(test.Date); got != test.Hebrew {
+			t.Errorf("%v: want: %v, got: %v", test.Date, test.Hebrew, got)
+		}
+		if got := test.Hebrew.Date(); !got.Equal(test.Date) {
+			t.Errorf("%v: want: %v, got: %v", test.Hebrew, test.Date, got)
+		}
+	}
+}
+
+func TestHebrewRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for d := chrono.NewDate(1900, 1, 1); d.Before(chrono.NewDate(2100, 1, 1)); d = d.AddDate(0, 0, 1) {
+		h := calendars.ToHebrew(d)
+		if h.Day < 1 || h.Day > 30 || h.Month < calendars.Nisan || h.Month > calendars.AdarII {
+			t.Fatalf("%v: invalid date: %#v", d, h)
+		}
+		if got := h.Date(); !got.Equal(d) {
+			t.Fatalf("%v: round trip wrong: %v", d, got)
+		}
+	}
+}
+
+func TestHebrewFormat(t *testing.T) {
+	t.Parallel()
+
+	h := calendars.HebrewDate{Year: 5785, Month: calendars.Tishri, Day: 1}
+	if got := h.Format(calendars.English); got != "1 Tishri 5785 AM" {
+		t.Error("english wrong:", got)
+	}
+	if got := h.Format(calendars.Native); got != "1 בתשרי 5785" {
+		t.Error("hebrew wrong:", got)
+	}
+	if !calendars.ToHebrew(chrono.NewDate(2024, 3, 1)).IsLeapYear() {
+		t.Error("5784 is a leap year")
+	}
+
+	adar := calendars.HebrewDate{Year: 5784, Month: calendars.Adar, Day: 1}
+	if got := adar.String(); got != "1 Adar I 5784 AM" {
+		t.Error("adar in a leap year wrong:", got)
+	}
+	adar.Year = 5785
+	if got := adar.String(); got != "1 Adar 5785 AM" {
+		t.Error("adar wrong:", got)
+	}
+}
@@ -0,0 +1,159 @@
+package calendars
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/aarondl/chrono"
+)
+
+// IslamicMonth is a month of the Islamic calendar
+type IslamicMonth int
+
+// Islamic months
+const (
+	Muharram IslamicMonth = 1 + iota
+	Safar
+	RabiAlAwwal
+	RabiAlThani
+	JumadaAlUla
+	JumadaAlAkhirah
+	Rajab
+	Shaban
+	Ramadan
+	Shawwal
+	DhuAlQadah
+	DhuAlHijjah
+)
+
+var islamicMonthNames = [...][2]string{
+	{"Muharram", "محرم"},
+	{"Safar", "صفر"},
+	{"Rabi al-Awwal", "ربيع الأول"},
+	{"Rabi al-Thani", "ربيع الآخر"},
+	{"Jumada al-Ula", "جمادى الأولى"},
+	{"Jumada al-Akhirah", "جمادى الآخرة"},
+	{"Rajab", "رجب"},
+	{"Shaban", "شعبان"},
+	{"Ramadan", "رمضان"},
+	{"Shawwal", "شوال"},
+	{"Dhu al-Qadah", "ذو القعدة"},
+	{"Dhu al-Hijjah", "ذو الحجة"},
+}
+
+// String returns the English name of the month
+func (m IslamicMonth) String() string {
+	if m < Muharram || m > DhuAlHijjah {
+		return fmt.Sprintf("%%!IslamicMonth(%d)", int(m))
+	}
+	return islamicMonthNames[m-1][0]
+}
+
+// islamicEpochRataDie is 1 Muharram AH 1, 622-07-16 in the proleptic Julian
+// calendar, as a Rata Die
+const islamicEpochRataDie = 227015
+
+// ummAlQuraYearStarts is 1 Muharram of each year in the table and the year
+// after it in days since 1970-01-01
+var ummAlQuraYearStarts [len(ummAlQuraMonths) + 1]int
+
+func init() {
+	ummAlQuraYearStarts[0] = ummAlQuraEpochDays
+	for i, months := range ummAlQuraMonths {
+		ummAlQuraYearStarts[i+1] = ummAlQuraYearStarts[i] + 12*29 + bits.OnesCount16(months)
+	}
+}
+
+// IslamicDate is a date in the Umm al-Qura Islamic calendar. Outside of the
+// years the Umm al-Qura calendar is tabulated for (1300 to 1600 AH) the
+// arithmetic civil calendar is used instead, which can differ by a day or
+// two.
+type IslamicDate struct {
+	Year  int
+	Month IslamicMonth
+	Day   int
+}
+
+// ToIslamic converts d to the Umm al-Qura Islamic calendar
+func ToIslamic(d chrono.Date) IslamicDate {
+	days := d.EpochDays()
+	if days < ummAlQuraEpochDays || days >= ummAlQuraYearStart(ummAlQuraLastYear+1) {
+		return civilFromRataDie(d.RataDie())
+	}
+
+	year := ummAlQuraFirstYear + (days-ummAlQuraEpochDays)*30/10631
+	for year > ummAlQuraFirstYear && ummAlQuraYearStart(year) > days {
+		year--
+	}
+	for ummAlQuraYearStart(year+1) <= days {
+		year++
+	}
+
+	days -= ummAlQuraYearStart(year)
+	month := Muharram
+	for days >= ummAlQuraMonthDays(year, month) {
+		days -= ummAlQuraMonthDays(year, month)
+		month++
+	}
+	return IslamicDate{Year: year, Month: month, Day: days + 1}
+}
+
+// Date converts i to a chrono.Date
+func (i IslamicDate) Date() chrono.Date {
+	if i.Year < ummAlQuraFirstYear || i.Year > ummAlQuraLastYear {
+		return chrono.DateFromRataDie(civilToRataDie(i.Year, i.Month, i.Day))
+	}
+
+	days := ummAlQuraYearStart(i.Year) + i.Day - 1
+	for m := Muharram; m < i.Month; m++ {
+		days += ummAlQuraMonthDays(i.Year, m)
+	}
+	return chrono.DateFromEpochDays(days)
+}
+
+// Format writes the date as day, month and year followed by the era, eg.
+// "1 Ramadan 1445 AH"
+func (i IslamicDate) Format(lang Language) string {
+	if i.Month < Muharram || i.Month > DhuAlHijjah {
+		return fmt.Sprintf("%d %s %d", i.Day, i.Month, i.Year)
+	}
+
+	if lang == Native {
+		return fmt.Sprintf("%d %s %d هـ", i.Day, islamicMonthNames[i.Month-1][1], i.Year)
+	}
+	return fmt.Sprintf("%d %s %d AH", i.Day, islamicMonthNames[i.Month-1][0], i.Year)
+}
+
+// String returns the date in English
+func (i IslamicDate) String() string {
+	return i.Format(English)
+}
+
+// ummAlQuraYearStart returns 1 Muharram of year in days since 1970-01-01,
+// year may be one past the end of the table
+func ummAlQuraYearStart(year int) int {
+	return ummAlQuraYearStarts[year-ummAlQuraFirstYear]
+}
+
+func ummAlQuraMonthDays(year int, month IslamicMonth) int {
+	if ummAlQuraMonths[year-ummAlQuraFirstYear]&(1<<(month-1)) != 0 {
+		return 30
+	}
+	return 29
+}
+
+func civilToRataDie(year int, month IslamicMonth, day int) int {
+	m := int(month)
+	return islamicEpochRataDie - 1 + (year-1)*354 + floorDiv(3+11*year, 30) +
+		29*(m-1) + m/2 + day
+}
+
+func civilFromRataDie(rd int) IslamicDate {
+	year := floorDiv(30*(rd-islamicEpochRataDie)+10646, 10631)
+	month := IslamicMonth(floorDiv(11*(rd-civilToRataDie(year, Muharram, 1))+330, 325))
+	return IslamicDate{
+		Year:  year,
+		Month: month,
+		Day:   rd - civilToRataDie(year, month, 1) + 1,
+	}
+}
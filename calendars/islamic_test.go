@@ -0,0 +1,65 @@
+package calendars_test
+
+import (
+	"testing"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/calendars"
+)
+
+func TestIslamic(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Date    chrono.Date
+		Islamic calendars.IslamicDate
+	}{
+		{chrono.NewDate(2024, 3, 11), calendars.IslamicDate{Year: 1445, Month: calendars.Ramadan, Day: 1}},
+		{chrono.NewDate(2024, 4, 9), calendars.IslamicDate{Year: 1445, Month: calendars.Ramadan, Day: 30}},
+		{chrono.NewDate(2023, 10, 16), calendars.IslamicDate{Year: 1445, Month: calendars.RabiAlThani, Day: 1}},
+		{chrono.NewDate(2022, 7, 30), calendars.IslamicDate{Year: 1444, Month: calendars.Muharram, Day: 1}},
+		{chrono.NewDate(1882, 11, 12), calendars.IslamicDate{Year: 1300, Month: calendars.Muharram, Day: 1}},
+		{chrono.NewDate(1900, 1, 1), calendars.IslamicDate{Year: 1317, Month: calendars.Shaban, Day: 29}},
+		// Outside of the Umm al-Qura table
+		{chrono.NewDate(1850, 1, 1), calendars.IslamicDate{Year: 1266, Month: calendars.Safar, Day: 16}},
+		{chrono.NewDate(2174, 12, 31), calendars.IslamicDate{Year: 1601, Month: calendars.Safar, Day: 6}},
+	}
+
+	for _, test := range tests {
+		if got := calendars.ToIslamic(test.Date); got != test.Islamic {
+			t.Errorf("%v: want: %v, got: %v", test.Date, test.Islamic, got)
+		}
+		if got := test.Islamic.Date(); !got.Equal(test.Date) {
+			t.Errorf("%v: want: %v, got: %v", test.Islamic, test.Date, got)
+		}
+	}
+}
+
+func TestIslamicRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for d := chrono.NewDate(1850, 1, 1); d.Before(chrono.NewDate(2200, 1, 1)); d = d.AddDate(0, 0, 1) {
+		i := calendars.ToIslamic(d)
+		if i.Day < 1 || i.Day > 30 || i.Month < calendars.Muharram || i.Month > calendars.DhuAlHijjah {
+			t.Fatalf("%v: invalid date: %#v", d, i)
+		}
+		if got := i.Date(); !got.Equal(d) {
+			t.Fatalf("%v: round trip wrong: %v", d, got)
+		}
+	}
+}
+
+func TestIslamicFormat(t *testing.T) {
+	t.Parallel()
+
+	i := calendars.IslamicDate{Year: 1445, Month: calendars.Ramadan, Day: 1}
+	if got := i.Format(calendars.English); got != "1 Ramadan 1445 AH" {
+		t.Error("english wrong:", got)
+	}
+	if got := i.Format(calendars.Native); got != "1 رمضان 1445 هـ" {
+		t.Error("arabic wrong:", got)
+	}
+	if got := calendars.IslamicMonth(13).String(); got != "%!IslamicMonth(13)" {
+		t.Error("invalid month wrong:", got)
+	}
+}
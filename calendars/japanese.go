@@ -0,0 +1,82 @@
+package calendars
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+// Era is a Japanese imperial era
+type Era struct {
+	// Name is the romanized name of the era, eg. Reiwa
+	Name string
+	// Kanji is the name of the era in Japanese, eg. 令和
+	Kanji string
+	// Start is the first day of the era
+	Start chrono.Date
+}
+
+// Eras since the Meiji restoration, it's when Japan adopted the Gregorian
+// calendar. Dates before Meiji can't be converted.
+var (
+	Meiji  = Era{Name: "Meiji", Kanji: "明治", Start: chrono.NewDate(1868, 10, 23)}
+	Taisho = Era{Name: "Taishō", Kanji: "大正", Start: chrono.NewDate(1912, 7, 30)}
+	Showa  = Era{Name: "Shōwa", Kanji: "昭和", Start: chrono.NewDate(1926, 12, 25)}
+	Heisei = Era{Name: "Heisei", Kanji: "平成", Start: chrono.NewDate(1989, 1, 8)}
+	Reiwa  = Era{Name: "Reiwa", Kanji: "令和", Start: chrono.NewDate(2019, 5, 1)}
+)
+
+var japaneseEras = []Era{Reiwa, Heisei, Showa, Taisho, Meiji}
+
+// JapaneseDate is a date in the Japanese calendar. It's the Gregorian
+// calendar with years counted from the start of each era, the first year of
+// an era ends on 31 December like any other.
+type JapaneseDate struct {
+	Era   Era
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// ToJapanese converts d to the Japanese calendar. It returns false for
+// dates before the Meiji era.
+func ToJapanese(d chrono.Date) (JapaneseDate, bool) {
+	for _, era := range japaneseEras {
+		if d.AfterOrEqual(era.Start) {
+			year, month, day := d.Date()
+			return JapaneseDate{
+				Era:   era,
+				Year:  year - era.Start.Year() + 1,
+				Month: month,
+				Day:   day,
+			}, true
+		}
+	}
+	return JapaneseDate{}, false
+}
+
+// Date converts j to a chrono.Date
+func (j JapaneseDate) Date() chrono.Date {
+	return chrono.NewDate(j.Era.Start.Year()+j.Year-1, j.Month, j.Day)
+}
+
+// Format writes the date with the era, eg. "Reiwa 6, May 1" in English
+// or "令和6年5月1日" in Japanese where the first year of an era is written as
+// 元年 (gannen).
+func (j JapaneseDate) Format(lang Language) string {
+	if lang == Native {
+		year := strconv.Itoa(j.Year)
+		if j.Year == 1 {
+			year = "元"
+		}
+		return fmt.Sprintf("%s%s年%d月%d日", j.Era.Kanji, year, int(j.Month), j.Day)
+	}
+	return fmt.Sprintf("%s %d, %s %d", j.Era.Name, j.Year, j.Month, j.Day)
+}
+
+// String returns the date in English
+func (j JapaneseDate) String() string {
+	return j.Format(English)
+}
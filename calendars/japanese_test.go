@@ -0,0 +1,63 @@
+package calendars_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/calendars"
+)
+
+func TestJapanese(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Date chrono.Date
+		Era  calendars.Era
+		Year int
+	}{
+		{chrono.NewDate(2019, 5, 1), calendars.Reiwa, 1},
+		{chrono.NewDate(2019, 4, 30), calendars.Heisei, 31},
+		{chrono.NewDate(2024, 6, 1), calendars.Reiwa, 6},
+		{chrono.NewDate(1989, 1, 7), calendars.Showa, 64},
+		{chrono.NewDate(1989, 1, 8), calendars.Heisei, 1},
+		{chrono.NewDate(1926, 12, 25), calendars.Showa, 1},
+		{chrono.NewDate(1912, 7, 30), calendars.Taisho, 1},
+		{chrono.NewDate(1900, 1, 1), calendars.Meiji, 33},
+	}
+
+	for _, test := range tests {
+		got, ok := calendars.ToJapanese(test.Date)
+		if !ok {
+			t.Errorf("%v: expected a japanese date", test.Date)
+			continue
+		}
+		if got.Era != test.Era || got.Year != test.Year || got.Month != test.Date.Month() || got.Day != test.Date.Day() {
+			t.Errorf("%v: want: %s %d, got: %v", test.Date, test.Era.Name, test.Year, got)
+		}
+		if d := got.Date(); !d.Equal(test.Date) {
+			t.Errorf("%v: round trip wrong: %v", test.Date, d)
+		}
+	}
+
+	if _, ok := calendars.ToJapanese(chrono.NewDate(1868, 10, 22)); ok {
+		t.Error("dates before meiji should not convert")
+	}
+}
+
+func TestJapaneseFormat(t *testing.T) {
+	t.Parallel()
+
+	j := calendars.JapaneseDate{Era: calendars.Reiwa, Year: 6, Month: time.May, Day: 1}
+	if got := j.Format(calendars.English); got != "Reiwa 6, May 1" {
+		t.Error("english wrong:", got)
+	}
+	if got := j.Format(calendars.Native); got != "令和6年5月1日" {
+		t.Error("japanese wrong:", got)
+	}
+
+	j.Year = 1
+	if got := j.Format(calendars.Native); got != "令和元年5月1日" {
+		t.Error("first year wrong:", got)
+	}
+}
@@ -0,0 +1,132 @@
+package chrono
+
+import "time"
+
+// CalendarUnit is a unit of time that can be used to align DateTimes to
+// boundaries on the wall clock or calendar, eg. the top of the hour or the
+// first of the month.
+type CalendarUnit int
+
+// Calendar units
+const (
+	Second CalendarUnit = iota
+	Minute
+	Hour
+	Day
+	// Week boundaries are Mondays according to ISO8601
+	Week
+	Month
+	Year
+)
+
+// String returns the name of the unit
+func (c CalendarUnit) String() string {
+	switch c {
+	case Second:
+		return "Second"
+	case Minute:
+		return "Minute"
+	case Hour:
+		return "Hour"
+	case Day:
+		return "Day"
+	case Week:
+		return "Week"
+	case Month:
+		return "Month"
+	case Year:
+		return "Year"
+	default:
+		return "CalendarUnit(unknown)"
+	}
+}
+
+// StartOf returns the start of the unit that d falls in, in the location loc.
+// For example the start of the Day is midnight of the date d falls on in loc.
+func (d DateTime) StartOf(unit CalendarUnit, loc *time.Location) DateTime {
+	return DateTime{t: startOf(d.t.In(loc), unit)}
+}
+
+// AddUnits adds n of the unit to d. Units of Day and larger are added on the
+// calendar in d's location, keeping the wall clock the same, while smaller
+// units are exact durations.
+func (d DateTime) AddUnits(unit CalendarUnit, n int) DateTime {
+	return DateTime{t: addUnits(d.t, unit, n)}
+}
+
+// startOf truncates t to the start of the unit in t's location
+func startOf(t time.Time, unit CalendarUnit) time.Time {
+	year, month, day := t.Date()
+	loc := t.Location()
+
+	switch unit {
+	case Second:
+		return t.Add(-time.Duration(t.Nanosecond()))
+	case Minute:
+		return t.Add(-time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+	case Hour:
+		// Subtracting rather than calling time.Date keeps us in the right
+		// hour when the wall clock repeats at the end of DST
+		return t.Add(-time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+	case Day:
+		return startOfDay(year, month, day, loc)
+	case Week:
+		offset := (int(t.Weekday()) + 6) % 7
+		return startOfDay(year, month, day-offset, loc)
+	case Month:
+		return startOfDay(year, month, 1, loc)
+	default:
+		return startOfDay(year, 1, 1, loc)
+	}
+}
+
+// startOfDay returns the first instant of the date in loc. That's midnight
+// except where a DST transition skips it, eg. America/Santiago goes from
+// 23:59:59 straight to 01:00, and time.Date would give 23:00 the day before.
+// The date is normalized like time.Date.
+func startOfDay(year int, month time.Month, day int, loc *time.Location) time.Time {
+	want := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	t := time.Date(year, month, day, 0, 0, 0, 0, loc)
+
+	onOrAfter := func(u time.Time) bool {
+		y, m, d := u.In(loc).Date()
+		return !time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Before(want)
+	}
+	if h, m, s := t.Clock(); h == 0 && m == 0 && s == 0 && onOrAfter(t) && !onOrAfter(t.Add(-time.Second)) {
+		return t
+	}
+
+	// Transitions happen on whole seconds so search for the first second that
+	// is on the date, they're never more than a day away from time.Date's
+	// answer
+	lo, hi := t.Add(-48*time.Hour).Unix(), t.Add(48*time.Hour).Unix()
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		if onOrAfter(time.Unix(mid, 0)) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return time.Unix(lo, 0).In(loc)
+}
+
+// addUnits adds n units to t
+func addUnits(t time.Time, unit CalendarUnit, n int) time.Time {
+	switch unit {
+	case Second:
+		return t.Add(time.Duration(n) * time.Second)
+	case Minute:
+		return t.Add(time.Duration(n) * time.Minute)
+	case Hour:
+		return t.Add(time.Duration(n) * time.Hour)
+	case Day:
+		return t.AddDate(0, 0, n)
+	case Week:
+		return t.AddDate(0, 0, 7*n)
+	case Month:
+		return t.AddDate(0, n, 0)
+	default:
+		return t.AddDate(n, 0, 0)
+	}
+}
@@ -0,0 +1,70 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestStartOf(t *testing.T) {
+	t.Parallel()
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2022-06-15 is a Wednesday
+	ref := chrono.NewDateTime(2022, 6, 15, 13, 14, 15, 16, ny)
+	tests := []struct {
+		Unit chrono.CalendarUnit
+		Want chrono.DateTime
+	}{
+		{chrono.Second, chrono.NewDateTime(2022, 6, 15, 13, 14, 15, 0, ny)},
+		{chrono.Minute, chrono.NewDateTime(2022, 6, 15, 13, 14, 0, 0, ny)},
+		{chrono.Hour, chrono.NewDateTime(2022, 6, 15, 13, 0, 0, 0, ny)},
+		{chrono.Day, chrono.NewDateTime(2022, 6, 15, 0, 0, 0, 0, ny)},
+		{chrono.Week, chrono.NewDateTime(2022, 6, 13, 0, 0, 0, 0, ny)},
+		{chrono.Month, chrono.NewDateTime(2022, 6, 1, 0, 0, 0, 0, ny)},
+		{chrono.Year, chrono.NewDateTime(2022, 1, 1, 0, 0, 0, 0, ny)},
+	}
+
+	for _, test := range tests {
+		if got := ref.UTC().StartOf(test.Unit, ny); !got.Equal(test.Want) {
+			t.Errorf("%s: want %s, got %s", test.Unit, test.Want, got)
+		}
+	}
+
+	// During the repeated hour at the end of DST the start of the hour must
+	// stay in the same offset
+	second := chrono.NewDateTime(2022, 11, 6, 6, 30, 0, 0, time.UTC).In(ny)
+	if got := second.StartOf(chrono.Hour, ny); got.Sub(second) != -30*time.Minute {
+		t.Error("start of repeated hour wrong", got)
+	}
+}
+
+func TestAddUnits(t *testing.T) {
+	t.Parallel()
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// DST starts on 2022-03-13 so that day is only 23 hours long
+	ref := chrono.NewDateTime(2022, 3, 12, 12, 0, 0, 0, ny)
+	if got := ref.AddUnits(chrono.Day, 1); !got.Equal(chrono.NewDateTime(2022, 3, 13, 12, 0, 0, 0, ny)) {
+		t.Error("value wrong", got)
+	}
+	if got := ref.AddUnits(chrono.Hour, 24); !got.Equal(chrono.NewDateTime(2022, 3, 13, 13, 0, 0, 0, ny)) {
+		t.Error("value wrong", got)
+	}
+	if got := ref.AddUnits(chrono.Month, -3); !got.Equal(chrono.NewDateTime(2021, 12, 12, 12, 0, 0, 0, ny)) {
+		t.Error("value wrong", got)
+	}
+	if chrono.Week.String() != "Week" {
+		t.Error("string wrong")
+	}
+}
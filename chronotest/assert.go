@@ -0,0 +1,54 @@
+package chronotest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+// WithinDuration returns true if a and b are no more than tolerance apart
+func WithinDuration(a, b chrono.DateTime, tolerance time.Duration) bool {
+	diff := a.Sub(b)
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff <= tolerance
+}
+
+// AssertEqualDate fails the test if want and got are not the same date
+func AssertEqualDate(t testing.TB, want, got chrono.Date) bool {
+	t.Helper()
+	if !want.Equal(got) {
+		t.Errorf("dates not equal:\nwant: %s\n got: %s", want, got)
+		return false
+	}
+	return true
+}
+
+// AssertEqualTime fails the test if want and got are more than tolerance
+// apart. Times are compared on the same day so 23:59 and 00:01 are nearly a
+// full day apart.
+func AssertEqualTime(t testing.TB, want, got chrono.Time, tolerance time.Duration) bool {
+	t.Helper()
+	diff := want.Sub(got)
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff > tolerance {
+		t.Errorf("times not equal (tolerance %s):\nwant: %s\n got: %s\ndiff: %s", tolerance, want, got, diff)
+		return false
+	}
+	return true
+}
+
+// AssertEqualDateTime fails the test if want and got are more than tolerance
+// apart. Locations are ignored, only the instant in time is compared.
+func AssertEqualDateTime(t testing.TB, want, got chrono.DateTime, tolerance time.Duration) bool {
+	t.Helper()
+	if !WithinDuration(want, got, tolerance) {
+		t.Errorf("datetimes not equal (tolerance %s):\nwant: %s\n got: %s\ndiff: %s", tolerance, want, got, got.Sub(want))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package chronotest_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/chronotest"
+)
+
+// recorder captures failures instead of failing the real test
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertEqualDateTime(t *testing.T) {
+	t.Parallel()
+
+	a := chrono.NewDateTime(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	b := a.Add(500 * time.Millisecond).In(time.FixedZone("", 3600))
+
+	r := &recorder{}
+	if !chronotest.AssertEqualDateTime(r, a, b, time.Second) {
+		t.Error("expected equal within a second")
+	}
+	if chronotest.AssertEqualDateTime(r, a, b, time.Millisecond) {
+		t.Error("expected not equal within a millisecond")
+	}
+	if len(r.errors) != 1 {
+		t.Error("expected one error, got", r.errors)
+	}
+
+	if !chronotest.WithinDuration(b, a, time.Second) || chronotest.WithinDuration(b, a, 0) {
+		t.Error("within duration wrong")
+	}
+}
+
+func TestAssertEqualDateAndTime(t *testing.T) {
+	t.Parallel()
+
+	r := &recorder{}
+	if !chronotest.AssertEqualDate(r, chrono.NewDate(2020, 1, 1), chrono.NewDate(2020, 1, 1)) {
+		t.Error("expected equal")
+	}
+	if chronotest.AssertEqualDate(r, chrono.NewDate(2020, 1, 1), chrono.NewDate(2020, 1, 2)) {
+		t.Error("expected not equal")
+	}
+
+	a, b := chrono.NewTime(1, 0, 0, 0, time.UTC), chrono.NewTime(1, 0, 30, 0, time.UTC)
+	if !chronotest.AssertEqualTime(r, a, b, time.Minute) {
+		t.Error("expected equal")
+	}
+	if chronotest.AssertEqualTime(r, b, a, time.Second) {
+		t.Error("expected not equal")
+	}
+
+	if len(r.errors) != 2 {
+		t.Error("expected two errors, got", r.errors)
+	}
+}
@@ -0,0 +1,106 @@
+package chronotest
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+// Clock is a chrono.TimerClock that only moves when told to. It is safe for
+// concurrent use so the code under test can wait on it in other goroutines.
+type Clock struct {
+	mut    sync.Mutex
+	cond   *sync.Cond
+	now    chrono.DateTime
+	timers []timer
+}
+
+type timer struct {
+	at chrono.DateTime
+	ch chan time.Time
+}
+
+// NewClock creates a clock stopped at now
+func NewClock(now chrono.DateTime) *Clock {
+	c := &Clock{now: now}
+	c.cond = sync.NewCond(&c.mut)
+	return c
+}
+
+// Now returns the clock's current time
+func (c *Clock) Now() chrono.DateTime {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return c.now
+}
+
+// After returns a channel that receives the clock's time once it has been
+// advanced by at least d. A non-positive d fires immediately.
+func (c *Clock) After(d time.Duration) <-chan time.Time {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now.ToStdTime()
+		return ch
+	}
+
+	c.timers = append(c.timers, timer{at: c.now.Add(d), ch: ch})
+	c.cond.Broadcast()
+	return ch
+}
+
+// Advance moves the clock forward by d, firing any timers that are due
+func (c *Clock) Advance(d time.Duration) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.set(c.now.Add(d))
+}
+
+// Set moves the clock to now, firing any timers that are due. Moving the
+// clock backwards does not un-fire timers.
+func (c *Clock) Set(now chrono.DateTime) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.set(now)
+}
+
+// Pending returns the number of timers waiting to fire
+func (c *Clock) Pending() int {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return len(c.timers)
+}
+
+// BlockUntil waits until at least n timers are waiting to fire. This is
+// useful to make sure that a goroutine is waiting on the clock before
+// advancing it.
+func (c *Clock) BlockUntil(n int) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	for len(c.timers) < n {
+		c.cond.Wait()
+	}
+}
+
+// set must be called with the lock held
+func (c *Clock) set(now chrono.DateTime) {
+	c.now = now
+
+	sort.SliceStable(c.timers, func(i, j int) bool {
+		return c.timers[i].at.Before(c.timers[j].at)
+	})
+
+	fired := 0
+	for _, t := range c.timers {
+		if t.at.After(now) {
+			break
+		}
+		t.ch <- now.ToStdTime()
+		fired++
+	}
+	c.timers = c.timers[fired:]
+}
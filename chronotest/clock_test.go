@@ -0,0 +1,66 @@
+package chronotest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/chronotest"
+)
+
+var _ chrono.TimerClock = &chronotest.Clock{}
+
+func TestClock(t *testing.T) {
+	t.Parallel()
+
+	start := chrono.NewDateTime(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := chronotest.NewClock(start)
+
+	short, long := c.After(time.Minute), c.After(time.Hour)
+	if c.Pending() != 2 {
+		t.Error("pending wrong", c.Pending())
+	}
+
+	c.Advance(30 * time.Second)
+	select {
+	case <-short:
+		t.Error("fired too early")
+	default:
+	}
+
+	c.Advance(30 * time.Second)
+	if got := <-short; !got.Equal(start.Add(time.Minute).ToStdTime()) {
+		t.Error("fired with the wrong time", got)
+	}
+
+	c.Set(start.Add(2 * time.Hour))
+	<-long
+	if !c.Now().Equal(start.Add(2 * time.Hour)) {
+		t.Error("now wrong", c.Now())
+	}
+	if c.Pending() != 0 {
+		t.Error("pending wrong", c.Pending())
+	}
+
+	select {
+	case <-c.After(0):
+	default:
+		t.Error("expected immediate fire")
+	}
+}
+
+func TestClockScheduler(t *testing.T) {
+	t.Parallel()
+
+	start := chrono.NewDateTime(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := chronotest.NewClock(start)
+	s := chrono.NewScheduler(c)
+	defer s.Stop()
+
+	ran := make(chan chrono.DateTime, 1)
+	s.At(start.Add(time.Hour), func() { ran <- c.Now() })
+
+	c.BlockUntil(1)
+	c.Advance(time.Hour)
+	chronotest.AssertEqualDateTime(t, start.Add(time.Hour), <-ran, 0)
+}
@@ -0,0 +1,56 @@
+// Package chronotest provides helpers for testing code that uses chrono:
+// random values for property based tests, evenly spaced sequences for
+// fixtures, assertions, and a Clock that is controlled by the test.
+package chronotest
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+// RandomDate returns a date chosen uniformly from the years 0001-9999
+func RandomDate(r *rand.Rand) chrono.Date {
+	return chrono.Date{}.Generate(r, 0).Interface().(chrono.Date)
+}
+
+// RandomTime returns a time of day in UTC with nanosecond precision
+func RandomTime(r *rand.Rand) chrono.Time {
+	return chrono.Time{}.Generate(r, 0).Interface().(chrono.Time)
+}
+
+// RandomDateTime returns a datetime chosen uniformly from the years 0001-9999
+// in either UTC or a random fixed zone.
+func RandomDateTime(r *rand.Rand) chrono.DateTime {
+	return chrono.DateTime{}.Generate(r, 0).Interface().(chrono.DateTime)
+}
+
+// RandomDateBetween returns a date in the inclusive range [start, end]. It
+// panics if end is before start.
+func RandomDateBetween(r *rand.Rand, start, end chrono.Date) chrono.Date {
+	if end.Before(start) {
+		panic("chronotest: end date before start date")
+	}
+	days := (end.Unix() - start.Unix()) / (24 * 60 * 60)
+	return start.AddDate(0, 0, int(r.Int63n(days+1)))
+}
+
+// RandomTimeBetween returns a time in the half-open range [start, end). It
+// panics if end is not after start.
+func RandomTimeBetween(r *rand.Rand, start, end chrono.Time) chrono.Time {
+	if !end.After(start) {
+		panic("chronotest: end time not after start time")
+	}
+	return start.Add(time.Duration(r.Int63n(int64(end.Sub(start)))))
+}
+
+// RandomDateTimeBetween returns a datetime in the half-open range [start,
+// end) in start's location. It panics if end is not after start, and like
+// DateTime.Sub the range can be at most ~292 years.
+func RandomDateTimeBetween(r *rand.Rand, start, end chrono.DateTime) chrono.DateTime {
+	if !end.After(start) {
+		panic("chronotest: end datetime not after start datetime")
+	}
+	return start.Add(time.Duration(r.Int63n(int64(end.Sub(start)))))
+}
@@ -0,0 +1,58 @@
+package chronotest_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/chronotest"
+)
+
+func TestRandomBetween(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+
+	startDate, endDate := chrono.NewDate(2020, 1, 1), chrono.NewDate(2020, 1, 3)
+	seen := make(map[chrono.Date]bool)
+	for i := 0; i < 100; i++ {
+		d := chronotest.RandomDateBetween(r, startDate, endDate)
+		if !d.BetweenOrEqual(startDate, endDate) {
+			t.Error("date out of range", d)
+		}
+		seen[d] = true
+	}
+	if len(seen) != 3 {
+		t.Error("expected every date in the range, got", len(seen))
+	}
+
+	startTime, endTime := chrono.NewTime(9, 0, 0, 0, time.UTC), chrono.NewTime(17, 0, 0, 0, time.UTC)
+	startDT := chrono.NewDateTime(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDT := startDT.Add(time.Hour)
+	for i := 0; i < 100; i++ {
+		if tm := chronotest.RandomTimeBetween(r, startTime, endTime); tm.Before(startTime) || !tm.Before(endTime) {
+			t.Error("time out of range", tm)
+		}
+		if dt := chronotest.RandomDateTimeBetween(r, startDT, endDT); dt.Before(startDT) || !dt.Before(endDT) {
+			t.Error("datetime out of range", dt)
+		}
+	}
+}
+
+func TestRandom(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		if y := chronotest.RandomDate(r).Year(); y < 1 || y > 9999 {
+			t.Error("year out of range", y)
+		}
+		if y := chronotest.RandomDateTime(r).Year(); y < 1 || y > 9999 {
+			t.Error("year out of range", y)
+		}
+		if tm := chronotest.RandomTime(r); tm.ToStdTime().Year() != 0 {
+			t.Error("time not on year 0", tm)
+		}
+	}
+}
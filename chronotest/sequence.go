@@ -0,0 +1,48 @@
+package chronotest
+
+import (
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+// Sequence returns n datetimes starting at start each step after the last,
+// eg. for fixtures of evenly spaced samples. step may be negative.
+func Sequence(start chrono.DateTime, step time.Duration, n int) []chrono.DateTime {
+	if n <= 0 {
+		return nil
+	}
+	out := make([]chrono.DateTime, n)
+	for i := range out {
+		out[i] = start.Add(step * time.Duration(i))
+	}
+	return out
+}
+
+// DateSequence returns n dates starting at start each step days after the
+// last. step may be negative.
+func DateSequence(start chrono.Date, step, n int) []chrono.Date {
+	if n <= 0 {
+		return nil
+	}
+	out := make([]chrono.Date, n)
+	for i := range out {
+		out[i] = start.AddDate(0, 0, step*i)
+	}
+	return out
+}
+
+// MonthSequence returns n dates starting at start each step months after the
+// last. Like chrono.Date.AddMonthsNoOverflow days past the end of a month are
+// clamped to the last day of the month, so a sequence starting on January
+// 31st continues with the last day of February.
+func MonthSequence(start chrono.Date, step, n int) []chrono.Date {
+	if n <= 0 {
+		return nil
+	}
+	out := make([]chrono.Date, n)
+	for i := range out {
+		out[i] = start.AddMonthsNoOverflow(step * i)
+	}
+	return out
+}
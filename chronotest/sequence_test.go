@@ -0,0 +1,75 @@
+package chronotest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/chronotest"
+)
+
+func TestSequence(t *testing.T) {
+	t.Parallel()
+
+	start := chrono.NewDateTime(2020, 1, 1, 23, 0, 0, 0, time.UTC)
+	seq := chronotest.Sequence(start, 30*time.Minute, 3)
+	want := []chrono.DateTime{
+		start,
+		chrono.NewDateTime(2020, 1, 1, 23, 30, 0, 0, time.UTC),
+		chrono.NewDateTime(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	if len(seq) != len(want) {
+		t.Fatal("wrong length:", len(seq))
+	}
+	for i := range want {
+		if !seq[i].Equal(want[i]) {
+			t.Errorf("%d: want %s, got %s", i, want[i], seq[i])
+		}
+	}
+
+	if seq := chronotest.Sequence(start, -time.Hour, 2); !seq[1].Equal(start.Add(-time.Hour)) {
+		t.Error("wrong negative step:", seq)
+	}
+	if seq := chronotest.Sequence(start, time.Hour, 0); seq != nil {
+		t.Error("expected nil:", seq)
+	}
+}
+
+func TestDateSequence(t *testing.T) {
+	t.Parallel()
+
+	seq := chronotest.DateSequence(chrono.NewDate(2020, 2, 27), 2, 3)
+	want := []chrono.Date{chrono.NewDate(2020, 2, 27), chrono.NewDate(2020, 2, 29), chrono.NewDate(2020, 3, 2)}
+	if len(seq) != len(want) {
+		t.Fatal("wrong length:", len(seq))
+	}
+	for i := range want {
+		chronotest.AssertEqualDate(t, want[i], seq[i])
+	}
+
+	if seq := chronotest.DateSequence(chrono.NewDate(2020, 1, 1), 1, -1); seq != nil {
+		t.Error("expected nil:", seq)
+	}
+}
+
+func TestMonthSequence(t *testing.T) {
+	t.Parallel()
+
+	seq := chronotest.MonthSequence(chrono.NewDate(2020, 11, 30), 1, 5)
+	want := []chrono.Date{
+		chrono.NewDate(2020, 11, 30),
+		chrono.NewDate(2020, 12, 30),
+		chrono.NewDate(2021, 1, 30),
+		chrono.NewDate(2021, 2, 28),
+		chrono.NewDate(2021, 3, 30),
+	}
+	if len(seq) != len(want) {
+		t.Fatal("wrong length:", len(seq))
+	}
+	for i := range want {
+		chronotest.AssertEqualDate(t, want[i], seq[i])
+	}
+
+	seq = chronotest.MonthSequence(chrono.NewDate(2020, 3, 31), -1, 2)
+	chronotest.AssertEqualDate(t, chrono.NewDate(2020, 2, 29), seq[1])
+}
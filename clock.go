@@ -0,0 +1,60 @@
+package chrono
+
+import "time"
+
+// Clock is a source of the current time. Functionality in this package that
+// depends on the current time accepts a Clock so that it can be controlled
+// in tests.
+type Clock interface {
+	Now() DateTime
+}
+
+// SystemClock is a Clock that uses time.Now
+type SystemClock struct{}
+
+// Now returns the current local time
+func (SystemClock) Now() DateTime {
+	return DateTime{t: time.Now()}
+}
+
+// ClockFunc is an adapter to allow the use of an ordinary function as a
+// Clock.
+type ClockFunc func() DateTime
+
+// Now calls f()
+func (f ClockFunc) Now() DateTime {
+	return f()
+}
+
+// DefaultClock is the Clock read by the package level functions that return
+// the current time: DateTimeFromNow, DateTimeFromNowIn, DateTimeFromNowUTC,
+// StartOfToday, DateFromNow, Today, Tomorrow, Yesterday, TodayIn, TimeFromNow
+// and InstantFromNow, as well as DateTime.Until, DateTime.Since,
+// NextAlignedTick and the tickers, schedulers and stopwatches created without
+// a clock. It can be replaced to control the current time in tests.
+var DefaultClock Clock = SystemClock{}
+
+// NowLocation is the location DateTimeFromNow, DateFromNow and TimeFromNow
+// return the current time in. It defaults to time.Local, services that only
+// want to deal in UTC can set it to time.UTC so that the server's zone is
+// never captured by accident.
+var NowLocation = time.Local
+
+// now returns the current time from DefaultClock in NowLocation. Converting
+// the location strips the monotonic clock reading so it's skipped for the
+// default of time.Local.
+func now() time.Time {
+	return nowFrom(DefaultClock)
+}
+
+// nowFrom is like now but reads clock, or DefaultClock if clock is nil
+func nowFrom(clock Clock) time.Time {
+	if clock == nil {
+		clock = DefaultClock
+	}
+	t := clock.Now().t
+	if NowLocation == time.Local && t.Location() == time.Local {
+		return t
+	}
+	return t.In(NowLocation)
+}
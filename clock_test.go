@@ -0,0 +1,117 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestClocks(t *testing.T) {
+	t.Parallel()
+
+	before := time.Now()
+	now := chrono.SystemClock{}.Now()
+	if now.ToStdTime().Before(before) {
+		t.Error("system clock should be now")
+	}
+
+	ref := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	var clock chrono.Clock = chrono.ClockFunc(func() chrono.DateTime { return ref })
+	if !clock.Now().Equal(ref) {
+		t.Error("value wrong")
+	}
+}
+
+func TestDefaultClock(t *testing.T) {
+	// Not parallel, changes package level configuration
+	ref := time.Date(2000, 1, 1, 23, 30, 0, 0, time.UTC)
+	oldClock, oldLoc := chrono.DefaultClock, chrono.NowLocation
+	defer func() { chrono.DefaultClock, chrono.NowLocation = oldClock, oldLoc }()
+	chrono.DefaultClock = chrono.ClockFunc(func() chrono.DateTime { return chrono.DateTimeFromStdTime(ref) })
+
+	tokyo := time.FixedZone("JST", 9*60*60)
+	if got := chrono.DateTimeFromNowIn(tokyo); !got.ToStdTime().Equal(ref) || got.Location() != tokyo {
+		t.Error("now in wrong:", got)
+	}
+	if got := chrono.TodayIn(tokyo); !got.Equal(chrono.NewDate(2000, 1, 2)) {
+		t.Error("today in tokyo wrong:", got)
+	}
+	if got := chrono.TodayIn(time.UTC); !got.Equal(chrono.NewDate(2000, 1, 1)) {
+		t.Error("today in utc wrong:", got)
+	}
+	if got := chrono.DateTimeFromNowUTC(); !got.ToStdTime().Equal(ref) || got.Location() != time.UTC {
+		t.Error("now utc wrong:", got)
+	}
+	if got := chrono.InstantFromNow(); got.UnixNano() != ref.UnixNano() {
+		t.Error("instant wrong:", got)
+	}
+
+	chrono.NowLocation = tokyo
+	if got := chrono.DateTimeFromNow(); !got.ToStdTime().Equal(ref) || got.Location() != tokyo {
+		t.Error("now wrong:", got)
+	}
+	if got := chrono.DateFromNow(); !got.Equal(chrono.NewDate(2000, 1, 2)) {
+		t.Error("date from now wrong:", got)
+	}
+	if h, m, _ := chrono.TimeFromNow().Clock(); h != 8 || m != 30 {
+		t.Error("time from now wrong:", h, m)
+	}
+
+	if got := chrono.Today(); !got.Equal(chrono.NewDate(2000, 1, 2)) {
+		t.Error("today wrong:", got)
+	}
+	if got := chrono.Tomorrow(); !got.Equal(chrono.NewDate(2000, 1, 3)) {
+		t.Error("tomorrow wrong:", got)
+	}
+	if got := chrono.Yesterday(); !got.Equal(chrono.NewDate(2000, 1, 1)) {
+		t.Error("yesterday wrong:", got)
+	}
+	if got := chrono.StartOfToday(nil); !got.Equal(chrono.NewDateTime(2000, 1, 2, 0, 0, 0, 0, tokyo)) || got.Location() != tokyo {
+		t.Error("start of today wrong:", got)
+	}
+
+	chrono.NowLocation = time.UTC
+	if got := chrono.DateFromNow(); !got.Equal(chrono.NewDate(2000, 1, 1)) {
+		t.Error("utc date from now wrong:", got)
+	}
+	if got := chrono.Today(); !got.Equal(chrono.NewDate(2000, 1, 1)) {
+		t.Error("utc today wrong:", got)
+	}
+	if got := chrono.StartOfToday(tokyo); !got.Equal(chrono.NewDateTime(2000, 1, 2, 0, 0, 0, 0, tokyo)) {
+		t.Error("start of today in tokyo wrong:", got)
+	}
+	if got := chrono.StartOfToday(time.UTC); !got.Equal(chrono.NewDateTime(2000, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("start of today in utc wrong:", got)
+	}
+}
+
+func TestDefaultClockTimers(t *testing.T) {
+	// Not parallel, changes package level configuration
+	now := time.Date(3000, 1, 1, 12, 30, 0, 0, time.UTC)
+	oldClock := chrono.DefaultClock
+	defer func() { chrono.DefaultClock = oldClock }()
+	chrono.DefaultClock = chrono.ClockFunc(func() chrono.DateTime { return chrono.DateTimeFromStdTime(now) })
+
+	if got := chrono.NextAlignedTick(chrono.Hour, time.UTC); !got.Equal(chrono.NewDateTime(3000, 1, 1, 13, 0, 0, 0, time.UTC)) {
+		t.Error("next tick wrong:", got)
+	}
+
+	sw := chrono.StartStopwatch()
+	now = now.Add(5 * time.Minute)
+	if got := sw.Elapsed(); got != 5*time.Minute {
+		t.Error("elapsed wrong:", got)
+	}
+
+	// In the past according to DefaultClock but centuries away for the
+	// system clock
+	ran := make(chan struct{})
+	s := chrono.NewScheduler(nil)
+	defer s.Stop()
+	s.At(chrono.NewDateTime(2900, 1, 1, 0, 0, 0, 0, time.UTC), func() { close(ran) })
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Error("scheduler should use DefaultClock")
+	}
+}
@@ -0,0 +1,154 @@
+package chrono
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// ErrEnvNotSet is returned (wrapped) by the FromEnv functions when the
+// environment variable does not exist
+var ErrEnvNotSet = errors.New("environment variable not set")
+
+// DateConfigLayouts are the layouts accepted by DateFromConfigString, Set and
+// UnmarshalText, in the order they're tried. It can be changed to accept other
+// formats in your project's config.
+var DateConfigLayouts = []string{
+	dateLayout,
+	"20060102",
+	"2006/01/02",
+}
+
+// TimeConfigLayouts are the layouts accepted by TimeFromConfigString, Set and
+// UnmarshalText, in the order they're tried. It can be changed to accept other
+// formats in your project's config. Layouts without a zone are parsed as UTC.
+var TimeConfigLayouts = []string{
+	timeLayout,
+	"15:04:05.999999999Z07:00",
+	"15:04:05.999999999",
+	"15:04",
+	"3:04PM",
+	"3:04 PM",
+}
+
+// DateTimeConfigLayouts are the layouts accepted by DateTimeFromConfigString,
+// Set and UnmarshalText, in the order they're tried. It can be changed to
+// accept other formats in your project's config. Layouts without a zone are
+// parsed as UTC.
+var DateTimeConfigLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+}
+
+// DateFromConfigString parses a date using the first of DateConfigLayouts
+// that matches
+func DateFromConfigString(str string) (Date, error) {
+	t, err := parseConfigString(str, DateConfigLayouts)
+	if err != nil {
+		return Date{}, &ParseError{Op: "parse", Kind: "date", Input: str, Err: err}
+	}
+	return DateFromStdTime(t), nil
+}
+
+// TimeFromConfigString parses a time using the first of TimeConfigLayouts
+// that matches
+func TimeFromConfigString(str string) (Time, error) {
+	t, err := parseConfigString(str, TimeConfigLayouts)
+	if err != nil {
+		return Time{}, &ParseError{Op: "parse", Kind: "time", Input: str, Err: err}
+	}
+	return TimeFromStdTime(t), nil
+}
+
+// DateTimeFromConfigString parses a datetime using the first of
+// DateTimeConfigLayouts that matches
+func DateTimeFromConfigString(str string) (DateTime, error) {
+	t, err := parseConfigString(str, DateTimeConfigLayouts)
+	if err != nil {
+		return DateTime{}, &ParseError{Op: "parse", Kind: "datetime", Input: str, Err: err}
+	}
+	return DateTime{t: t}, nil
+}
+
+// DateFromEnv reads the environment variable key with DateFromConfigString
+func DateFromEnv(key string) (Date, error) {
+	str, ok := os.LookupEnv(key)
+	if !ok {
+		return Date{}, fmt.Errorf("failed to read date from env (%s): %w", key, ErrEnvNotSet)
+	}
+	return DateFromConfigString(str)
+}
+
+// TimeFromEnv reads the environment variable key with TimeFromConfigString
+func TimeFromEnv(key string) (Time, error) {
+	str, ok := os.LookupEnv(key)
+	if !ok {
+		return Time{}, fmt.Errorf("failed to read time from env (%s): %w", key, ErrEnvNotSet)
+	}
+	return TimeFromConfigString(str)
+}
+
+// DateTimeFromEnv reads the environment variable key with
+// DateTimeFromConfigString
+func DateTimeFromEnv(key string) (DateTime, error) {
+	str, ok := os.LookupEnv(key)
+	if !ok {
+		return DateTime{}, fmt.Errorf("failed to read datetime from env (%s): %w", key, ErrEnvNotSet)
+	}
+	return DateTimeFromConfigString(str)
+}
+
+// Set implements flag.Value and the Setter interface used by config
+// libraries like envconfig, it parses str with DateFromConfigString.
+func (d *Date) Set(str string) error {
+	v, err := DateFromConfigString(str)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// Set implements flag.Value and the Setter interface used by config
+// libraries like envconfig, it parses str with TimeFromConfigString.
+func (t *Time) Set(str string) error {
+	v, err := TimeFromConfigString(str)
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
+
+// Set implements flag.Value and the Setter interface used by config
+// libraries like envconfig, it parses str with DateTimeFromConfigString.
+func (d *DateTime) Set(str string) error {
+	v, err := DateTimeFromConfigString(str)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+func parseConfigString(str string, layouts []string) (time.Time, error) {
+	var firstErr error
+	for _, layout := range layouts {
+		t, err := time.Parse(layout, str)
+		if err == nil {
+			return t, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if firstErr == nil {
+		firstErr = errors.New("no layouts to parse with")
+	}
+	return time.Time{}, firstErr
+}
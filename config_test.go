@@ -0,0 +1,111 @@
+package chrono_test
+
+import (
+	"errors"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestConfigStrings(t *testing.T) {
+	t.Parallel()
+
+	for _, in := range []string{"2000-01-02", "20000102", "2000/01/02"} {
+		d, err := chrono.DateFromConfigString(in)
+		if err != nil {
+			t.Error(in, err)
+		} else if !d.Equal(chrono.NewDate(2000, 1, 2)) {
+			t.Error(in, "value wrong", d)
+		}
+	}
+
+	for _, in := range []string{"15:04:00Z", "15:04", "15:04:00.000", "3:04PM", "3:04 PM"} {
+		tm, err := chrono.TimeFromConfigString(in)
+		if err != nil {
+			t.Error(in, err)
+		} else if h, m, s := tm.Clock(); h != 15 || m != 4 || s != 0 {
+			t.Error(in, "value wrong", tm)
+		}
+	}
+
+	want := chrono.NewDateTime(2000, 1, 2, 3, 4, 0, 0, time.UTC)
+	for _, in := range []string{"2000-01-02T03:04:00Z", "2000-01-02 03:04:00Z", "2000-01-02T03:04:00", "2000-01-02 03:04", "2000-01-02T04:04:00+01:00"} {
+		dt, err := chrono.DateTimeFromConfigString(in)
+		if err != nil {
+			t.Error(in, err)
+		} else if !dt.Equal(want) {
+			t.Error(in, "value wrong", dt)
+		}
+	}
+
+	if _, err := chrono.DateFromConfigString("02/01/2000"); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestConfigUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	var tm chrono.Time
+	if err := tm.UnmarshalText([]byte("09:30")); err != nil {
+		t.Error(err)
+	}
+	if tm.Hour() != 9 || tm.Minute() != 30 {
+		t.Error("value wrong", tm)
+	}
+
+	var dt chrono.DateTime
+	if err := dt.UnmarshalText([]byte("2000-01-02 03:04")); err != nil {
+		t.Error(err)
+	}
+	if !dt.Equal(chrono.NewDateTime(2000, 1, 2, 3, 4, 0, 0, time.UTC)) {
+		t.Error("value wrong", dt)
+	}
+}
+
+func TestConfigFlags(t *testing.T) {
+	t.Parallel()
+
+	var (
+		d  chrono.Date
+		tm chrono.Time
+		dt chrono.DateTime
+	)
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&d, "date", "")
+	fs.Var(&tm, "time", "")
+	fs.Var(&dt, "datetime", "")
+
+	err := fs.Parse([]string{"-date", "2000-01-02", "-time", "03:04", "-datetime", "2000-01-02T03:04:05Z"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.Equal(chrono.NewDate(2000, 1, 2)) || tm.Hour() != 3 || !dt.Equal(chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Error("values wrong", d, tm, dt)
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("CHRONO_TEST_DATE", "2000-01-02")
+	t.Setenv("CHRONO_TEST_TIME", "03:04")
+	t.Setenv("CHRONO_TEST_DATETIME", "2000-01-02T03:04:05Z")
+
+	d, err := chrono.DateFromEnv("CHRONO_TEST_DATE")
+	if err != nil || !d.Equal(chrono.NewDate(2000, 1, 2)) {
+		t.Error("date wrong", d, err)
+	}
+	tm, err := chrono.TimeFromEnv("CHRONO_TEST_TIME")
+	if err != nil || tm.Hour() != 3 || tm.Minute() != 4 {
+		t.Error("time wrong", tm, err)
+	}
+	dt, err := chrono.DateTimeFromEnv("CHRONO_TEST_DATETIME")
+	if err != nil || !dt.Equal(chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Error("datetime wrong", dt, err)
+	}
+
+	if _, err := chrono.DateFromEnv("CHRONO_TEST_MISSING"); !errors.Is(err, chrono.ErrEnvNotSet) {
+		t.Error("expected not set error, got", err)
+	}
+}
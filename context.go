@@ -0,0 +1,33 @@
+package chrono
+
+import (
+	"context"
+	"time"
+)
+
+// ContextWithDeadline is context.WithDeadline for a DateTime
+func ContextWithDeadline(ctx context.Context, deadline DateTime) (context.Context, context.CancelFunc) {
+	return context.WithDeadline(ctx, deadline.t)
+}
+
+// DeadlineFromContext returns the deadline of the context as a DateTime and
+// false if there is none.
+func DeadlineFromContext(ctx context.Context) (DateTime, bool) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return DateTime{}, false
+	}
+	return DateTime{t: deadline}, true
+}
+
+// Until returns the duration until d, it's shorthand for d.Sub(now) where
+// now comes from DefaultClock
+func (d DateTime) Until() time.Duration {
+	return d.t.Sub(DefaultClock.Now().t)
+}
+
+// Since returns the duration elapsed since d, it's shorthand for now.Sub(d)
+// where now comes from DefaultClock
+func (d DateTime) Since() time.Duration {
+	return DefaultClock.Now().t.Sub(d.t)
+}
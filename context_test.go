@@ -0,0 +1,58 @@
+package chrono_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestContextDeadline(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := chrono.DeadlineFromContext(context.Background()); ok {
+		t.Error("background has no deadline")
+	}
+
+	deadline := chrono.DateTimeFromNow().Add(time.Hour)
+	ctx, cancel := chrono.ContextWithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	got, ok := chrono.DeadlineFromContext(ctx)
+	if !ok {
+		t.Fatal("should have a deadline")
+	}
+	if !got.Equal(deadline) {
+		t.Error("deadline wrong", got)
+	}
+
+	if until := got.Until(); until <= 0 || until > time.Hour {
+		t.Error("until wrong", until)
+	}
+	if since := got.Since(); since >= 0 {
+		t.Error("since should be negative for the future", since)
+	}
+
+	past := chrono.DateTimeFromNow().Add(-time.Hour)
+	ctx, cancel = chrono.ContextWithDeadline(context.Background(), past)
+	defer cancel()
+	if ctx.Err() != context.DeadlineExceeded {
+		t.Error("deadline should be exceeded")
+	}
+}
+
+func TestUntilSinceDefaultClock(t *testing.T) {
+	// Not parallel, changes package level configuration
+	oldClock := chrono.DefaultClock
+	defer func() { chrono.DefaultClock = oldClock }()
+	now := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	chrono.DefaultClock = chrono.ClockFunc(func() chrono.DateTime { return now })
+
+	if until := now.Add(time.Hour).Until(); until != time.Hour {
+		t.Error("until wrong:", until)
+	}
+	if since := now.Add(-time.Minute).Since(); since != time.Minute {
+		t.Error("since wrong:", since)
+	}
+}
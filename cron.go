@@ -0,0 +1,218 @@
+package chrono
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchYears is how far ahead Next will look for a matching time before
+// giving up, rules like "0 0 30 2 *" never match.
+const cronSearchYears = 5
+
+var (
+	cronMonthNames = map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}
+	cronDayNames = map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}
+	cronMacros = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// CronRule is a parsed standard 5 field cron expression. Each field is a bit
+// set of the values it matches.
+type CronRule struct {
+	expr string
+
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	// domStar and dowStar record if the day fields were '*', when both are
+	// restricted a day matches if either of them do
+	domStar bool
+	dowStar bool
+}
+
+// ParseCronRule parses a cron expression of the form:
+//
+//	minute hour day-of-month month day-of-week
+//
+// Each field supports '*', lists (1,2), ranges (1-5) and steps (*/15, 0-30/5).
+// Months and days of the week may also be given by their 3 letter English
+// names (JAN, MON) and Sunday may be 0 or 7. The macros @yearly, @annually,
+// @monthly, @weekly, @daily, @midnight and @hourly are also accepted.
+func ParseCronRule(expr string) (CronRule, error) {
+	spec := strings.TrimSpace(expr)
+	if macro, ok := cronMacros[strings.ToLower(spec)]; ok {
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return CronRule{}, &ParseError{Op: "parse", Kind: "cron rule", Input: expr, Err: fmt.Errorf("expected 5 fields, got %d", len(fields))}
+	}
+
+	c := CronRule{expr: expr}
+	var err error
+	if c.minute, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return CronRule{}, &ParseError{Op: "parse", Kind: "cron rule", Input: expr, Err: fmt.Errorf("minute: %w", err)}
+	}
+	if c.hour, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return CronRule{}, &ParseError{Op: "parse", Kind: "cron rule", Input: expr, Err: fmt.Errorf("hour: %w", err)}
+	}
+	if c.dom, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return CronRule{}, &ParseError{Op: "parse", Kind: "cron rule", Input: expr, Err: fmt.Errorf("day of month: %w", err)}
+	}
+	if c.month, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return CronRule{}, &ParseError{Op: "parse", Kind: "cron rule", Input: expr, Err: fmt.Errorf("month: %w", err)}
+	}
+	if c.dow, err = parseCronField(fields[4], 0, 7, cronDayNames); err != nil {
+		return CronRule{}, &ParseError{Op: "parse", Kind: "cron rule", Input: expr, Err: fmt.Errorf("day of week: %w", err)}
+	}
+	// 7 is an alias for Sunday
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+
+	c.domStar = strings.HasPrefix(fields[2], "*")
+	c.dowStar = strings.HasPrefix(fields[4], "*")
+	return c, nil
+}
+
+// Next returns the first time strictly after d that matches the rule in d's
+// location. The zero DateTime is returned if there is no match within the
+// next few years (eg. "0 0 30 2 *").
+func (c CronRule) Next(d DateTime) DateTime {
+	t := d.t.Truncate(time.Minute).Add(time.Minute)
+	loc := t.Location()
+	limit := t.Year() + cronSearchYears
+
+	for t.Year() <= limit {
+		y, m, day := t.Date()
+		switch {
+		// startOfDay rather than time.Date so that a day whose midnight is
+		// skipped by DST doesn't send us back to the day before
+		case c.month&(1<<uint(m)) == 0:
+			t = startOfDay(y, m+1, 1, loc)
+		case !c.matchDay(t):
+			t = startOfDay(y, m, day+1, loc)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			// Adding minutes rather than constructing the hour keeps us
+			// moving forward through DST changes
+			t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return DateTime{t: t}
+		}
+	}
+
+	return DateTime{}
+}
+
+// String returns the expression the rule was parsed from
+func (c CronRule) String() string {
+	return c.expr
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (c CronRule) MarshalText() ([]byte, error) {
+	return []byte(c.expr), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (c *CronRule) UnmarshalText(data []byte) error {
+	rule, err := ParseCronRule(string(data))
+	if err != nil {
+		return err
+	}
+	*c = rule
+	return nil
+}
+
+func (c CronRule) matchDay(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// parseCronField parses a comma separated list of cron values into a bit set
+func parseCronField(field string, first, last int, names map[string]int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepStr)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q", stepStr)
+			}
+		}
+
+		var lo, hi int
+		if rng == "*" {
+			lo, hi = first, last
+		} else {
+			loStr, hiStr, isRange := strings.Cut(rng, "-")
+			var err error
+			if lo, err = parseCronValue(loStr, names); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if isRange {
+				if hi, err = parseCronValue(hiStr, names); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				// "5/15" means starting at 5 through the end
+				hi = last
+			}
+		}
+
+		if lo < first || hi > last {
+			return 0, fmt.Errorf("%q out of range %d-%d", part, first, last)
+		}
+		if lo > hi {
+			return 0, fmt.Errorf("%q range is backwards", part)
+		}
+
+		for i := lo; i <= hi; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+
+	return bits, nil
+}
+
+func parseCronValue(str string, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToLower(str)]; ok {
+		return v, nil
+	}
+	if len(str) == 0 {
+		return 0, errors.New("empty value")
+	}
+	v, err := strconv.Atoi(str)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", str)
+	}
+	return v, nil
+}
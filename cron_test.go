@@ -0,0 +1,127 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestCronRuleNext(t *testing.T) {
+	t.Parallel()
+
+	// 2022-06-15 is a Wednesday
+	ref := chrono.NewDateTime(2022, 6, 15, 13, 14, 15, 0, time.UTC)
+	tests := []struct {
+		Expr string
+		Want chrono.DateTime
+	}{
+		{"* * * * *", chrono.NewDateTime(2022, 6, 15, 13, 15, 0, 0, time.UTC)},
+		{"*/15 * * * *", chrono.NewDateTime(2022, 6, 15, 13, 15, 0, 0, time.UTC)},
+		{"5/20 * * * *", chrono.NewDateTime(2022, 6, 15, 13, 25, 0, 0, time.UTC)},
+		{"0 9-17 * * MON-FRI", chrono.NewDateTime(2022, 6, 15, 14, 0, 0, 0, time.UTC)},
+		{"30 8 * * 1", chrono.NewDateTime(2022, 6, 20, 8, 30, 0, 0, time.UTC)},
+		{"0 0 * * 7", chrono.NewDateTime(2022, 6, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 jan *", chrono.NewDateTime(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", chrono.NewDateTime(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1,20 * *", chrono.NewDateTime(2022, 6, 20, 12, 0, 0, 0, time.UTC)},
+		// Both day fields restricted matches either
+		{"0 0 1 * fri", chrono.NewDateTime(2022, 6, 17, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", chrono.NewDateTime(2022, 6, 15, 14, 0, 0, 0, time.UTC)},
+		{"@monthly", chrono.NewDateTime(2022, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", chrono.DateTime{}},
+	}
+
+	for _, test := range tests {
+		rule, err := chrono.ParseCronRule(test.Expr)
+		if err != nil {
+			t.Error(test.Expr, err)
+			continue
+		}
+		if got := rule.Next(ref); !got.Equal(test.Want) {
+			t.Errorf("%s: want %s, got %s", test.Expr, test.Want, got)
+		}
+	}
+}
+
+func TestCronRuleNextDST(t *testing.T) {
+	t.Parallel()
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rule, err := chrono.ParseCronRule("0 * * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Clocks jump from 2:00 to 3:00 on 2022-03-13
+	got := rule.Next(chrono.NewDateTime(2022, 3, 13, 1, 30, 0, 0, ny))
+	if want := chrono.NewDateTime(2022, 3, 13, 3, 0, 0, 0, ny); !got.Equal(want) {
+		t.Errorf("want %s, got %s", want, got)
+	}
+
+	santiago, err := time.LoadLocation("America/Santiago")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rule, err = chrono.ParseCronRule("0 12 * * MON")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Midnight is skipped on 2024-09-08 in Santiago
+	got = rule.Next(chrono.NewDateTime(2024, 9, 6, 13, 0, 0, 0, santiago))
+	if want := chrono.NewDateTime(2024, 9, 9, 12, 0, 0, 0, santiago); !got.Equal(want) {
+		t.Errorf("want %s, got %s", want, got)
+	}
+	rule, err = chrono.ParseCronRule("30 0 * 9 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = rule.Next(chrono.NewDateTime(2024, 8, 31, 12, 0, 0, 0, santiago))
+	if want := chrono.NewDateTime(2024, 9, 1, 0, 30, 0, 0, santiago); !got.Equal(want) {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+func TestCronRuleErrors(t *testing.T) {
+	t.Parallel()
+
+	bad := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"1- * * * *",
+	}
+
+	for _, expr := range bad {
+		if _, err := chrono.ParseCronRule(expr); err == nil {
+			t.Errorf("%q: expected an error", expr)
+		}
+	}
+}
+
+func TestCronRuleText(t *testing.T) {
+	t.Parallel()
+
+	var rule chrono.CronRule
+	if err := rule.UnmarshalText([]byte("@daily")); err != nil {
+		t.Fatal(err)
+	}
+	if rule.String() != "@daily" {
+		t.Error("string wrong", rule)
+	}
+	if err := rule.UnmarshalText([]byte("x")); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -23,19 +23,41 @@ func NewDate(year int, month time.Month, day int) Date {
 	return Date{t: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
 }
 
-// DateFromNow returns a new date using the current date. It uses time.Now()
-// as a reference date, discarding time information.
+// DateFromNow returns a new date using the current date. It uses the current
+// time in NowLocation (local by default) as a reference date, discarding time
+// information.
 func DateFromNow() Date {
 	// Careful to use local time else we might end up changing dates
 	// which would be unexpected.
-	return DateFromStdTime(time.Now())
+	return DateFromStdTime(now())
+}
+
+// Today returns the current date in NowLocation, the same as DateFromNow
+func Today() Date {
+	return DateFromNow()
+}
+
+// Tomorrow returns the day after Today
+func Tomorrow() Date {
+	return Today().AddDate(0, 0, 1)
+}
+
+// Yesterday returns the day before Today
+func Yesterday() Date {
+	return Today().AddDate(0, 0, -1)
+}
+
+// TodayIn returns the current date in loc, which can differ from the date
+// in NowLocation around midnight
+func TodayIn(loc *time.Location) Date {
+	return DateFromStdTime(DefaultClock.Now().t.In(loc))
 }
 
 // DateFromString parses a Date from RFC3339 full-date
 func DateFromString(str string) (Date, error) {
 	t, err := time.ParseInLocation(dateLayout, str, time.UTC)
 	if err != nil {
-		return Date{}, fmt.Errorf("failed to parse date: %w", err)
+		return Date{}, &ParseError{Op: "parse", Kind: "date", Input: str, Layout: dateLayout, Err: err}
 	}
 
 	return DateFromStdTime(t), nil
@@ -45,19 +67,72 @@ func DateFromString(str string) (Date, error) {
 func DateFromLayout(layout, str string) (Date, error) {
 	t, err := time.ParseInLocation(layout, str, time.UTC)
 	if err != nil {
-		return Date{}, fmt.Errorf("failed to parse date: %w", err)
+		return Date{}, &ParseError{Op: "parse", Kind: "date", Input: str, Layout: layout, Err: err}
 	}
 
 	return DateFromStdTime(t), nil
 }
 
+// NewDateFromISOWeek constructs a date from its ISO8601 week date components,
+// it is the inverse of ISOWeek. Like NewDate, out of range values are
+// normalized, eg. week 53 of a year with only 52 weeks is week 1 of the
+// following year.
+func NewDateFromISOWeek(year, week int, weekday time.Weekday) Date {
+	// Week 1 is always the week with January 4th in it
+	jan4 := time.Date(year, 1, 4, 0, 0, 0, 0, time.UTC)
+	mondayOffset := (int(jan4.Weekday()) + 6) % 7
+	isoWeekday := (int(weekday)+6)%7 + 1
+	days := (week-1)*7 + (isoWeekday - 1) - mondayOffset
+	return Date{t: jan4.AddDate(0, 0, days)}
+}
+
+// DateFromISOWeekString parses an ISO8601 week date in either the extended
+// (2004-W53-6) or basic (2004W536) format. When the weekday is omitted it is
+// taken to be Monday.
+func DateFromISOWeekString(str string) (Date, error) {
+	if len(str) < 5 || (str[4] != 'W' && (str[4] != '-' || len(str) < 6 || str[5] != 'W')) {
+		return Date{}, &ParseError{Op: "parse", Kind: "iso week date", Input: str, Err: errors.New("not a week date")}
+	}
+
+	date, rest, err := parseISODate(str)
+	if err != nil {
+		return Date{}, &ParseError{Op: "parse", Kind: "iso week date", Input: str, Err: err}
+	}
+	if len(rest) != 0 {
+		return Date{}, &ParseError{Op: "parse", Kind: "iso week date", Input: str, Err: fmt.Errorf("unexpected %q after date", rest)}
+	}
+	return date, nil
+}
+
 // FromTime converts from the stdlib time.Time type, discarding time information
 func DateFromStdTime(t time.Time) Date {
 	return Date{t: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}
 }
 
-// DateFromUnix converts a unix timestamp in seconds into a date.
+// DateFromEpochDays creates a date from a number of days since 1970-01-01.
+// Days outside of MinDate and MaxDate are clamped to the day after MinDate
+// or before MaxDate so that garbage input isn't mistaken for the infinities
+// (see PosInfinityDate), use DateFromEpochDaysChecked to detect it.
+func DateFromEpochDays(days int) Date {
+	switch {
+	case int64(days) < MinDate.epochDays():
+		return minFiniteDate
+	case int64(days) > MaxDate.epochDays():
+		return maxFiniteDate
+	}
+	return dateFromEpochDays(int64(days))
+}
+
+// DateFromUnix converts a unix timestamp in seconds into a date. Timestamps
+// outside of MinDate and MaxDate are clamped like DateFromEpochDays, use
+// DateFromUnixChecked to detect them.
 func DateFromUnix(sec int64, nsec int64) Date {
+	if !checkUnix(sec, nsec) {
+		if sec < 0 {
+			return minFiniteDate
+		}
+		return maxFiniteDate
+	}
 	return DateFromStdTime(time.Unix(sec, nsec).UTC())
 }
 
@@ -83,6 +158,18 @@ func (d Date) AddDate(years int, months int, days int) Date {
 	return DateFromStdTime(d.t.AddDate(years, months, days))
 }
 
+// AddMonthsNoOverflow adds months to d like AddDate but a day past the end of
+// the resulting month is clamped to its last day rather than overflowing into
+// the next, eg. Jan 31 plus 1 month is Feb 28 (or 29) rather than Mar 3.
+func (d Date) AddMonthsNoOverflow(months int) Date {
+	year, month, day := d.Date()
+	first := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	if last := daysIn(first.Month(), first.Year()); day > last {
+		day = last
+	}
+	return Date{t: first.AddDate(0, 0, day-1)}
+}
+
 // After returns true if d is after rhs
 func (d Date) After(rhs Date) bool {
 	return d.t.After(rhs.t)
@@ -98,9 +185,34 @@ func (d Date) AfterOrEqual(rhs Date) bool {
 // string is not checked for time-like parts that could be leaked out but will
 // be zero.
 func (d Date) AppendFormat(b []byte, layout string) []byte {
+	if layout == dateLayout {
+		return appendDate(b, d.t)
+	}
 	return d.t.AppendFormat(b, layout)
 }
 
+// At returns the datetime on d at the wall clock time of t in t's location
+func (d Date) At(t Time) DateTime {
+	year, month, day := d.t.Date()
+	hour, min, sec := t.t.Clock()
+	return DateTime{t: time.Date(year, month, day, hour, min, sec, t.t.Nanosecond(), t.t.Location())}
+}
+
+// AtClock returns the datetime on d at hour:min:sec in loc. Like time.Date
+// a wall clock time skipped by a DST transition is moved forward.
+func (d Date) AtClock(hour, min, sec int, loc *time.Location) DateTime {
+	year, month, day := d.t.Date()
+	return DateTime{t: time.Date(year, month, day, hour, min, sec, 0, loc)}
+}
+
+// AtStartOfDayIn returns the first instant of the date in loc. That's
+// midnight unless a DST transition skips it, in which case it's the moment
+// the clocks jump to, eg. 01:00 in America/Santiago on the day DST starts.
+func (d Date) AtStartOfDayIn(loc *time.Location) DateTime {
+	year, month, day := d.t.Date()
+	return DateTime{t: startOfDay(year, month, day, loc)}
+}
+
 // Before returns true if d is before rhs
 func (d Date) Before(rhs Date) bool {
 	return d.t.Before(rhs.t)
@@ -116,6 +228,13 @@ func (d Date) Between(start, end Date) bool {
 	return d.t.After(start.t) && d.t.Before(end.t)
 }
 
+// BetweenBounds returns true if d is in the time range from start to end
+// including or excluding each of them according to bounds, eg.
+// BoundsClosedOpen for the half-open range [start, end).
+func (d Date) BetweenBounds(start, end Date, bounds BoundType) bool {
+	return inBounds(d.t, start.t, end.t, bounds)
+}
+
 // BetweenOrEqual returns true if d is in the inclusive time range [start, end]
 func (d Date) BetweenOrEqual(start, end Date) bool {
 	return d.AfterOrEqual(start) && d.BeforeOrEqual(end)
@@ -131,14 +250,47 @@ func (d Date) Day() int {
 	return d.t.Day()
 }
 
+// DaysInMonth returns the number of days in the month of d
+func (d Date) DaysInMonth() int {
+	year, month, _ := d.t.Date()
+	return daysIn(month, year)
+}
+
+// EpochDays returns the number of days since 1970-01-01. It's the natural
+// integer representation of a date: two dates are the same day exactly when
+// their EpochDays are equal, and subtracting them gives the days between.
+// On 32 bit platforms an int only holds about 5.8 million years of days so
+// dates further away, like MinDate and MaxDate, wrap around, Key does not.
+func (d Date) EpochDays() int {
+	return int(d.epochDays())
+}
+
+// epochDays is EpochDays without the limits of an int
+func (d Date) epochDays() int64 {
+	// Dates are always midnight UTC so this division is exact
+	return d.t.Unix() / secondsPerDay
+}
+
+// dateFromEpochDays is DateFromEpochDays without the limits of an int or the
+// clamping
+func dateFromEpochDays(days int64) Date {
+	return Date{t: time.Unix(days*secondsPerDay, 0).UTC()}
+}
+
 // Equal returns true if rhs == d
 func (d Date) Equal(rhs Date) bool {
 	return d.t.Equal(rhs.t)
 }
 
+// FirstDayOfMonth returns the first day of the month of d
+func (d Date) FirstDayOfMonth() Date {
+	year, month, _ := d.t.Date()
+	return NewDate(year, month, 1)
+}
+
 // Format using a layout string from time.Time. This can accidentally pull
 // zero'd time information from the underlying time.Time so caution must be
-// used.
+// used, see SafeFormat.
 func (d Date) Format(layout string) string {
 	return d.t.Format(layout)
 }
@@ -149,14 +301,51 @@ func (d Date) GoString() string {
 	return fmt.Sprintf("chrono.Date(%d, %s, %d)", y, m, day)
 }
 
+// IsLastDayOfMonth returns true if d is the last day of its month
+func (d Date) IsLastDayOfMonth() bool {
+	return d.Day() == d.DaysInMonth()
+}
+
+// IsAfter returns true if d is after rhs, the same as After
+func (d Date) IsAfter(rhs Date) bool {
+	return d.After(rhs)
+}
+
+// IsBefore returns true if d is before rhs, the same as Before
+func (d Date) IsBefore(rhs Date) bool {
+	return d.Before(rhs)
+}
+
+// IsOnOrAfter returns true if d is equal to or after rhs, the same as
+// AfterOrEqual
+func (d Date) IsOnOrAfter(rhs Date) bool {
+	return d.AfterOrEqual(rhs)
+}
+
+// IsOnOrBefore returns true if d is equal to or before rhs, the same as
+// BeforeOrEqual
+func (d Date) IsOnOrBefore(rhs Date) bool {
+	return d.BeforeOrEqual(rhs)
+}
+
 // IsZero returns true if the Date is the zero value.
 func (d Date) IsZero() bool {
 	return d.t.IsZero()
 }
 
+// LastDayOfMonth returns the last day of the month of d
+func (d Date) LastDayOfMonth() Date {
+	year, month, _ := d.t.Date()
+	return NewDate(year, month, daysIn(month, year))
+}
+
 // MarshalBinary implements the encoding.BinaryMarshaler interface. Is always
-// a width of 32 bits (4 bytes).
+// a width of 32 bits (4 bytes). Like the text encodings it returns an error
+// for years outside of 0-9999, eg. MinDate and MaxDate.
 func (d Date) MarshalBinary() ([]byte, error) {
+	if err := d.checkMarshalYear("MarshalBinary"); err != nil {
+		return nil, err
+	}
 	var out uint32
 	y, m, day := d.t.Date()
 	// Year = 14 bits
@@ -170,14 +359,33 @@ func (d Date) MarshalBinary() ([]byte, error) {
 	return buf, nil
 }
 
-// MarshalJSON implements json.Marshaller
+// MarshalJSON implements json.Marshaller. Like time.Time it returns an error
+// for years outside of 0-9999 since they can't be parsed back.
 func (d Date) MarshalJSON() ([]byte, error) {
-	return []byte(fmt.Sprintf(`"%s"`, d)), nil
+	if err := d.checkMarshalYear("MarshalJSON"); err != nil {
+		return nil, err
+	}
+	b := make([]byte, 0, len(quotedDateLayout))
+	b = append(b, '"')
+	b = appendDate(b, d.t)
+	return append(b, '"'), nil
 }
 
-// MarshalText implements encoding.TextMarshaller
+// MarshalText implements encoding.TextMarshaller. Like time.Time it returns
+// an error for years outside of 0-9999 since they can't be parsed back.
 func (d Date) MarshalText() ([]byte, error) {
-	return []byte(d.String()), nil
+	if err := d.checkMarshalYear("MarshalText"); err != nil {
+		return nil, err
+	}
+	return appendDate(make([]byte, 0, len(dateLayout)), d.t), nil
+}
+
+// checkMarshalYear returns an error if d's year can't be encoded
+func (d Date) checkMarshalYear(method string) error {
+	if y := d.t.Year(); y < 0 || y > 9999 {
+		return errors.New("Date." + method + ": year outside of range [0,9999]")
+	}
+	return nil
 }
 
 // Month returns the month
@@ -185,9 +393,19 @@ func (d Date) Month() time.Month {
 	return d.t.Month()
 }
 
+// SafeFormat is like Format but returns a *LayoutError instead of printing
+// zeros if the layout contains time of day or zone tokens, eg. "15:04".
+func (d Date) SafeFormat(layout string) (string, error) {
+	if err := checkLayout(layout, "date", TokenHour, TokenMinute, TokenSecond, TokenFraction, TokenAMPM, TokenZone); err != nil {
+		return "", err
+	}
+	return d.t.Format(layout), nil
+}
+
 // String returns an ISO8601 Date, also an RFC3339 full-date
 func (d Date) String() string {
-	return d.t.Format(dateLayout)
+	var buf [len(dateLayout)]byte
+	return string(appendDate(buf[:0], d.t))
 }
 
 // Unix timestamp
@@ -213,7 +431,7 @@ func (d Date) UnixNano() int64 {
 // UnmarshalBinary
 func (d *Date) UnmarshalBinary(data []byte) error {
 	if len(data) != 4 {
-		return errors.New("failed to unmarshal date, incorrect number of bytes")
+		return &ParseError{Op: "unmarshal", Kind: "date", Input: string(data), Err: errors.New("incorrect number of bytes")}
 	}
 	in := binary.LittleEndian.Uint32(data)
 	y, m, day := in&0b11_1111_1111_1111, (in>>14)&0b1111, (in>>(14+4))&0b1_1111
@@ -221,21 +439,28 @@ func (d *Date) UnmarshalBinary(data []byte) error {
 	return nil
 }
 
-// UnmarshalJSON parses a quoted ISO8601 date / RFC3339 full-date
+// UnmarshalJSON parses a quoted ISO8601 date / RFC3339 full-date. A null or
+// "" is handled according to EmptyJSONIsZero.
 func (d *Date) UnmarshalJSON(data []byte) error {
+	if empty, err := emptyJSON(data, "date"); empty {
+		*d = Date{}
+		return err
+	}
+
 	t, err := time.Parse(quotedDateLayout, string(data))
 	if err != nil {
-		return fmt.Errorf("failed to unmarshal date (%q): %w", data, err)
+		return &ParseError{Op: "unmarshal", Kind: "date", Input: string(data), Layout: quotedDateLayout, Err: err}
 	}
 	*d = DateFromStdTime(t)
 	return nil
 }
 
-// UnmarshalText parses a byte string with ISO8601 date / RFC3339 full-date
+// UnmarshalText parses a byte string with ISO8601 date / RFC3339 full-date,
+// or any of the other DateConfigLayouts.
 func (d *Date) UnmarshalText(data []byte) error {
-	t, err := time.Parse(dateLayout, string(data))
+	t, err := parseConfigString(string(data), DateConfigLayouts)
 	if err != nil {
-		return fmt.Errorf("failed to unmarshal date (%q): %w", data, err)
+		return &ParseError{Op: "unmarshal", Kind: "date", Input: string(data), Err: err}
 	}
 	*d = DateFromStdTime(t)
 	return nil
@@ -261,12 +486,32 @@ func (d Date) ISOWeek() (year, week int) {
 	return d.t.ISOWeek()
 }
 
-// Value implements driver.Valuer. SQL requires the use of ISO8601.
+// Value implements driver.Valuer. SQL requires the use of ISO8601 but
+// SQLValueStorage can be changed to write a Julian Day, unix timestamp or
+// time.Time instead. PosInfinityDate and NegInfinityDate are written as
+// infinity and -infinity if the current Dialect supports them, otherwise they
+// are an error.
 func (d Date) Value() (driver.Value, error) {
+	switch SQLValueStorage {
+	case SQLStorageReal:
+		return d.JulianDay(), nil
+	case SQLStorageInteger:
+		return d.t.Unix(), nil
+	case SQLStorageTime:
+		return d.t, nil
+	}
+	if inf := d.infinity(); inf != 0 {
+		return infinitySQL(inf, "date")
+	}
 	return d.t.Format(dateLayout), nil
 }
 
-// Scan implements sql.Scanner. SQL requires the use of ISO8601.
+// Scan implements sql.Scanner. SQL requires the use of ISO8601 but the current
+// Dialect's DateScanLayouts are also accepted. Integers are unix timestamps
+// and floats are Julian Days (the storage classes SQLite uses), unless the
+// float is outside of the years 0000-9999 in which case it is also a unix
+// timestamp. Postgres' infinity and -infinity become PosInfinityDate and
+// NegInfinityDate.
 func (d *Date) Scan(value any) error {
 	if value == nil {
 		d.t = time.Time{}
@@ -279,20 +524,48 @@ func (d *Date) Scan(value any) error {
 		*d = DateFromUnix(v, 0)
 		return nil
 	case float64:
+		if isScanJulianDay(v) {
+			*d = DateFromJulianDay(v)
+			return nil
+		}
 		// Assume this is a unix timestamp in float
 		*d = DateFromUnix(int64(v), 0)
 		return nil
 	case string:
+		if t, ok := parseSQLDate(v); ok {
+			d.t = t
+			return nil
+		}
+		if inf := sqlInfinity(v); inf != 0 {
+			*d = infiniteDate(inf)
+			return nil
+		}
 		t, err := time.Parse(dateLayout, v)
 		if err != nil {
-			return fmt.Errorf("failed to scan date (%q): %w", v, err)
+			if t, ok := parseLayouts(v, currentDialect().DateScanLayouts); ok {
+				*d = DateFromStdTime(t)
+				return nil
+			}
+			return &ParseError{Op: "scan", Kind: "date", Input: v, Layout: dateLayout, Err: err}
 		}
 		d.t = t
 		return nil
 	case []byte:
+		if t, ok := parseSQLDate(v); ok {
+			d.t = t
+			return nil
+		}
+		if inf := sqlInfinity(v); inf != 0 {
+			*d = infiniteDate(inf)
+			return nil
+		}
 		t, err := time.Parse(dateLayout, string(v))
 		if err != nil {
-			return fmt.Errorf("failed to scan date (%q): %w", v, err)
+			if t, ok := parseLayouts(string(v), currentDialect().DateScanLayouts); ok {
+				*d = DateFromStdTime(t)
+				return nil
+			}
+			return &ParseError{Op: "scan", Kind: "date", Input: string(v), Layout: dateLayout, Err: err}
 		}
 		d.t = t
 		return nil
@@ -301,5 +574,17 @@ func (d *Date) Scan(value any) error {
 		return nil
 	}
 
-	return fmt.Errorf("failed to scan type '%T' into date", value)
+	return &TypeError{Op: "scan", Kind: "date", Value: value}
+}
+
+// DaysBetween returns the number of calendar days from a to b, negative if b
+// is before a. It is exact, there is no duration division involved.
+func DaysBetween(a, b Date) int {
+	return b.EpochDays() - a.EpochDays()
+}
+
+// WeeksBetween returns the number of whole weeks from a to b, truncated
+// towards zero like DaysBetween(a, b) / 7.
+func WeeksBetween(a, b Date) int {
+	return DaysBetween(a, b) / 7
 }
@@ -2,6 +2,7 @@ package chrono_test
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 	"time"
 
@@ -72,6 +73,60 @@ func TestDateModifications(t *testing.T) {
 	}
 }
 
+func TestDateMonthDays(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Date  chrono.Date
+		Days  int
+		First chrono.Date
+		Last  chrono.Date
+	}{
+		{chrono.NewDate(2024, 2, 10), 29, chrono.NewDate(2024, 2, 1), chrono.NewDate(2024, 2, 29)},
+		{chrono.NewDate(2023, 2, 28), 28, chrono.NewDate(2023, 2, 1), chrono.NewDate(2023, 2, 28)},
+		{chrono.NewDate(1900, 2, 1), 28, chrono.NewDate(1900, 2, 1), chrono.NewDate(1900, 2, 28)},
+		{chrono.NewDate(2024, 4, 30), 30, chrono.NewDate(2024, 4, 1), chrono.NewDate(2024, 4, 30)},
+		{chrono.NewDate(2024, 12, 5), 31, chrono.NewDate(2024, 12, 1), chrono.NewDate(2024, 12, 31)},
+	}
+	for _, test := range tests {
+		if got := test.Date.DaysInMonth(); got != test.Days {
+			t.Errorf("%s: want %d days, got %d", test.Date, test.Days, got)
+		}
+		if got := test.Date.FirstDayOfMonth(); got != test.First {
+			t.Errorf("%s: want first %s, got %s", test.Date, test.First, got)
+		}
+		if got := test.Date.LastDayOfMonth(); got != test.Last {
+			t.Errorf("%s: want last %s, got %s", test.Date, test.Last, got)
+		}
+		if got := test.Date.IsLastDayOfMonth(); got != (test.Date == test.Last) {
+			t.Errorf("%s: wrong is last day %t", test.Date, got)
+		}
+	}
+}
+
+func TestDateAddMonthsNoOverflow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Date   chrono.Date
+		Months int
+		Want   chrono.Date
+	}{
+		{chrono.NewDate(2024, 1, 31), 1, chrono.NewDate(2024, 2, 29)},
+		{chrono.NewDate(2023, 1, 31), 1, chrono.NewDate(2023, 2, 28)},
+		{chrono.NewDate(2024, 1, 31), 3, chrono.NewDate(2024, 4, 30)},
+		{chrono.NewDate(2024, 3, 31), -1, chrono.NewDate(2024, 2, 29)},
+		{chrono.NewDate(2024, 2, 29), 12, chrono.NewDate(2025, 2, 28)},
+		{chrono.NewDate(2024, 1, 15), 25, chrono.NewDate(2026, 2, 15)},
+		{chrono.NewDate(2024, 12, 31), 0, chrono.NewDate(2024, 12, 31)},
+	}
+	for _, test := range tests {
+		if got := test.Date.AddMonthsNoOverflow(test.Months); got != test.Want {
+			t.Errorf("%s + %d months: want %s, got %s", test.Date, test.Months, test.Want, got)
+		}
+	}
+}
+
 func TestDateComparisons(t *testing.T) {
 	t.Parallel()
 
@@ -308,3 +363,191 @@ func TestDateSQL(t *testing.T) {
 		t.Error("value was wrong")
 	}
 }
+
+func TestDateISOWeek(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Year    int
+		Week    int
+		Weekday time.Weekday
+		Want    chrono.Date
+	}{
+		{2000, 1, time.Saturday, chrono.NewDate(2000, 1, 8)},
+		{2004, 53, time.Saturday, chrono.NewDate(2005, 1, 1)},
+		{2009, 1, time.Monday, chrono.NewDate(2008, 12, 29)},
+		{2008, 52, time.Sunday, chrono.NewDate(2008, 12, 28)},
+		{2003, 53, time.Monday, chrono.NewDate(2003, 12, 29)},
+	}
+
+	for _, test := range tests {
+		d := chrono.NewDateFromISOWeek(test.Year, test.Week, test.Weekday)
+		if !d.Equal(test.Want) {
+			t.Errorf("%d-W%d-%s: want %s, got %s", test.Year, test.Week, test.Weekday, test.Want, d)
+		}
+	}
+
+	// Round trip every day across a few year boundaries
+	for d := chrono.NewDate(1999, 12, 1); d.Before(chrono.NewDate(2011, 2, 1)); d = d.AddDate(0, 0, 1) {
+		year, week := d.ISOWeek()
+		if back := chrono.NewDateFromISOWeek(year, week, d.Weekday()); !back.Equal(d) {
+			t.Fatalf("%s did not round trip, got %s", d, back)
+		}
+	}
+
+	d, err := chrono.DateFromISOWeekString("2004-W53-6")
+	if err != nil {
+		t.Error(err)
+	}
+	if !d.Equal(chrono.NewDate(2005, 1, 1)) {
+		t.Error("value wrong", d)
+	}
+	if d, err = chrono.DateFromISOWeekString("2004W536"); err != nil || !d.Equal(chrono.NewDate(2005, 1, 1)) {
+		t.Error("value wrong", d, err)
+	}
+	if _, err = chrono.DateFromISOWeekString("2003-W53-6"); err == nil {
+		t.Error("2003 has no week 53")
+	}
+	if _, err = chrono.DateFromISOWeekString("2004-01-02"); err == nil {
+		t.Error("not a week date")
+	}
+}
+
+func TestEpochDays(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Date chrono.Date
+		Days int
+	}{
+		{chrono.NewDate(1970, 1, 1), 0},
+		{chrono.NewDate(2000, 1, 2), 10958},
+		{chrono.NewDate(1969, 12, 31), -1},
+		{chrono.NewDate(1, 1, 1), -719162},
+	}
+
+	for _, test := range tests {
+		if got := test.Date.EpochDays(); got != test.Days {
+			t.Errorf("%v: want: %d, got: %d", test.Date, test.Days, got)
+		}
+		if got := chrono.DateFromEpochDays(test.Days); !got.Equal(test.Date) {
+			t.Errorf("%d: want: %v, got: %v", test.Days, test.Date, got)
+		}
+	}
+}
+
+func TestDaysBetween(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		A, B  chrono.Date
+		Days  int
+		Weeks int
+	}{
+		{chrono.NewDate(2000, 1, 1), chrono.NewDate(2000, 1, 1), 0, 0},
+		{chrono.NewDate(2000, 1, 1), chrono.NewDate(2000, 1, 7), 6, 0},
+		{chrono.NewDate(2000, 1, 1), chrono.NewDate(2000, 1, 8), 7, 1},
+		{chrono.NewDate(2000, 1, 8), chrono.NewDate(2000, 1, 1), -7, -1},
+		{chrono.NewDate(2000, 1, 7), chrono.NewDate(2000, 1, 1), -6, 0},
+		// Leap years
+		{chrono.NewDate(2000, 2, 28), chrono.NewDate(2000, 3, 1), 2, 0},
+		{chrono.NewDate(1900, 2, 28), chrono.NewDate(1900, 3, 1), 1, 0},
+		{chrono.NewDate(2023, 1, 1), chrono.NewDate(2024, 1, 1), 365, 52},
+		{chrono.NewDate(2024, 1, 1), chrono.NewDate(2025, 1, 1), 366, 52},
+		// Dates on either side of a DST change are still whole days apart
+		{chrono.NewDate(2023, 3, 11), chrono.NewDate(2023, 3, 13), 2, 0},
+		// Across the epoch and the whole supported range
+		{chrono.NewDate(1969, 12, 25), chrono.NewDate(1970, 1, 8), 14, 2},
+		{chrono.NewDate(1, 1, 1), chrono.NewDate(9999, 12, 31), 3652058, 521722},
+	}
+
+	for _, test := range tests {
+		if got := chrono.DaysBetween(test.A, test.B); got != test.Days {
+			t.Errorf("days %v -> %v: want: %d, got: %d", test.A, test.B, test.Days, got)
+		}
+		if got := chrono.WeeksBetween(test.A, test.B); got != test.Weeks {
+			t.Errorf("weeks %v -> %v: want: %d, got: %d", test.A, test.B, test.Weeks, got)
+		}
+	}
+}
+
+func TestDateAtStartOfDayIn(t *testing.T) {
+	t.Parallel()
+
+	santiago, err := time.LoadLocation("America/Santiago")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Date chrono.Date
+		Loc  *time.Location
+		Want time.Time
+	}{
+		{chrono.NewDate(2022, 9, 10), santiago, time.Date(2022, 9, 10, 0, 0, 0, 0, santiago)},
+		// DST starts at midnight so the day begins at 01:00
+		{chrono.NewDate(2022, 9, 11), santiago, time.Date(2022, 9, 11, 4, 0, 0, 0, time.UTC)},
+		{chrono.NewDate(2022, 9, 12), santiago, time.Date(2022, 9, 12, 0, 0, 0, 0, santiago)},
+		// DST ends at midnight going back to 23:00, the day starts at 00:00 -04
+		{chrono.NewDate(2022, 4, 3), santiago, time.Date(2022, 4, 3, 4, 0, 0, 0, time.UTC)},
+		// Transitions later in the day don't matter
+		{chrono.NewDate(2023, 3, 12), newYork, time.Date(2023, 3, 12, 5, 0, 0, 0, time.UTC)},
+		{chrono.NewDate(2000, 1, 2), time.UTC, time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		got := test.Date.AtStartOfDayIn(test.Loc)
+		if !got.ToStdTime().Equal(test.Want) {
+			t.Errorf("%v in %s: want: %v, got: %v", test.Date, test.Loc, test.Want.In(test.Loc), got)
+		}
+		if got.Location() != test.Loc {
+			t.Errorf("%v in %s: location wrong: %s", test.Date, test.Loc, got.Location())
+		}
+		if !got.ToDate().Equal(test.Date) {
+			t.Errorf("%v in %s: on the wrong day: %v", test.Date, test.Loc, got)
+		}
+	}
+}
+
+func TestDateAt(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("", 5*60*60+30*60)
+	date := chrono.NewDate(2000, 1, 2)
+
+	got := date.At(chrono.TimeFromStdTime(time.Date(0, 1, 1, 3, 4, 5, 6, loc)))
+	if want := time.Date(2000, 1, 2, 3, 4, 5, 6, loc); !got.ToStdTime().Equal(want) || got.Location() != loc {
+		t.Error("at wrong:", got)
+	}
+
+	got = date.AtClock(3, 4, 5, time.UTC)
+	if want := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC); !got.Equal(want) || got.Location() != time.UTC {
+		t.Error("at clock wrong:", got)
+	}
+}
+
+func TestDateSafeFormat(t *testing.T) {
+	t.Parallel()
+
+	d := chrono.NewDate(2000, 1, 2)
+	if got, err := d.SafeFormat("Monday, Jan 2 2006"); err != nil || got != "Sunday, Jan 2 2000" {
+		t.Error("wrong format:", got, err)
+	}
+
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02 3PM", "2006-01-02 MST", "2006-01-02Z07:00"} {
+		_, err := d.SafeFormat(layout)
+		var lerr *chrono.LayoutError
+		if !errors.As(err, &lerr) {
+			t.Errorf("%q: expected a layout error, got: %v", layout, err)
+		}
+	}
+
+	_, err := d.SafeFormat("2006-01-02 15:04")
+	if err == nil || err.Error() != `layout "2006-01-02 15:04" formats hour ("15") which a date does not have` {
+		t.Error("wrong error message:", err)
+	}
+}
@@ -26,9 +26,33 @@ func NewDateTime(year int, month time.Month, day, hour, min, sec, nsec int, loc
 }
 
 // DateTimeFromNow creates a new date time from the current moment in time
-// (local).
+// (in NowLocation, local by default). Like time.Now the result carries a
+// monotonic clock reading, see WithoutMonotonic.
 func DateTimeFromNow() DateTime {
-	return DateTime{t: time.Now()}
+	return DateTime{t: now()}
+}
+
+// DateTimeFromNowIn creates a new date time from the current moment in time
+// in loc. It has no monotonic clock reading.
+func DateTimeFromNowIn(loc *time.Location) DateTime {
+	return DateTime{t: DefaultClock.Now().t.In(loc)}
+}
+
+// DateTimeFromNowUTC creates a new date time from the current moment in time
+// in UTC. It has no monotonic clock reading.
+func DateTimeFromNowUTC() DateTime {
+	return DateTimeFromNowIn(time.UTC)
+}
+
+// StartOfToday returns the start of the current date in loc, normally
+// midnight but later on days that DST begins at midnight. If loc is nil
+// NowLocation is used.
+func StartOfToday(loc *time.Location) DateTime {
+	if loc == nil {
+		loc = NowLocation
+	}
+	year, month, day := DefaultClock.Now().t.In(loc).Date()
+	return DateTime{t: startOfDay(year, month, day, loc)}
 }
 
 // DateTimeFromString parses a date time (ISO8601/RFC3339 date-time) in the
@@ -36,7 +60,7 @@ func DateTimeFromNow() DateTime {
 func DateTimeFromString(str string) (DateTime, error) {
 	t, err := time.Parse(time.RFC3339, str)
 	if err != nil {
-		return DateTime{}, fmt.Errorf("failed to parse datetime (%s): %w", str, err)
+		return DateTime{}, &ParseError{Op: "parse", Kind: "datetime", Input: str, Layout: time.RFC3339, Err: err}
 	}
 
 	return DateTime{t: t}, nil
@@ -47,7 +71,7 @@ func DateTimeFromString(str string) (DateTime, error) {
 func DateTimeFromStringLocation(str string, loc *time.Location) (DateTime, error) {
 	t, err := time.ParseInLocation(time.RFC3339, str, loc)
 	if err != nil {
-		return DateTime{}, fmt.Errorf("failed to parse datetime (%s): %w", str, err)
+		return DateTime{}, &ParseError{Op: "parse", Kind: "datetime", Input: str, Layout: time.RFC3339, Err: err}
 	}
 
 	return DateTime{t: t}, nil
@@ -57,7 +81,7 @@ func DateTimeFromStringLocation(str string, loc *time.Location) (DateTime, error
 func DateTimeFromLayout(layout, str string) (DateTime, error) {
 	t, err := time.Parse(layout, str)
 	if err != nil {
-		return DateTime{}, fmt.Errorf("failed to parse datetime (%s): %w", str, err)
+		return DateTime{}, &ParseError{Op: "parse", Kind: "datetime", Input: str, Layout: layout, Err: err}
 	}
 
 	return DateTime{t: t}, nil
@@ -68,7 +92,7 @@ func DateTimeFromLayout(layout, str string) (DateTime, error) {
 func DateTimeFromLayoutLocation(layout, str string, loc *time.Location) (DateTime, error) {
 	t, err := time.ParseInLocation(layout, str, loc)
 	if err != nil {
-		return DateTime{}, fmt.Errorf("failed to parse datetime (%s): %w", str, err)
+		return DateTime{}, &ParseError{Op: "parse", Kind: "datetime", Input: str, Layout: layout, Err: err}
 	}
 
 	return DateTime{t: t}, nil
@@ -118,6 +142,18 @@ func (d DateTime) ToTime() Time {
 	return NewTime(d.t.Hour(), d.t.Minute(), d.t.Second(), d.t.Nanosecond(), d.t.Location())
 }
 
+// ToDateIn converts the datetime to loc before discarding the time component,
+// so it returns the calendar date as seen in loc.
+func (d DateTime) ToDateIn(loc *time.Location) Date {
+	return d.In(loc).ToDate()
+}
+
+// ToTimeIn converts the datetime to loc before discarding the date component,
+// so it returns the wall clock time as seen in loc.
+func (d DateTime) ToTimeIn(loc *time.Location) Time {
+	return d.In(loc).ToTime()
+}
+
 // Add returns the time t+d.
 func (d DateTime) Add(dur time.Duration) DateTime {
 	return DateTime{t: d.t.Add(dur)}
@@ -128,27 +164,30 @@ func (d DateTime) AddDate(years int, months int, days int) DateTime {
 	return DateTime{t: d.t.AddDate(years, months, days)}
 }
 
-// After returns true if rhs is after d
+// After returns true if d is after rhs
 func (d DateTime) After(rhs DateTime) bool {
 	return d.t.After(rhs.t)
 }
 
-// AfterOrEqual returns true if rhs is equal to or after d
+// AfterOrEqual returns true if d is equal to or after rhs
 func (d DateTime) AfterOrEqual(rhs DateTime) bool {
 	return d.t.After(rhs.t) || d.t.Equal(rhs.t)
 }
 
 // AppendFormat passes through to the underlying time.Time but.
 func (d DateTime) AppendFormat(b []byte, layout string) []byte {
+	if layout == time.RFC3339 {
+		return appendRFC3339(b, d.t)
+	}
 	return d.t.AppendFormat(b, layout)
 }
 
-// Before returns true if rhs is before d
+// Before returns true if d is before rhs
 func (d DateTime) Before(rhs DateTime) bool {
 	return d.t.Before(rhs.t)
 }
 
-// BeforeOrEqual returns true if rhs is before d
+// BeforeOrEqual returns true if d is equal to or before rhs
 func (d DateTime) BeforeOrEqual(rhs DateTime) bool {
 	return d.t.Before(rhs.t) || d.t.Equal(rhs.t)
 }
@@ -158,6 +197,13 @@ func (d DateTime) Between(start, end DateTime) bool {
 	return d.t.After(start.t) && d.t.Before(end.t)
 }
 
+// BetweenBounds returns true if d is in the time range from start to end
+// including or excluding each of them according to bounds, eg.
+// BoundsClosedOpen for the half-open range [start, end).
+func (d DateTime) BetweenBounds(start, end DateTime, bounds BoundType) bool {
+	return inBounds(d.t, start.t, end.t, bounds)
+}
+
 // BetweenOrEqual returns true if d is in the inclusive time range [start, end]
 func (d DateTime) BetweenOrEqual(start, end DateTime) bool {
 	return d.AfterOrEqual(start) && d.BeforeOrEqual(end)
@@ -173,11 +219,35 @@ func (d DateTime) Day() int {
 	return d.t.Day()
 }
 
-// Equal returns true if rhs == d
+// Equal returns true if rhs == d. When both datetimes carry a monotonic
+// clock reading (from DateTimeFromNow) it's used for the comparison instead
+// of the wall clock. Use Equal rather than == which also compares the
+// monotonic reading and location.
 func (d DateTime) Equal(rhs DateTime) bool {
 	return d.t.Equal(rhs.t)
 }
 
+// EqualClock returns true if d and rhs have the same wall clock date and time
+// in their own locations regardless of their offsets, eg. 10:00+01:00 and
+// 10:00-05:00 have the same clock but are different instants.
+func (d DateTime) EqualClock(rhs DateTime) bool {
+	return equalClock(d.t, rhs.t) && sameDay(d.t, rhs.t)
+}
+
+// EqualInstant returns true if d and rhs are the same instant regardless of
+// their locations, the same as Equal
+func (d DateTime) EqualInstant(rhs DateTime) bool {
+	return d.Equal(rhs)
+}
+
+// EqualTruncated returns true if d and rhs are in the same unit, eg. the same
+// Second, ignoring anything smaller. The unit's boundaries are those of d's
+// location, see StartOf.
+func (d DateTime) EqualTruncated(rhs DateTime, unit CalendarUnit) bool {
+	loc := d.t.Location()
+	return startOf(d.t, unit).Equal(startOf(rhs.t.In(loc), unit))
+}
+
 // GoString implements fmt.GoStringer
 func (d DateTime) GoString() string {
 	y, m, day := d.t.Date()
@@ -208,7 +278,8 @@ func (d DateTime) Month() time.Month {
 
 // String returns an ISO8601 DateTime, also an RFC3339 date-time
 func (d DateTime) String() string {
-	return d.t.Format(time.RFC3339)
+	var buf [len(time.RFC3339)]byte
+	return string(appendRFC3339(buf[:0], d.t))
 }
 
 // Unix timestamp
@@ -235,27 +306,34 @@ func (d DateTime) UnixNano() int64 {
 func (d *DateTime) UnmarshalBinary(data []byte) error {
 	var t time.Time
 	if err := t.UnmarshalBinary(data); err != nil {
-		return fmt.Errorf("failed to unmarshal DateTime (%q): %w", data, err)
+		return &ParseError{Op: "unmarshal", Kind: "datetime", Input: string(data), Err: err}
 	}
 	d.t = t
 	return nil
 }
 
-// UnmarshalJSON parses a quoted ISO8601 DateTime / RFC3339 full-DateTime
+// UnmarshalJSON parses a quoted ISO8601 DateTime / RFC3339 full-DateTime. A
+// null or "" is handled according to EmptyJSONIsZero.
 func (d *DateTime) UnmarshalJSON(data []byte) error {
+	if empty, err := emptyJSON(data, "datetime"); empty {
+		*d = DateTime{}
+		return err
+	}
+
 	var t time.Time
 	if err := t.UnmarshalJSON(data); err != nil {
-		return fmt.Errorf("failed to unmarshal DateTime (%q): %w", data, err)
+		return &ParseError{Op: "unmarshal", Kind: "datetime", Input: string(data), Err: err}
 	}
 	d.t = t
 	return nil
 }
 
-// UnmarshalText parses a byte string with ISO8601 DateTime / RFC3339 full-DateTime
+// UnmarshalText parses a byte string with ISO8601 DateTime / RFC3339 full-DateTime,
+// or any of the other DateTimeConfigLayouts.
 func (d *DateTime) UnmarshalText(data []byte) error {
-	var t time.Time
-	if err := t.UnmarshalText(data); err != nil {
-		return fmt.Errorf("failed to unmarshal DateTime (%q): %w", data, err)
+	t, err := parseConfigString(string(data), DateTimeConfigLayouts)
+	if err != nil {
+		return &ParseError{Op: "unmarshal", Kind: "datetime", Input: string(data), Err: err}
 	}
 	d.t = t
 	return nil
@@ -316,7 +394,29 @@ func (d DateTime) IsDST() bool {
 	return d.t.IsDST()
 }
 
-// IsZero returns true if the Date is the zero value.
+// IsAfter returns true if d is after rhs, the same as After
+func (d DateTime) IsAfter(rhs DateTime) bool {
+	return d.After(rhs)
+}
+
+// IsBefore returns true if d is before rhs, the same as Before
+func (d DateTime) IsBefore(rhs DateTime) bool {
+	return d.Before(rhs)
+}
+
+// IsOnOrAfter returns true if d is equal to or after rhs, the same as
+// AfterOrEqual
+func (d DateTime) IsOnOrAfter(rhs DateTime) bool {
+	return d.AfterOrEqual(rhs)
+}
+
+// IsOnOrBefore returns true if d is equal to or before rhs, the same as
+// BeforeOrEqual
+func (d DateTime) IsOnOrBefore(rhs DateTime) bool {
+	return d.BeforeOrEqual(rhs)
+}
+
+// IsZero returns true if the DateTime is the zero value.
 func (d DateTime) IsZero() bool {
 	return d.t.IsZero()
 }
@@ -331,6 +431,13 @@ func (d DateTime) Location() *time.Location {
 	return d.t.Location()
 }
 
+// MidnightIn returns the first instant of the calendar day that d falls on in
+// loc, see Date.AtStartOfDayIn for how days without a midnight are handled.
+func (d DateTime) MidnightIn(loc *time.Location) DateTime {
+	year, month, day := d.t.In(loc).Date()
+	return DateTime{t: startOfDay(year, month, day, loc)}
+}
+
 // Minute returns the minute of the hour
 func (d DateTime) Minute() int {
 	return d.t.Minute()
@@ -351,7 +458,9 @@ func (d DateTime) Second() int {
 	return d.t.Second()
 }
 
-// Sub returns the duration between the two times
+// Sub returns the duration between the two times. When both datetimes carry
+// a monotonic clock reading it's used, so the result isn't affected by the
+// wall clock being changed in between.
 func (d DateTime) Sub(u DateTime) time.Duration {
 	return d.t.Sub(u.t)
 }
@@ -366,16 +475,48 @@ func (d DateTime) UTC() DateTime {
 	return DateTime{t: d.t.UTC()}
 }
 
+// WithoutMonotonic strips the monotonic clock reading from the datetime, the
+// same as time.Time's Round(0). Serialized datetimes never have one so this
+// makes a value compare equal with == after a round trip.
+func (d DateTime) WithoutMonotonic() DateTime {
+	return DateTime{t: d.t.Round(0)}
+}
+
 func (d DateTime) Zone() (name string, offset int) {
 	return d.t.Zone()
 }
 
-// Value implements driver.Valuer. SQL requires the use of ISO8601.
+// Value implements driver.Valuer. SQL requires the use of ISO8601, the
+// current Dialect's DateTimeLayout is used. PosInfinity and NegInfinity are
+// written as infinity and -infinity if the Dialect supports them, otherwise
+// they are an error. SQLValueStorage can be changed to write
+// a Julian Day or unix timestamp instead, both of which lose the location, or
+// a time.Time.
 func (d DateTime) Value() (driver.Value, error) {
-	return d.t.Format(DateTimeSQLLayout), nil
+	switch SQLValueStorage {
+	case SQLStorageReal:
+		return timeToJulianDay(d.t), nil
+	case SQLStorageInteger:
+		return d.t.Unix(), nil
+	case SQLStorageTime:
+		return d.t, nil
+	}
+	if inf := d.infinity(); inf != 0 {
+		return infinitySQL(inf, "datetime")
+	}
+	return d.t.Format(currentDialect().DateTimeLayout), nil
 }
 
 // Scan implements sql.Scanner. SQL requires the use of ISO8601.
+// Offsets with minutes (+05:30) are also accepted since that is what postgres
+// returns for zones that aren't a whole number of hours. Strings that aren't
+// in DateTimeSQLLayout are also tried as RFC3339 (which some drivers like
+// sqlite return), then with the current Dialect's DateTimeScanLayouts and
+// finally with any layouts added by RegisterScanLayout. Strings that are
+// still not understood but are a number of seconds like 1700000000 or
+// 1700000000.123 are unix timestamps.
+// Integers are unix timestamps and floats are Julian Days like Date.Scan.
+// Postgres' infinity and -infinity become PosInfinity and NegInfinity.
 func (d *DateTime) Scan(value any) error {
 	if value == nil {
 		d.t = time.Time{}
@@ -388,20 +529,36 @@ func (d *DateTime) Scan(value any) error {
 		d.t = time.Unix(v, 0).UTC()
 		return nil
 	case float64:
+		if isScanJulianDay(v) {
+			d.t = julianDayToTime(v)
+			return nil
+		}
 		// Assume this is a unix timestamp in float
 		d.t = time.Unix(int64(v), 0).UTC()
 		return nil
 	case string:
-		t, err := time.Parse(DateTimeSQLLayout, v)
+		if inf := sqlInfinity(v); inf != 0 {
+			*d = infiniteDateTime(inf)
+			return nil
+		}
+		t, err := scanDateTime(v)
 		if err != nil {
-			return fmt.Errorf("failed to scan datetime (%q): %w", v, err)
+			return &ParseError{Op: "scan", Kind: "datetime", Input: v, Layout: DateTimeSQLLayout, Err: err}
 		}
 		d.t = t
 		return nil
 	case []byte:
-		t, err := time.Parse(DateTimeSQLLayout, string(v))
+		if t, ok := parseSQLDateTime(v); ok {
+			d.t = t
+			return nil
+		}
+		if inf := sqlInfinity(v); inf != 0 {
+			*d = infiniteDateTime(inf)
+			return nil
+		}
+		t, err := scanDateTime(string(v))
 		if err != nil {
-			return fmt.Errorf("failed to scan datetime (%q): %w", v, err)
+			return &ParseError{Op: "scan", Kind: "datetime", Input: string(v), Layout: DateTimeSQLLayout, Err: err}
 		}
 		d.t = t
 		return nil
@@ -410,5 +567,5 @@ func (d *DateTime) Scan(value any) error {
 		return nil
 	}
 
-	return fmt.Errorf("failed to scan type '%T' into datetime", value)
+	return &TypeError{Op: "scan", Kind: "datetime", Value: value}
 }
@@ -2,6 +2,7 @@ package chrono_test
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 	"time"
 
@@ -417,3 +418,226 @@ func TestDateTimeSQL(t *testing.T) {
 		t.Error("value was wrong")
 	}
 }
+
+func TestDateTimeScanLayouts(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	datetime := ref
+	if err := datetime.Scan(nil); err != nil {
+		t.Error(err)
+	}
+	if !datetime.IsZero() {
+		t.Error("expected the zero value")
+	}
+
+	for _, in := range []string{"2000-01-02T03:04:05Z", "2000-01-02T04:04:05+01:00", "2000-01-02 03:04:05+00:00"} {
+		datetime = chrono.DateTime{}
+		if err := datetime.Scan(in); err != nil {
+			t.Error(in, err)
+		}
+		if !datetime.Equal(ref) {
+			t.Error(in, "value was wrong", datetime)
+		}
+	}
+
+	const layout = "02 Jan 2006 15:04:05 MST"
+	if err := datetime.Scan([]byte("02 Jan 2000 03:04:05 UTC")); err == nil {
+		t.Error("expected an error before registering")
+	}
+	chrono.RegisterScanLayout(layout)
+	datetime = chrono.DateTime{}
+	if err := datetime.Scan([]byte("02 Jan 2000 03:04:05 UTC")); err != nil {
+		t.Error(err)
+	}
+	if !datetime.Equal(ref) {
+		t.Error("value was wrong", datetime)
+	}
+}
+
+func TestDateTimeScanEpochString(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewDateTime(2023, 11, 14, 22, 13, 20, 0, time.UTC)
+	tests := []struct {
+		In   string
+		Want chrono.DateTime
+	}{
+		{"1700000000", ref},
+		{"1700000000.123", ref.Add(123 * time.Millisecond)},
+		{"1700000000.123456789", ref.Add(123456789)},
+		{"-1.5", chrono.NewDateTime(1969, 12, 31, 23, 59, 58, 500000000, time.UTC)},
+		{"0", chrono.NewDateTime(1970, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for i, test := range tests {
+		var datetime chrono.DateTime
+		if err := datetime.Scan(test.In); err != nil {
+			t.Errorf("%d) %v", i, err)
+		}
+		if !datetime.Equal(test.Want) {
+			t.Errorf("%d) value was wrong, want: %s, got: %s", i, test.Want, datetime)
+		}
+		datetime = chrono.DateTime{}
+		if err := datetime.Scan([]byte(test.In)); err != nil || !datetime.Equal(test.Want) {
+			t.Errorf("%d) []byte value was wrong: %s %v", i, datetime, err)
+		}
+	}
+
+	for _, in := range []string{"", "-", "1.", ".5", "1.1234567890", "1e9", "1700000000.12a", "9999999999999999999"} {
+		var datetime chrono.DateTime
+		if err := datetime.Scan(in); err == nil {
+			t.Errorf("%q should not scan: %s", in, datetime)
+		}
+	}
+}
+
+func TestDateTimeMidnightIn(t *testing.T) {
+	t.Parallel()
+
+	santiago, err := time.LoadLocation("America/Santiago")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2022-09-11 12:00 in Santiago is still 2022-09-11 there
+	ref := chrono.NewDateTime(2022, 9, 11, 15, 0, 0, 0, time.UTC)
+	got := ref.MidnightIn(santiago)
+	if want := time.Date(2022, 9, 11, 4, 0, 0, 0, time.UTC); !got.ToStdTime().Equal(want) {
+		t.Error("midnight wrong:", got)
+	}
+
+	// 02:00 UTC is still the 10th in Santiago
+	ref = chrono.NewDateTime(2022, 9, 11, 2, 0, 0, 0, time.UTC)
+	got = ref.MidnightIn(santiago)
+	if want := time.Date(2022, 9, 10, 0, 0, 0, 0, santiago); !got.ToStdTime().Equal(want) {
+		t.Error("midnight wrong:", got)
+	}
+
+	if got := ref.StartOf(chrono.Month, santiago); !got.Equal(chrono.NewDateTime(2022, 9, 1, 0, 0, 0, 0, santiago)) {
+		t.Error("start of month wrong:", got)
+	}
+}
+
+func TestDateTimeToDateTimeIn(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("", -5*60*60)
+	ref := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	if got := ref.ToDateIn(loc); !got.Equal(chrono.NewDate(2000, 1, 1)) {
+		t.Error("date wrong:", got)
+	}
+	if got := ref.ToDateIn(time.UTC); !got.Equal(chrono.NewDate(2000, 1, 2)) {
+		t.Error("utc date wrong:", got)
+	}
+
+	got := ref.ToTimeIn(loc)
+	if h, m, s := got.Clock(); h != 22 || m != 4 || s != 5 || got.Nanosecond() != 6 {
+		t.Error("time wrong:", got)
+	}
+	if got.Location() != loc {
+		t.Error("time location wrong:", got.Location())
+	}
+}
+
+func TestDateTimeWithoutMonotonic(t *testing.T) {
+	t.Parallel()
+
+	now := chrono.DateTimeFromNow()
+	if !strings.Contains(now.ToStdTime().String(), "m=") {
+		t.Fatal("expected a monotonic clock reading:", now.ToStdTime())
+	}
+
+	stripped := now.WithoutMonotonic()
+	if strings.Contains(stripped.ToStdTime().String(), "m=") {
+		t.Error("monotonic clock reading should be stripped:", stripped.ToStdTime())
+	}
+	if !stripped.Equal(now) {
+		t.Error("should be equal:", stripped, now)
+	}
+
+	var got chrono.DateTime
+	b, _ := stripped.MarshalBinary()
+	if err := got.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	if got != stripped {
+		t.Error("should be == after a round trip:", got, stripped)
+	}
+
+	utc := chrono.DateTimeFromNowUTC()
+	if utc.Location() != time.UTC || strings.Contains(utc.ToStdTime().String(), "m=") {
+		t.Error("should be utc without a monotonic clock reading:", utc.ToStdTime())
+	}
+}
+
+func TestComparisonAliases(t *testing.T) {
+	t.Parallel()
+
+	d1, d2 := chrono.NewDate(2000, 1, 1), chrono.NewDate(2000, 1, 2)
+	if !d2.IsAfter(d1) || d1.IsAfter(d2) || !d1.IsBefore(d2) || d2.IsBefore(d1) {
+		t.Error("date after/before wrong")
+	}
+	if !d1.IsOnOrAfter(d1) || !d2.IsOnOrAfter(d1) || d1.IsOnOrAfter(d2) || !d1.IsOnOrBefore(d1) || d2.IsOnOrBefore(d1) {
+		t.Error("date on or after/before wrong")
+	}
+
+	dt1, dt2 := chrono.NewDateTime(2000, 1, 1, 0, 0, 0, 0, time.UTC), chrono.NewDateTime(2000, 1, 1, 0, 0, 0, 1, time.UTC)
+	if !dt2.IsAfter(dt1) || dt1.IsAfter(dt2) || !dt1.IsBefore(dt2) || dt2.IsBefore(dt1) {
+		t.Error("datetime after/before wrong")
+	}
+	if !dt1.IsOnOrAfter(dt1) || dt1.IsOnOrAfter(dt2) || !dt1.IsOnOrBefore(dt1) || dt2.IsOnOrBefore(dt1) {
+		t.Error("datetime on or after/before wrong")
+	}
+
+	t1, t2 := chrono.NewTime(1, 0, 0, 0, time.UTC), chrono.NewTime(2, 0, 0, 0, time.UTC)
+	if !t2.IsAfter(t1) || !t1.IsBefore(t2) || !t1.IsOnOrAfter(t1) || t1.IsOnOrAfter(t2) || !t1.IsOnOrBefore(t2) || t2.IsOnOrBefore(t1) {
+		t.Error("time comparisons wrong")
+	}
+
+	l1, l2 := chrono.NewLocalDateTime(2000, 1, 1, 0, 0, 0, 0), chrono.NewLocalDateTime(2000, 1, 2, 0, 0, 0, 0)
+	if !l2.IsAfter(l1) || !l1.IsBefore(l2) || !l1.IsOnOrAfter(l1) || l1.IsOnOrAfter(l2) || !l1.IsOnOrBefore(l2) || l2.IsOnOrBefore(l1) {
+		t.Error("local datetime comparisons wrong")
+	}
+}
+
+func TestDateTimeEqualVariants(t *testing.T) {
+	t.Parallel()
+
+	east, west := time.FixedZone("", 60*60), time.FixedZone("", -5*60*60)
+	a := chrono.NewDateTime(2000, 1, 2, 10, 0, 0, 500, east)
+	b := chrono.NewDateTime(2000, 1, 2, 10, 0, 0, 500, west)
+
+	if !a.EqualClock(b) || a.EqualInstant(b) {
+		t.Error("same clock in different zones is not the same instant")
+	}
+	if c := a.In(west); !a.EqualInstant(c) || a.EqualClock(c) {
+		t.Error("same instant in different zones is not the same clock")
+	}
+	if a.EqualClock(a.AddDate(0, 0, 1)) {
+		t.Error("the date is part of the clock")
+	}
+
+	tests := []struct {
+		Unit chrono.CalendarUnit
+		RHS  chrono.DateTime
+		Want bool
+	}{
+		{chrono.Second, a.Add(time.Millisecond), true},
+		{chrono.Second, a.Add(time.Second), false},
+		{chrono.Minute, a.Add(59 * time.Second), true},
+		{chrono.Hour, a.Add(-time.Second), false},
+		{chrono.Day, chrono.NewDateTime(2000, 1, 2, 22, 59, 0, 0, time.UTC), true},
+		// 23:00 UTC is the next day in east
+		{chrono.Day, chrono.NewDateTime(2000, 1, 2, 23, 0, 0, 0, time.UTC), false},
+		{chrono.Month, chrono.NewDateTime(2000, 1, 31, 0, 0, 0, 0, east), true},
+		{chrono.Year, chrono.NewDateTime(2000, 12, 31, 0, 0, 0, 0, east), true},
+	}
+	for _, test := range tests {
+		if got := a.EqualTruncated(test.RHS, test.Unit); got != test.Want {
+			t.Errorf("%s %s: want %t, got %t", test.RHS, test.Unit, test.Want, got)
+		}
+	}
+}
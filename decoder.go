@@ -0,0 +1,132 @@
+package chrono
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"time"
+)
+
+// Decoder reads a stream of datetimes or dates, one per line or separated by
+// a separator chosen with Separator, eg. to ingest large log files. Values
+// are read with Next and retrieved with DateTime or Date:
+//
+//	dec := chrono.NewDecoder(r, time.RFC3339)
+//	for dec.Next() {
+//		use(dec.DateTime())
+//	}
+//	if err := dec.Err(); err != nil {
+//		...
+//	}
+//
+// The layout is examined once, the common layouts (time.RFC3339,
+// time.RFC3339Nano, DateTimeSQLLayout and 2006-01-02) are parsed directly from
+// the read buffer without allocating. Blank values are skipped and spaces
+// around values are ignored.
+type Decoder struct {
+	scanner *bufio.Scanner
+	layout  string
+	fast    func([]byte) (time.Time, bool)
+	sep     byte
+
+	index int
+	t     time.Time
+	err   error
+}
+
+// NewDecoder creates a decoder reading values in layout from r
+func NewDecoder(r io.Reader, layout string) *Decoder {
+	d := &Decoder{
+		scanner: bufio.NewScanner(r),
+		layout:  layout,
+		fast:    func([]byte) (time.Time, bool) { return time.Time{}, false },
+		sep:     '\n',
+		index:   -1,
+	}
+	switch layout {
+	case time.RFC3339, time.RFC3339Nano:
+		d.fast = parseRFC3339[[]byte]
+	case DateTimeSQLLayout:
+		d.fast = parseSQLDateTime[[]byte]
+	case dateLayout:
+		d.fast = parseSQLDate[[]byte]
+	}
+	d.scanner.Split(d.split)
+	return d
+}
+
+// Separator changes what separates values from a newline to sep (a newline
+// still ends a value), eg. ',' for comma separated values. It must be called
+// before the first call to Next.
+func (d *Decoder) Separator(sep byte) *Decoder {
+	d.sep = sep
+	return d
+}
+
+// Next reads the next value, it returns false at the end of the input or
+// when a value fails to parse, see Err.
+func (d *Decoder) Next() bool {
+	if d.err != nil {
+		return false
+	}
+
+	for d.scanner.Scan() {
+		b := bytes.TrimSpace(d.scanner.Bytes())
+		if len(b) == 0 {
+			continue
+		}
+		d.index++
+
+		if t, ok := d.fast(b); ok {
+			d.t = t
+			return true
+		}
+		t, err := time.Parse(d.layout, string(b))
+		if err != nil {
+			d.t = time.Time{}
+			d.err = &IndexError{Index: d.index, Err: &ParseError{Op: "decode", Kind: "datetime", Input: string(b), Layout: d.layout, Err: err}}
+			return false
+		}
+		d.t = t
+		return true
+	}
+
+	d.t = time.Time{}
+	d.err = d.scanner.Err()
+	return false
+}
+
+// DateTime returns the value read by the last call to Next
+func (d *Decoder) DateTime() DateTime {
+	return DateTime{t: d.t}
+}
+
+// Date returns the date of the value read by the last call to Next
+func (d *Decoder) Date() Date {
+	return DateFromStdTime(d.t)
+}
+
+// Index returns the zero based position of the value read by the last call
+// to Next among the non-blank values
+func (d *Decoder) Index() int {
+	return d.index
+}
+
+// Err returns the first error encountered by Next, a value that fails to
+// parse is reported as an *IndexError. The end of the input is not an error.
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+// split is a bufio.SplitFunc that splits on newlines and the separator
+func (d *Decoder) split(data []byte, atEOF bool) (int, []byte, error) {
+	for i, c := range data {
+		if c == '\n' || c == d.sep {
+			return i + 1, data[:i], nil
+		}
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
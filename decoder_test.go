@@ -0,0 +1,114 @@
+package chrono_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/chronotest"
+)
+
+func TestDecoder(t *testing.T) {
+	t.Parallel()
+
+	in := "2000-01-02T03:04:05Z\r\n\n  2000-01-02T03:04:05.5+01:00\n2000-01-03T00:00:00-05:30"
+	want := []chrono.DateTime{
+		chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC),
+		chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 500000000, time.FixedZone("", 60*60)),
+		chrono.NewDateTime(2000, 1, 3, 0, 0, 0, 0, time.FixedZone("", -(5*60*60+30*60))),
+	}
+
+	dec := chrono.NewDecoder(strings.NewReader(in), time.RFC3339)
+	var got []chrono.DateTime
+	for dec.Next() {
+		if dec.Index() != len(got) {
+			t.Error("wrong index:", dec.Index())
+		}
+		got = append(got, dec.DateTime())
+	}
+	if err := dec.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatal("wrong count:", len(got))
+	}
+	for i := range want {
+		if got[i].Format(time.RFC3339Nano) != want[i].Format(time.RFC3339Nano) {
+			t.Errorf("%d: want %s, got %s", i, want[i], got[i])
+		}
+	}
+}
+
+func TestDecoderSeparator(t *testing.T) {
+	t.Parallel()
+
+	dec := chrono.NewDecoder(strings.NewReader("2000-01-02, 2000-01-03,\n2000-01-04,"), "2006-01-02").Separator(',')
+	var got []chrono.Date
+	for dec.Next() {
+		got = append(got, dec.Date())
+	}
+	if err := dec.Err(); err != nil {
+		t.Fatal(err)
+	}
+	want := chronotest.DateSequence(chrono.NewDate(2000, 1, 2), 1, 3)
+	if len(got) != len(want) {
+		t.Fatal("wrong count:", got)
+	}
+	for i := range want {
+		chronotest.AssertEqualDate(t, want[i], got[i])
+	}
+
+	dec = chrono.NewDecoder(strings.NewReader("Mon, 02 Jan 2006 15:04:05 MST\nTue, 03 Jan 2006 15:04:05 MST"), time.RFC1123)
+	n := 0
+	for dec.Next() {
+		n++
+	}
+	if err := dec.Err(); err != nil || n != 2 {
+		t.Error("commas should be kept with the default separator:", n, err)
+	}
+}
+
+func TestDecoderError(t *testing.T) {
+	t.Parallel()
+
+	dec := chrono.NewDecoder(strings.NewReader("2000-01-02\n2000-13-02\n2000-01-04"), "2006-01-02")
+	n := 0
+	for dec.Next() {
+		n++
+	}
+	if n != 1 {
+		t.Error("should stop at the bad value:", n)
+	}
+
+	var idxErr *chrono.IndexError
+	if !errors.As(dec.Err(), &idxErr) || idxErr.Index != 1 {
+		t.Error("wrong error:", dec.Err())
+	}
+	var parseErr *chrono.ParseError
+	if !errors.As(dec.Err(), &parseErr) || parseErr.Input != "2000-13-02" {
+		t.Error("wrong error:", dec.Err())
+	}
+	if dec.Next() {
+		t.Error("next should keep failing")
+	}
+}
+
+func BenchmarkDecoder(b *testing.B) {
+	var buf bytes.Buffer
+	for _, d := range chronotest.Sequence(chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC), time.Second, 1000) {
+		buf.WriteString(d.Format(time.RFC3339))
+		buf.WriteByte('\n')
+	}
+	data := buf.Bytes()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dec := chrono.NewDecoder(bytes.NewReader(data), time.RFC3339)
+		for dec.Next() {
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package chrono
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Dialect is the set of layouts a database uses for the text forms of its
+// date and time types. Value writes with the dialect's layouts and Scan
+// accepts the ScanLayouts in addition to the canonical SQL layouts, so a
+// dialect only has to list the forms that differ from them.
+type Dialect struct {
+	Name string
+
+	// Layouts used by Value
+	TimeLayout          string
+	DateTimeLayout      string
+	LocalDateTimeLayout string
+
+	// Extra layouts accepted by Scan
+	DateScanLayouts          []string
+	TimeScanLayouts          []string
+	DateTimeScanLayouts      []string
+	LocalDateTimeScanLayouts []string
+
+	// Infinity is true if the database has infinity and -infinity, Value
+	// writes them for PosInfinity and NegInfinity instead of returning an
+	// error
+	Infinity bool
+}
+
+// Dialects
+var (
+	// DialectPostgres is the default. DialectStandard has the same layouts
+	// without infinity.
+	DialectPostgres = Dialect{
+		Name:                "postgres",
+		TimeLayout:          TimeSQLLayout,
+		DateTimeLayout:      DateTimeSQLLayout,
+		LocalDateTimeLayout: LocalDateTimeSQLLayout,
+		Infinity:            true,
+	}
+
+	// DialectStandard uses the canonical SQL layouts like DialectPostgres
+	// but without infinity, it's suitable for MySQL and SQLite.
+	DialectStandard = Dialect{
+		Name:                "standard",
+		TimeLayout:          TimeSQLLayout,
+		DateTimeLayout:      DateTimeSQLLayout,
+		LocalDateTimeLayout: LocalDateTimeSQLLayout,
+	}
+
+	// DialectSQLServer writes and scans the text forms of SQL Server's time,
+	// datetime2 and datetimeoffset which have 100ns precision and a space
+	// before the offset.
+	DialectSQLServer = Dialect{
+		Name:                     "sqlserver",
+		TimeLayout:               "15:04:05.9999999",
+		DateTimeLayout:           "2006-01-02 15:04:05.9999999 -07:00",
+		LocalDateTimeLayout:      "2006-01-02 15:04:05.9999999",
+		TimeScanLayouts:          []string{"15:04:05.9999999"},
+		DateTimeScanLayouts:      []string{"2006-01-02 15:04:05.9999999 -07:00", "2006-01-02T15:04:05.9999999-07:00"},
+		LocalDateTimeScanLayouts: []string{"2006-01-02T15:04:05.9999999"},
+	}
+
+	// DialectOracle scans Oracle's default NLS formats for DATE and TIMESTAMP
+	// (02-JAN-06 03.04.05.000000 PM) as well as ISO8601. Oracle has no TIME
+	// type and converts strings using the session's NLS settings, so values
+	// are written in ISO8601 and the session must be configured to match:
+	//
+	//	ALTER SESSION SET NLS_DATE_FORMAT = 'YYYY-MM-DD'
+	//	ALTER SESSION SET NLS_TIMESTAMP_FORMAT = 'YYYY-MM-DD HH24:MI:SS.FF'
+	//	ALTER SESSION SET NLS_TIMESTAMP_TZ_FORMAT = 'YYYY-MM-DD HH24:MI:SS.FF TZH:TZM'
+	DialectOracle = Dialect{
+		Name:                "oracle",
+		TimeLayout:          "15:04:05.999999999",
+		DateTimeLayout:      "2006-01-02 15:04:05.999999999 -07:00",
+		LocalDateTimeLayout: "2006-01-02 15:04:05.999999999",
+		DateScanLayouts:     []string{"02-Jan-06", "2006-01-02 15:04:05", "02-Jan-06 03.04.05.999999999 PM"},
+		TimeScanLayouts:     []string{"15:04:05.999999999"},
+		DateTimeScanLayouts: []string{
+			"02-Jan-06 03.04.05.999999999 PM -07:00",
+			"2006-01-02 15:04:05.999999999 -07:00",
+		},
+		LocalDateTimeScanLayouts: []string{
+			"02-Jan-06 03.04.05.999999999 PM",
+			"02-Jan-06",
+			"2006-01-02 15:04:05",
+		},
+	}
+)
+
+// sqlDialect holds a *Dialect
+var sqlDialect atomic.Value
+
+func init() {
+	sqlDialect.Store(&DialectPostgres)
+}
+
+// SetDialect changes the dialect used by Value and Scan. It is safe for
+// concurrent use but is intended to be called during program initialization.
+func SetDialect(d Dialect) {
+	sqlDialect.Store(&d)
+}
+
+// CurrentDialect returns the dialect used by Value and Scan
+func CurrentDialect() Dialect {
+	return *currentDialect()
+}
+
+func currentDialect() *Dialect {
+	return sqlDialect.Load().(*Dialect)
+}
+
+// parseLayouts tries each layout in turn
+func parseLayouts(str string, layouts []string) (time.Time, bool) {
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, str); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,100 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+// The dialect tests cannot be parallel since they change a package variable
+
+func TestDialectSQLServer(t *testing.T) {
+	defer chrono.SetDialect(chrono.DialectPostgres)
+	chrono.SetDialect(chrono.DialectSQLServer)
+
+	if got := chrono.CurrentDialect().Name; got != "sqlserver" {
+		t.Error("dialect wrong:", got)
+	}
+
+	loc := time.FixedZone("", -7*60*60)
+	datetime := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 123456700, loc)
+	if v, err := datetime.Value(); err != nil {
+		t.Error(err)
+	} else if v != "2000-01-02 03:04:05.1234567 -07:00" {
+		t.Error("datetimeoffset value wrong:", v)
+	}
+
+	var got chrono.DateTime
+	if err := got.Scan([]byte("2000-01-02 03:04:05.1234567 -07:00")); err != nil {
+		t.Error(err)
+	}
+	if !got.Equal(datetime) {
+		t.Error("datetimeoffset scan wrong:", got)
+	}
+
+	var local chrono.LocalDateTime
+	if err := local.Scan("2000-01-02T03:04:05.1234567"); err != nil {
+		t.Error(err)
+	}
+	if local != chrono.NewLocalDateTime(2000, 1, 2, 3, 4, 5, 123456700) {
+		t.Error("datetime2 scan wrong:", local)
+	}
+
+	var tm chrono.Time
+	if err := tm.Scan("03:04:05.1234567"); err != nil {
+		t.Error(err)
+	}
+	if h, m, s := tm.Clock(); h != 3 || m != 4 || s != 5 || tm.Nanosecond() != 123456700 {
+		t.Error("time scan wrong:", tm)
+	}
+	if v, err := tm.Value(); err != nil {
+		t.Error(err)
+	} else if v != "03:04:05.1234567" {
+		t.Error("time value wrong:", v)
+	}
+}
+
+func TestDialectOracle(t *testing.T) {
+	defer chrono.SetDialect(chrono.DialectPostgres)
+	chrono.SetDialect(chrono.DialectOracle)
+
+	var date chrono.Date
+	if err := date.Scan("02-JAN-00"); err != nil {
+		t.Error(err)
+	}
+	if !date.Equal(chrono.NewDate(2000, 1, 2)) {
+		t.Error("date scan wrong:", date)
+	}
+
+	var local chrono.LocalDateTime
+	if err := local.Scan([]byte("02-JAN-00 03.04.05.500000 PM")); err != nil {
+		t.Error(err)
+	}
+	if local != chrono.NewLocalDateTime(2000, 1, 2, 15, 4, 5, 500000000) {
+		t.Error("timestamp scan wrong:", local)
+	}
+
+	var datetime chrono.DateTime
+	if err := datetime.Scan("02-JAN-00 03.04.05.000000 AM -07:00"); err != nil {
+		t.Error(err)
+	}
+	if !datetime.Equal(chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.FixedZone("", -7*60*60))) {
+		t.Error("timestamp with time zone scan wrong:", datetime)
+	}
+
+	// The canonical layouts are still accepted
+	if err := datetime.Scan("2000-01-02 03:04:05+00"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDialectPostgresDefault(t *testing.T) {
+	t.Parallel()
+
+	// Dialect specific layouts aren't accepted by default
+	var date chrono.Date
+	if err := date.Scan("02-JAN-00"); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -0,0 +1,433 @@
+package chrono
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationFormat selects the textual representation of a Duration
+type DurationFormat int
+
+// Duration formats
+const (
+	// DurationFormatGo is the format used by time.Duration, eg. "1h30m0s"
+	DurationFormatGo DurationFormat = iota
+	// DurationFormatISO is an ISO8601 duration, eg. "PT1H30M"
+	DurationFormatISO
+)
+
+// DurationJSONFormat is the format Duration uses when marshalling to JSON.
+// Both formats are always accepted when unmarshalling.
+var DurationJSONFormat = DurationFormatGo
+
+// Duration is a time.Duration that can be stored in SQL databases (as a
+// postgres interval) and encoded as JSON.
+type Duration time.Duration
+
+// DurationFromString parses either a Go duration ("1h30m") or an ISO8601
+// duration ("PT1H30M"). ISO8601 durations may not contain years or months as
+// they have no fixed length, days and weeks are taken to be 24 hours and 7
+// days respectively.
+func DurationFromString(str string) (Duration, error) {
+	if d, err := parseISODuration(str); err == nil {
+		return d, nil
+	} else if isISODuration(str) {
+		return 0, &ParseError{Op: "parse", Kind: "duration", Input: str, Err: err}
+	}
+
+	d, err := time.ParseDuration(str)
+	if err != nil {
+		return 0, &ParseError{Op: "parse", Kind: "duration", Input: str, Err: err}
+	}
+	return Duration(d), nil
+}
+
+// Std returns the duration as a time.Duration
+func (d Duration) Std() time.Duration {
+	return time.Duration(d)
+}
+
+// Days returns the duration as a floating point number of 24 hour days
+func (d Duration) Days() float64 {
+	return time.Duration(d).Hours() / 24
+}
+
+// Weeks returns the duration as a floating point number of 7 day weeks
+func (d Duration) Weeks() float64 {
+	return d.Days() / 7
+}
+
+// Hours returns the duration as a floating point number of hours
+func (d Duration) Hours() float64 {
+	return time.Duration(d).Hours()
+}
+
+// Minutes returns the duration as a floating point number of minutes
+func (d Duration) Minutes() float64 {
+	return time.Duration(d).Minutes()
+}
+
+// Seconds returns the duration as a floating point number of seconds
+func (d Duration) Seconds() float64 {
+	return time.Duration(d).Seconds()
+}
+
+// String returns the duration in the same format as time.Duration
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+// ISOString returns the duration as an ISO8601 duration using only hours,
+// minutes and seconds, eg. "PT36H0.5S". Negative durations are prefixed with
+// a '-'.
+func (d Duration) ISOString() string {
+	return string(d.appendISO(nil))
+}
+
+// Format returns the duration in the given format
+func (d Duration) Format(format DurationFormat) string {
+	if format == DurationFormatISO {
+		return d.ISOString()
+	}
+	return d.String()
+}
+
+// MarshalJSON implements json.Marshaller using DurationJSONFormat
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + d.Format(DurationJSONFormat) + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaller using DurationJSONFormat
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.Format(DurationJSONFormat)), nil
+}
+
+// UnmarshalJSON parses a quoted Go or ISO8601 duration
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return &ParseError{Op: "unmarshal", Kind: "duration", Input: string(data), Err: errors.New("expected a string")}
+	}
+	return d.UnmarshalText(data[1 : len(data)-1])
+}
+
+// UnmarshalText parses a Go or ISO8601 duration
+func (d *Duration) UnmarshalText(data []byte) error {
+	dur, err := DurationFromString(string(data))
+	if err != nil {
+		return err
+	}
+	*d = dur
+	return nil
+}
+
+// Value implements driver.Valuer. The duration is formatted as a postgres
+// interval in hours, minutes and seconds (eg. 36:00:00.5) which is also
+// understood by mysql's TIME type.
+func (d Duration) Value() (driver.Value, error) {
+	return d.intervalString(), nil
+}
+
+// Scan implements sql.Scanner. It accepts the postgres interval output format
+// (eg. "1 day 02:03:04.5") but rejects intervals with years or months since
+// they have no fixed length.
+func (d *Duration) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*d = 0
+		return nil
+	case string:
+		dur, err := parseInterval(v)
+		if err != nil {
+			return &ParseError{Op: "scan", Kind: "duration", Input: v, Err: err}
+		}
+		*d = dur
+		return nil
+	case []byte:
+		dur, err := parseInterval(string(v))
+		if err != nil {
+			return &ParseError{Op: "scan", Kind: "duration", Input: string(v), Err: err}
+		}
+		*d = dur
+		return nil
+	}
+
+	return &TypeError{Op: "scan", Kind: "duration", Value: value}
+}
+
+func (d Duration) appendISO(b []byte) []byte {
+	if d < 0 {
+		b = append(b, '-')
+	}
+	b = append(b, 'P', 'T')
+	if d == 0 {
+		return append(b, '0', 'S')
+	}
+
+	// Negating math.MinInt64 overflows, work with the unsigned value
+	u := uint64(d)
+	if d < 0 {
+		u = -u
+	}
+
+	hours := u / uint64(time.Hour)
+	u -= hours * uint64(time.Hour)
+	mins := u / uint64(time.Minute)
+	u -= mins * uint64(time.Minute)
+
+	if hours > 0 {
+		b = strconv.AppendUint(b, hours, 10)
+		b = append(b, 'H')
+	}
+	if mins > 0 {
+		b = strconv.AppendUint(b, mins, 10)
+		b = append(b, 'M')
+	}
+	if u > 0 {
+		b = appendSeconds(b, u)
+		b = append(b, 'S')
+	}
+	return b
+}
+
+func (d Duration) intervalString() string {
+	var b []byte
+	u := uint64(d)
+	if d < 0 {
+		b = append(b, '-')
+		u = -u
+	}
+
+	hours := u / uint64(time.Hour)
+	u -= hours * uint64(time.Hour)
+	mins := u / uint64(time.Minute)
+	u -= mins * uint64(time.Minute)
+
+	if hours < 10 {
+		b = append(b, '0')
+	}
+	b = strconv.AppendUint(b, hours, 10)
+	b = append(b, ':', byte('0'+mins/10), byte('0'+mins%10), ':')
+	if u < uint64(10*time.Second) {
+		b = append(b, '0')
+	}
+	return string(appendSeconds(b, u))
+}
+
+// appendSeconds appends nanoseconds as seconds with a fractional part that
+// has trailing zeros removed
+func appendSeconds(b []byte, nsec uint64) []byte {
+	b = strconv.AppendUint(b, nsec/uint64(time.Second), 10)
+	frac := nsec % uint64(time.Second)
+	if frac == 0 {
+		return b
+	}
+
+	var buf [9]byte
+	for i := 8; i >= 0; i-- {
+		buf[i] = byte('0' + frac%10)
+		frac /= 10
+	}
+	return append(append(b, '.'), strings.TrimRight(string(buf[:]), "0")...)
+}
+
+// isISODuration checks if the string looks like an attempt at an ISO8601
+// duration
+func isISODuration(str string) bool {
+	str = strings.TrimPrefix(str, "-")
+	return len(str) > 0 && str[0] == 'P'
+}
+
+// parseISODuration parses an ISO8601 duration that has no year or month
+// components
+func parseISODuration(str string) (Duration, error) {
+	p, err := parseISOPeriod(str)
+	if err != nil {
+		return 0, err
+	}
+	d, ok := p.ExactDuration()
+	if !ok {
+		return 0, errors.New("years and months have no fixed duration")
+	}
+	return d, nil
+}
+
+// parseISOPeriod parses an ISO8601 duration (PnYnMnDTnHnMnS or PnW), only the
+// smallest component may have a fraction.
+func parseISOPeriod(str string) (Period, error) {
+	var p Period
+	negative := strings.HasPrefix(str, "-")
+	if negative {
+		str = str[1:]
+	}
+	if len(str) < 2 || str[0] != 'P' {
+		return p, errors.New("expected 'P'")
+	}
+	str = str[1:]
+
+	inTime := false
+	seenFraction := false
+	for len(str) > 0 {
+		if str[0] == 'T' {
+			if inTime {
+				return p, errors.New("unexpected 'T'")
+			}
+			inTime = true
+			str = str[1:]
+			if len(str) == 0 {
+				return p, errors.New("expected time components after 'T'")
+			}
+			continue
+		}
+		if seenFraction {
+			return p, errors.New("only the last component may have a fraction")
+		}
+
+		// Individual components may be negative, eg. P-1Y2M
+		sign := int64(1)
+		if str[0] == '-' {
+			sign, str = -1, str[1:]
+		}
+		n := countDigits(str)
+		if n == 0 {
+			return p, fmt.Errorf("expected number at %q", str)
+		}
+		whole, err := strconv.ParseInt(str[:n], 10, 64)
+		if err != nil {
+			return p, err
+		}
+		whole *= sign
+		str = str[n:]
+
+		var frac float64
+		if len(str) > 0 && (str[0] == '.' || str[0] == ',') {
+			fn := countDigits(str[1:])
+			if fn == 0 {
+				return p, errors.New("expected digits after decimal separator")
+			}
+			frac, _ = strconv.ParseFloat("0."+str[1:1+fn], 64)
+			frac *= float64(sign)
+			str = str[1+fn:]
+			seenFraction = true
+		}
+
+		if len(str) == 0 {
+			return p, errors.New("missing designator")
+		}
+		designator := str[0]
+		str = str[1:]
+
+		if !inTime {
+			if frac != 0 && designator != 'D' && designator != 'W' {
+				return p, errors.New("fractional years and months are not supported")
+			}
+			switch designator {
+			case 'Y':
+				p.Years += int(whole)
+			case 'M':
+				p.Months += int(whole)
+			case 'W':
+				p.Days += int(whole) * 7
+				p.Duration += time.Duration(frac * 7 * float64(24*time.Hour))
+			case 'D':
+				p.Days += int(whole)
+				p.Duration += time.Duration(frac * float64(24*time.Hour))
+			default:
+				return p, fmt.Errorf("unknown date designator %q", designator)
+			}
+			continue
+		}
+
+		var unit time.Duration
+		switch designator {
+		case 'H':
+			unit = time.Hour
+		case 'M':
+			unit = time.Minute
+		case 'S':
+			unit = time.Second
+		default:
+			return p, fmt.Errorf("unknown time designator %q", designator)
+		}
+		p.Duration += time.Duration(whole)*unit + time.Duration(math.Round(frac*float64(unit)))
+	}
+
+	if negative {
+		p.Years, p.Months, p.Days, p.Duration = -p.Years, -p.Months, -p.Days, -p.Duration
+	}
+	return p, nil
+}
+
+// parseInterval parses postgres' default interval output style, eg.
+// "-1 days +02:03:04.5" or "3 days". Years and months are rejected.
+func parseInterval(str string) (Duration, error) {
+	fields := strings.Fields(str)
+	if len(fields) == 0 {
+		return 0, errors.New("empty interval")
+	}
+
+	var total time.Duration
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+		if strings.Contains(f, ":") {
+			clock, err := parseIntervalClock(f)
+			if err != nil {
+				return 0, err
+			}
+			total += clock
+			continue
+		}
+
+		n, err := strconv.ParseInt(f, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("unexpected %q in interval", f)
+		}
+		if i+1 >= len(fields) {
+			return 0, fmt.Errorf("missing unit after %q", f)
+		}
+		i++
+		switch strings.TrimSuffix(fields[i], "s") {
+		case "day":
+			total += time.Duration(n) * 24 * time.Hour
+		case "year", "mon":
+			return 0, errors.New("years and months have no fixed duration")
+		default:
+			return 0, fmt.Errorf("unknown unit %q in interval", fields[i])
+		}
+	}
+	return Duration(total), nil
+}
+
+// parseIntervalClock parses [+-]HH:MM:SS[.ffffff] where HH may exceed 24
+func parseIntervalClock(str string) (time.Duration, error) {
+	sign := time.Duration(1)
+	switch {
+	case strings.HasPrefix(str, "-"):
+		sign, str = -1, str[1:]
+	case strings.HasPrefix(str, "+"):
+		str = str[1:]
+	}
+
+	parts := strings.Split(str, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid interval time %q", str)
+	}
+	hours, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid interval hours %q", parts[0])
+	}
+	mins, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil || mins > 59 {
+		return 0, fmt.Errorf("invalid interval minutes %q", parts[1])
+	}
+	secs, err := time.ParseDuration(parts[2] + "s")
+	if err != nil || secs < 0 || secs >= time.Minute {
+		return 0, fmt.Errorf("invalid interval seconds %q", parts[2])
+	}
+
+	return sign * (time.Duration(hours)*time.Hour + time.Duration(mins)*time.Minute + secs), nil
+}
@@ -0,0 +1,153 @@
+package chrono_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestDurationFromString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   string
+		Want time.Duration
+	}{
+		{"1h30m", 90 * time.Minute},
+		{"-1.5s", -1500 * time.Millisecond},
+		{"PT1H30M", 90 * time.Minute},
+		{"PT0.5S", 500 * time.Millisecond},
+		{"PT1,5H", 90 * time.Minute},
+		{"P1DT2H", 26 * time.Hour},
+		{"P2W", 14 * 24 * time.Hour},
+		{"-PT1M", -time.Minute},
+		{"PT0S", 0},
+	}
+
+	for _, test := range tests {
+		d, err := chrono.DurationFromString(test.In)
+		if err != nil {
+			t.Error(test.In, err)
+			continue
+		}
+		if d.Std() != test.Want {
+			t.Errorf("%s: want %s, got %s", test.In, test.Want, d)
+		}
+	}
+
+	for _, bad := range []string{"P1Y", "P1M", "PT", "P1H", "PT1.5H2M", "1 hour", "P"} {
+		if d, err := chrono.DurationFromString(bad); err == nil {
+			t.Errorf("%s: expected an error, got %s", bad, d)
+		}
+	}
+}
+
+func TestDurationFormatting(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In       time.Duration
+		ISO      string
+		Interval string
+	}{
+		{0, "PT0S", "00:00:00"},
+		{90 * time.Minute, "PT1H30M", "01:30:00"},
+		{36*time.Hour + 500*time.Millisecond, "PT36H0.5S", "36:00:00.5"},
+		{-(time.Minute + 5*time.Second), "-PT1M5S", "-00:01:05"},
+		{time.Nanosecond, "PT0.000000001S", "00:00:00.000000001"},
+	}
+
+	for _, test := range tests {
+		d := chrono.Duration(test.In)
+		if s := d.ISOString(); s != test.ISO {
+			t.Errorf("%s: want %s, got %s", test.In, test.ISO, s)
+		}
+		v, err := d.Value()
+		if err != nil {
+			t.Error(err)
+		}
+		if v.(string) != test.Interval {
+			t.Errorf("%s: want %s, got %s", test.In, test.Interval, v)
+		}
+
+		back, err := chrono.DurationFromString(test.ISO)
+		if err != nil {
+			t.Error(err)
+		}
+		if back != d {
+			t.Errorf("%s: did not round trip, got %s", test.ISO, back)
+		}
+	}
+
+	d := chrono.Duration(36 * time.Hour)
+	if d.Days() != 1.5 || d.Weeks() != 1.5/7 || d.Hours() != 36 {
+		t.Error("helpers wrong", d.Days(), d.Weeks(), d.Hours())
+	}
+}
+
+func TestDurationJSON(t *testing.T) {
+	t.Parallel()
+
+	d := chrono.Duration(90 * time.Minute)
+	js, err := json.Marshal(d)
+	if err != nil {
+		t.Error(err)
+	}
+	if string(js) != `"1h30m0s"` {
+		t.Error("json wrong", string(js))
+	}
+
+	var back chrono.Duration
+	if err = json.Unmarshal([]byte(`"PT1H30M"`), &back); err != nil {
+		t.Error(err)
+	}
+	if back != d {
+		t.Error("value wrong", back)
+	}
+	if err = json.Unmarshal(js, &back); err != nil {
+		t.Error(err)
+	}
+	if back != d {
+		t.Error("value wrong", back)
+	}
+	if err = json.Unmarshal([]byte(`5400`), &back); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestDurationSQL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   any
+		Want time.Duration
+	}{
+		{"01:30:00", 90 * time.Minute},
+		{[]byte("36:00:00.5"), 36*time.Hour + 500*time.Millisecond},
+		{"1 day 02:00:00", 26 * time.Hour},
+		{"-1 days +02:00:00", -22 * time.Hour},
+		{"3 days", 72 * time.Hour},
+		{"-00:01:05", -(time.Minute + 5*time.Second)},
+		{nil, 0},
+	}
+
+	for _, test := range tests {
+		var d chrono.Duration
+		if err := d.Scan(test.In); err != nil {
+			t.Error(test.In, err)
+			continue
+		}
+		if d.Std() != test.Want {
+			t.Errorf("%v: want %s, got %s", test.In, test.Want, d)
+		}
+	}
+
+	var d chrono.Duration
+	for _, bad := range []any{"1 mon", "1 year 02:00:00", "01:60:00", int64(5)} {
+		if err := d.Scan(bad); err == nil {
+			t.Errorf("%v: expected an error", bad)
+		}
+	}
+}
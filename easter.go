@@ -0,0 +1,78 @@
+package chrono
+
+import "time"
+
+// EasterMethod is the computus used to find the date of Easter
+type EasterMethod int
+
+// Easter methods. EasterGregorian is used by the western churches.
+// EasterJulian is the computus on the Julian calendar used by the orthodox
+// churches, the date is still returned in the Gregorian calendar.
+const (
+	EasterGregorian EasterMethod = iota
+	EasterJulian
+)
+
+// EasterSunday returns the date of Easter Sunday in year
+func EasterSunday(year int, method EasterMethod) Date {
+	if method == EasterJulian {
+		return julianEasterSunday(year)
+	}
+	return gregorianEasterSunday(year)
+}
+
+// AshWednesday returns the first day of Lent, 46 days before Easter Sunday
+func AshWednesday(year int, method EasterMethod) Date {
+	return EasterSunday(year, method).AddDate(0, 0, -46)
+}
+
+// GoodFriday returns the Friday before Easter Sunday
+func GoodFriday(year int, method EasterMethod) Date {
+	return EasterSunday(year, method).AddDate(0, 0, -2)
+}
+
+// EasterMonday returns the Monday after Easter Sunday
+func EasterMonday(year int, method EasterMethod) Date {
+	return EasterSunday(year, method).AddDate(0, 0, 1)
+}
+
+// AscensionDay returns the Thursday 39 days after Easter Sunday
+func AscensionDay(year int, method EasterMethod) Date {
+	return EasterSunday(year, method).AddDate(0, 0, 39)
+}
+
+// Pentecost returns the Sunday 49 days after Easter Sunday
+func Pentecost(year int, method EasterMethod) Date {
+	return EasterSunday(year, method).AddDate(0, 0, 49)
+}
+
+// gregorianEasterSunday uses the anonymous Gregorian algorithm
+func gregorianEasterSunday(year int) Date {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return NewDate(year, time.Month(month), day)
+}
+
+// julianEasterSunday uses Meeus' Julian algorithm then moves the date from
+// the Julian to the Gregorian calendar
+func julianEasterSunday(year int) Date {
+	a, b, c := year%4, year%7, year%19
+	d := (19*c + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+	month := (d + e + 114) / 31
+	day := (d+e+114)%31 + 1
+
+	// Easter is always after February so the calendars are a fixed number
+	// of days apart for the whole year
+	drift := year/100 - year/400 - 2
+	return NewDate(year, time.Month(month), day+drift)
+}
@@ -0,0 +1,60 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestEasterSunday(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Year      int
+		Gregorian chrono.Date
+		Julian    chrono.Date
+	}{
+		{1961, chrono.NewDate(1961, 4, 2), chrono.NewDate(1961, 4, 9)},
+		{2000, chrono.NewDate(2000, 4, 23), chrono.NewDate(2000, 4, 30)},
+		{2008, chrono.NewDate(2008, 3, 23), chrono.NewDate(2008, 4, 27)},
+		{2017, chrono.NewDate(2017, 4, 16), chrono.NewDate(2017, 4, 16)},
+		{2024, chrono.NewDate(2024, 3, 31), chrono.NewDate(2024, 5, 5)},
+		{2025, chrono.NewDate(2025, 4, 20), chrono.NewDate(2025, 4, 20)},
+		{2100, chrono.NewDate(2100, 3, 28), chrono.NewDate(2100, 5, 2)},
+	}
+
+	for _, test := range tests {
+		if got := chrono.EasterSunday(test.Year, chrono.EasterGregorian); !got.Equal(test.Gregorian) {
+			t.Errorf("%d gregorian: want: %v, got: %v", test.Year, test.Gregorian, got)
+		}
+		if got := chrono.EasterSunday(test.Year, chrono.EasterJulian); !got.Equal(test.Julian) {
+			t.Errorf("%d julian: want: %v, got: %v", test.Year, test.Julian, got)
+		}
+	}
+}
+
+func TestMovableFeasts(t *testing.T) {
+	t.Parallel()
+
+	if got := chrono.AshWednesday(2024, chrono.EasterGregorian); !got.Equal(chrono.NewDate(2024, 2, 14)) {
+		t.Error("ash wednesday wrong:", got)
+	}
+	if got := chrono.GoodFriday(2024, chrono.EasterGregorian); !got.Equal(chrono.NewDate(2024, 3, 29)) {
+		t.Error("good friday wrong:", got)
+	}
+	if got := chrono.EasterMonday(2024, chrono.EasterGregorian); !got.Equal(chrono.NewDate(2024, 4, 1)) {
+		t.Error("easter monday wrong:", got)
+	}
+	if got := chrono.AscensionDay(2024, chrono.EasterGregorian); !got.Equal(chrono.NewDate(2024, 5, 9)) {
+		t.Error("ascension wrong:", got)
+	}
+	if got := chrono.Pentecost(2024, chrono.EasterGregorian); !got.Equal(chrono.NewDate(2024, 5, 19)) {
+		t.Error("pentecost wrong:", got)
+	}
+	if got := chrono.GoodFriday(2024, chrono.EasterJulian); !got.Equal(chrono.NewDate(2024, 5, 3)) {
+		t.Error("orthodox good friday wrong:", got)
+	}
+	if got := chrono.Pentecost(2024, chrono.EasterJulian); !got.Equal(chrono.NewDate(2024, 6, 23)) {
+		t.Error("orthodox pentecost wrong:", got)
+	}
+}
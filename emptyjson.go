@@ -0,0 +1,25 @@
+package chrono
+
+import "errors"
+
+// ErrEmptyJSON is returned (wrapped in a ParseError) when a JSON null or ""
+// is unmarshalled into a Date, Time, DateTime or PartialDate and
+// EmptyJSONIsZero is false
+var ErrEmptyJSON = errors.New("empty json value")
+
+// EmptyJSONIsZero makes Date, Time, DateTime and PartialDate unmarshal a JSON
+// null or "" as their zero value, which is common in third-party APIs. When
+// false an ErrEmptyJSON is returned instead.
+var EmptyJSONIsZero = true
+
+// emptyJSON reports whether data is a JSON null or "", the error is non-nil
+// when that isn't allowed by EmptyJSONIsZero
+func emptyJSON(data []byte, kind string) (bool, error) {
+	if string(data) != "null" && string(data) != `""` {
+		return false, nil
+	}
+	if !EmptyJSONIsZero {
+		return true, &ParseError{Op: "unmarshal", Kind: kind, Input: string(data), Err: ErrEmptyJSON}
+	}
+	return true, nil
+}
@@ -0,0 +1,61 @@
+package chrono_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+type emptyJSONPayload struct {
+	Date     chrono.Date     `json:"date"`
+	Time     chrono.Time     `json:"time"`
+	DateTime chrono.DateTime `json:"datetime"`
+}
+
+func TestEmptyJSON(t *testing.T) {
+	t.Parallel()
+
+	for _, in := range []string{
+		`{"date": null, "time": null, "datetime": null}`,
+		`{"date": "", "time": "", "datetime": ""}`,
+	} {
+		p := emptyJSONPayload{
+			Date:     chrono.NewDate(2000, 1, 2),
+			Time:     chrono.NewTime(3, 4, 5, 0, time.UTC),
+			DateTime: chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC),
+		}
+		if err := json.Unmarshal([]byte(in), &p); err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+		if !p.Date.IsZero() || !p.Time.IsZero() || !p.DateTime.IsZero() {
+			t.Errorf("%s: expected zero values, got: %v %v %v", in, p.Date, p.Time, p.DateTime)
+		}
+	}
+}
+
+func TestEmptyJSONError(t *testing.T) {
+	// Not parallel, changes package level configuration
+	chrono.EmptyJSONIsZero = false
+	defer func() { chrono.EmptyJSONIsZero = true }()
+
+	for _, in := range []string{`null`, `""`} {
+		var d chrono.Date
+		var tm chrono.Time
+		var dt chrono.DateTime
+		errs := []error{
+			d.UnmarshalJSON([]byte(in)),
+			tm.UnmarshalJSON([]byte(in)),
+			dt.UnmarshalJSON([]byte(in)),
+		}
+		for i, err := range errs {
+			var perr *chrono.ParseError
+			if !errors.As(err, &perr) || !errors.Is(err, chrono.ErrEmptyJSON) {
+				t.Errorf("%s (%d): expected an empty json parse error, got: %v", in, i, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package chrono
+
+import (
+	"fmt"
+	"time"
+)
+
+// epochUnitLimits are the exclusive magnitudes below which an epoch value is
+// taken to be in seconds, milliseconds and microseconds. 1e11 seconds is the
+// year 5138 so any timestamp from an API in the wild in a smaller unit is
+// larger than the limit of the unit before it, anything beyond the last limit
+// is nanoseconds.
+var epochUnitLimits = [...]struct {
+	limit int64
+	unit  time.Duration
+}{
+	{1e11, time.Second},
+	{1e14, time.Millisecond},
+	{1e17, time.Microsecond},
+}
+
+// DetectEpochUnit guesses the unit of a timestamp relative to the unix epoch
+// from its magnitude. Values up to ±1e11 are seconds, ±1e14 milliseconds,
+// ±1e17 microseconds and anything larger is nanoseconds. This means that
+// seconds are correct until the year 5138 and the smaller units between 1973
+// and 5138, timestamps in milliseconds or smaller before 1973 (close to the
+// epoch) are mistaken for a larger unit.
+func DetectEpochUnit(n int64) time.Duration {
+	for _, l := range epochUnitLimits {
+		if n > -l.limit && n < l.limit {
+			return l.unit
+		}
+	}
+	return time.Nanosecond
+}
+
+// DateTimeFromEpochAuto creates a UTC datetime from a timestamp relative to
+// the unix epoch whose unit is detected with DetectEpochUnit. Use
+// DateTimeFromEpoch when the unit is known.
+func DateTimeFromEpochAuto(n int64) DateTime {
+	d, _ := DateTimeFromEpoch(n, DetectEpochUnit(n))
+	return d
+}
+
+// DateTimeFromEpoch creates a UTC datetime from a timestamp relative to the
+// unix epoch in unit, which must be one of time.Second, time.Millisecond,
+// time.Microsecond or time.Nanosecond. Seconds outside of MinDateTime and
+// MaxDateTime return an error wrapping ErrTimestampRange.
+func DateTimeFromEpoch(n int64, unit time.Duration) (DateTime, error) {
+	switch unit {
+	case time.Second:
+		if !checkUnix(n, 0) {
+			return DateTime{}, fmt.Errorf("epoch seconds %d: %w", n, ErrTimestampRange)
+		}
+		return DateTime{t: time.Unix(n, 0).UTC()}, nil
+	case time.Millisecond:
+		return DateTime{t: time.UnixMilli(n).UTC()}, nil
+	case time.Microsecond:
+		return DateTime{t: time.UnixMicro(n).UTC()}, nil
+	case time.Nanosecond:
+		return DateTime{t: time.Unix(0, n).UTC()}, nil
+	}
+	return DateTime{}, fmt.Errorf("unsupported epoch unit %s", unit)
+}
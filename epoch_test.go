@@ -0,0 +1,55 @@
+package chrono_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestDateTimeFromEpochAuto(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewDateTime(2023, 11, 14, 22, 13, 20, 0, time.UTC)
+	tests := []struct {
+		In   int64
+		Unit time.Duration
+		Want chrono.DateTime
+	}{
+		{0, time.Second, chrono.NewDateTime(1970, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{1700000000, time.Second, ref},
+		{-1700000000, time.Second, chrono.NewDateTime(1916, 2, 18, 1, 46, 40, 0, time.UTC)},
+		{1700000000123, time.Millisecond, ref.Add(123 * time.Millisecond)},
+		{1700000000123456, time.Microsecond, ref.Add(123456 * time.Microsecond)},
+		{1700000000123456789, time.Nanosecond, ref.Add(123456789)},
+		{99999999999, time.Second, chrono.NewDateTime(5138, 11, 16, 9, 46, 39, 0, time.UTC)},
+		{100000000000, time.Millisecond, chrono.NewDateTime(1973, 3, 3, 9, 46, 40, 0, time.UTC)},
+		{math.MinInt64, time.Nanosecond, chrono.NewDateTime(1677, 9, 21, 0, 12, 43, 145224192, time.UTC)},
+	}
+
+	for i, test := range tests {
+		if unit := chrono.DetectEpochUnit(test.In); unit != test.Unit {
+			t.Errorf("%d) unit wrong, want: %s, got: %s", i, test.Unit, unit)
+		}
+		if got := chrono.DateTimeFromEpochAuto(test.In); !got.Equal(test.Want) || got.Location() != time.UTC {
+			t.Errorf("%d) datetime wrong, want: %s, got: %s", i, test.Want, got)
+		}
+	}
+}
+
+func TestDateTimeFromEpoch(t *testing.T) {
+	t.Parallel()
+
+	got, err := chrono.DateTimeFromEpoch(1700000000, time.Millisecond)
+	if err != nil || !got.Equal(chrono.NewDateTime(1970, 1, 20, 16, 13, 20, 0, time.UTC)) {
+		t.Error("wrong datetime:", got, err)
+	}
+	if _, err := chrono.DateTimeFromEpoch(math.MaxInt64, time.Second); !errors.Is(err, chrono.ErrTimestampRange) {
+		t.Error("expected range error:", err)
+	}
+	if _, err := chrono.DateTimeFromEpoch(1, time.Minute); err == nil {
+		t.Error("expected unit error")
+	}
+}
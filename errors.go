@@ -0,0 +1,46 @@
+package chrono
+
+import "fmt"
+
+// ParseError is returned when parsing, unmarshaling or scanning a value
+// fails. It can be retrieved with errors.As to produce precise messages, eg.
+// for a 400 response in an API.
+type ParseError struct {
+	// Op is the operation that failed: parse, unmarshal or scan
+	Op string
+	// Kind is the kind of value that was being parsed, eg. date, time,
+	// datetime or duration
+	Kind string
+	// Input is the value that failed to parse
+	Input string
+	// Layout is the layout the input was parsed with, if there was one
+	Layout string
+	// Err is the underlying reason, often a *time.ParseError
+	Err error
+}
+
+// Error implements error
+func (p *ParseError) Error() string {
+	return fmt.Sprintf("failed to %s %s (%q): %v", p.Op, p.Kind, p.Input, p.Err)
+}
+
+// Unwrap returns the underlying error
+func (p *ParseError) Unwrap() error {
+	return p.Err
+}
+
+// TypeError is returned when scanning or unmarshaling a value of a type that
+// is not supported.
+type TypeError struct {
+	// Op is the operation that failed: unmarshal or scan
+	Op string
+	// Kind is the kind of value that was being produced, eg. date
+	Kind string
+	// Value is the value that could not be converted
+	Value any
+}
+
+// Error implements error
+func (t *TypeError) Error() string {
+	return fmt.Sprintf("failed to %s type '%T' into %s", t.Op, t.Value, t.Kind)
+}
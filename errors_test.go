@@ -0,0 +1,70 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	_, err := chrono.DateFromString("2000-13-01")
+	var perr *chrono.ParseError
+	if !errors.As(err, &perr) {
+		t.Fatal("expected a parse error, got", err)
+	}
+	if perr.Op != "parse" || perr.Kind != "date" || perr.Input != "2000-13-01" || perr.Layout != "2006-01-02" {
+		t.Errorf("error wrong: %#v", perr)
+	}
+	var terr *time.ParseError
+	if !errors.As(err, &terr) {
+		t.Error("expected to unwrap to a time.ParseError")
+	}
+
+	var dt chrono.DateTime
+	err = dt.Scan("nope")
+	if !errors.As(err, &perr) || perr.Op != "scan" || perr.Kind != "datetime" || perr.Layout != chrono.DateTimeSQLLayout {
+		t.Error("error wrong", err)
+	}
+
+	var d chrono.Date
+	err = d.UnmarshalJSON([]byte(`"nope"`))
+	if !errors.As(err, &perr) || perr.Op != "unmarshal" || perr.Input != `"nope"` {
+		t.Error("error wrong", err)
+	}
+	if err.Error() != `failed to unmarshal date ("\"nope\""): parsing time "\"nope\"" as "\"2006-01-02\"": cannot parse "nope\"" as "2006"` {
+		t.Error("message wrong", err)
+	}
+}
+
+func TestTypeError(t *testing.T) {
+	t.Parallel()
+
+	var tm chrono.Time
+	err := tm.Scan(true)
+	var terr *chrono.TypeError
+	if !errors.As(err, &terr) {
+		t.Fatal("expected a type error, got", err)
+	}
+	if terr.Op != "scan" || terr.Kind != "time" || terr.Value != true {
+		t.Errorf("error wrong: %#v", terr)
+	}
+	if err.Error() != "failed to scan type 'bool' into time" {
+		t.Error("message wrong", err)
+	}
+}
+
+func TestTimeFromLayoutLocation(t *testing.T) {
+	t.Parallel()
+
+	tm, err := chrono.TimeFromLayoutLocation("3:04PM", "9:30AM", time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tm.Hour() != 9 || tm.Minute() != 30 {
+		t.Error("value wrong", tm)
+	}
+}
@@ -0,0 +1,35 @@
+package chrono
+
+import (
+	"strings"
+	"time"
+)
+
+// exifLayout is the layout of the EXIF DateTime tags
+const exifLayout = "2006:01:02 15:04:05"
+
+// DateTimeFromEXIF parses an EXIF DateTime, DateTimeOriginal or
+// DateTimeDigitized value, eg. "2006:01:02 15:04:05". EXIF doesn't store a
+// zone with these so the result is in loc, if the image has an
+// OffsetTimeOriginal it can be parsed with ParseOffset and used as the
+// location. Trailing NULs from the file are removed. Cameras write all zeros
+// or blanks when the date is unknown, that is returned as the zero DateTime
+// without an error.
+func DateTimeFromEXIF(str string, loc *time.Location) (DateTime, error) {
+	str = strings.TrimRight(str, "\x00")
+	if strings.Trim(str, " :0") == "" {
+		return DateTime{}, nil
+	}
+
+	t, err := time.ParseInLocation(exifLayout, str, loc)
+	if err != nil {
+		return DateTime{}, &ParseError{Op: "parse", Kind: "exif datetime", Input: str, Layout: exifLayout, Err: err}
+	}
+	return DateTime{t: t}, nil
+}
+
+// FormatEXIF formats the wall clock of d for an EXIF DateTime tag, the zone
+// is not included
+func (d DateTime) FormatEXIF() string {
+	return d.t.Format(exifLayout)
+}
@@ -0,0 +1,43 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestDateTimeFromEXIF(t *testing.T) {
+	t.Parallel()
+
+	off, err := chrono.ParseOffset("+09:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := chrono.DateTimeFromEXIF("2006:01:02 15:04:05\x00", off.Location())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 9*60*60))
+	if !got.Equal(want) {
+		t.Error("wrong datetime:", got)
+	}
+	if s := got.FormatEXIF(); s != "2006:01:02 15:04:05" {
+		t.Error("wrong format:", s)
+	}
+
+	for _, unknown := range []string{"0000:00:00 00:00:00", "    :  :     :  :  ", "", "\x00"} {
+		got, err := chrono.DateTimeFromEXIF(unknown, time.UTC)
+		if err != nil || !got.IsZero() {
+			t.Errorf("%q: expected the zero value, got: %v %v", unknown, got, err)
+		}
+	}
+
+	_, err = chrono.DateTimeFromEXIF("2006-01-02 15:04:05", time.UTC)
+	var perr *chrono.ParseError
+	if !errors.As(err, &perr) {
+		t.Error("expected a parse error, got:", err)
+	}
+}
@@ -0,0 +1,94 @@
+package chrono
+
+import "time"
+
+const secondsPerHour = 60 * 60
+
+// HoursOf returns the hour of each datetime in loc (nil is UTC), eg. for
+// building a histogram of activity by hour of day. It's the same as calling
+// In(loc).Hour() on each of them but the zone lookup is done once per hour
+// of datetimes rather than once per datetime.
+func HoursOf(datetimes []DateTime, loc *time.Location) []int {
+	cache := offsetCache{loc: loc}
+	hours := make([]int, len(datetimes))
+	for i, d := range datetimes {
+		sec := d.t.Unix() + int64(cache.offset(d.t))
+		hours[i] = int(floorMod(sec, secondsPerDay) / secondsPerHour)
+	}
+	return hours
+}
+
+// WeekdaysOf returns the weekday of each date
+func WeekdaysOf(dates []Date) []time.Weekday {
+	weekdays := make([]time.Weekday, len(dates))
+	for i, d := range dates {
+		weekdays[i] = unixWeekday(d.t.Unix())
+	}
+	return weekdays
+}
+
+// DateTimeWeekdaysOf returns the weekday of each datetime in loc (nil is
+// UTC). Like HoursOf the zone lookup is done once per hour of datetimes.
+func DateTimeWeekdaysOf(datetimes []DateTime, loc *time.Location) []time.Weekday {
+	cache := offsetCache{loc: loc}
+	weekdays := make([]time.Weekday, len(datetimes))
+	for i, d := range datetimes {
+		weekdays[i] = unixWeekday(d.t.Unix() + int64(cache.offset(d.t)))
+	}
+	return weekdays
+}
+
+// unixWeekday returns the weekday of a unix time, the epoch was a Thursday
+func unixWeekday(sec int64) time.Weekday {
+	return time.Weekday(floorMod(floorDiv(sec, secondsPerDay)+int64(time.Thursday), 7))
+}
+
+// offsetCache remembers the offset of loc during the hour of the last lookup.
+// Zones only change offset at transitions, which are never less than an
+// hour apart, so if the start and end of an hour have the same offset so
+// does everything in between.
+type offsetCache struct {
+	loc *time.Location
+
+	filled  bool
+	hour    int64
+	off     int
+	uniform bool
+}
+
+// offset returns the offset from UTC of t in the cache's location
+func (o *offsetCache) offset(t time.Time) int {
+	if o.loc == nil || o.loc == time.UTC {
+		return 0
+	}
+
+	hour := floorDiv(t.Unix(), secondsPerHour) * secondsPerHour
+	if !o.filled || hour != o.hour {
+		_, start := time.Unix(hour, 0).In(o.loc).Zone()
+		_, end := time.Unix(hour+secondsPerHour-1, 0).In(o.loc).Zone()
+		o.filled, o.hour, o.off, o.uniform = true, hour, start, start == end
+	}
+	if o.uniform {
+		return o.off
+	}
+	_, off := t.In(o.loc).Zone()
+	return off
+}
+
+// floorDiv divides rounding towards negative infinity
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b < 0 {
+		q--
+	}
+	return q
+}
+
+// floorMod is the remainder of floorDiv, it has the sign of b
+func floorMod(a, b int64) int64 {
+	m := a % b
+	if m < 0 {
+		m += b
+	}
+	return m
+}
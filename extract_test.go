@@ -0,0 +1,105 @@
+package chrono_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/chronotest"
+)
+
+func TestHoursOf(t *testing.T) {
+	t.Parallel()
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lordHowe, err := time.LoadLocation("Australia/Lord_Howe")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	random := make([]chrono.DateTime, 1000)
+	for i := range random {
+		random[i] = chronotest.RandomDateTime(r)
+	}
+	inputs := [][]chrono.DateTime{
+		random,
+		// Across the DST transitions of both zones in 2024
+		chronotest.Sequence(chrono.NewDateTime(2024, 3, 9, 0, 0, 0, 0, time.UTC), 7*time.Minute, 1000),
+		chronotest.Sequence(chrono.NewDateTime(2024, 4, 6, 10, 0, 0, 0, time.UTC), 7*time.Minute, 1000),
+		chronotest.Sequence(chrono.NewDateTime(1969, 12, 31, 22, 0, 0, 0, time.UTC), 17*time.Second, 1000),
+	}
+
+	for _, loc := range []*time.Location{nil, time.UTC, time.FixedZone("", -(9*60*60 + 30*60)), ny, lordHowe} {
+		for _, datetimes := range inputs {
+			hours := chrono.HoursOf(datetimes, loc)
+			weekdays := chrono.DateTimeWeekdaysOf(datetimes, loc)
+			for i, d := range datetimes {
+				if loc == nil {
+					d = d.In(time.UTC)
+				} else {
+					d = d.In(loc)
+				}
+				if hours[i] != d.Hour() {
+					t.Errorf("%s: want hour %d, got %d", d, d.Hour(), hours[i])
+				}
+				if weekdays[i] != d.Weekday() {
+					t.Errorf("%s: want weekday %s, got %s", d, d.Weekday(), weekdays[i])
+				}
+			}
+		}
+	}
+}
+
+func TestWeekdaysOf(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	dates := make([]chrono.Date, 1000)
+	for i := range dates {
+		dates[i] = chronotest.RandomDate(r)
+	}
+	dates = append(dates, chronotest.DateSequence(chrono.NewDate(1969, 12, 25), 1, 14)...)
+
+	weekdays := chrono.WeekdaysOf(dates)
+	for i, d := range dates {
+		if weekdays[i] != d.Weekday() {
+			t.Errorf("%s: want %s, got %s", d, d.Weekday(), weekdays[i])
+		}
+	}
+}
+
+func BenchmarkHoursOf(b *testing.B) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		b.Fatal(err)
+	}
+	datetimes := chronotest.Sequence(chrono.NewDateTime(2024, 3, 9, 0, 0, 0, 0, time.UTC), time.Second, 10000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = chrono.HoursOf(datetimes, ny)
+	}
+}
+
+func BenchmarkHoursOfLoop(b *testing.B) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		b.Fatal(err)
+	}
+	datetimes := chronotest.Sequence(chrono.NewDateTime(2024, 3, 9, 0, 0, 0, 0, time.UTC), time.Second, 10000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hours := make([]int, len(datetimes))
+		for j, d := range datetimes {
+			hours[j] = d.In(ny).Hour()
+		}
+	}
+}
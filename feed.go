@@ -0,0 +1,47 @@
+package chrono
+
+import "strings"
+
+// FeedDateLayouts are the layouts tried by DateTimeFromFeedDate after
+// RFC 2822, in order. They cover what's commonly found in the pubDate and
+// updated elements of real RSS and Atom feeds and can be changed to accept
+// others. Layouts without a zone are parsed as UTC.
+var FeedDateLayouts = []string{
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"Mon, 2 Jan 2006 15:04 MST",
+	"2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04 MST",
+	"Mon, 2 January 2006 15:04:05 -0700",
+	"Mon, 2 January 2006 15:04:05 MST",
+	"Monday, 2 January 2006 15:04:05 -0700",
+	"Monday, 2 January 2006 15:04:05 MST",
+	"Monday, 02-Jan-06 15:04:05 MST",
+	"Mon Jan _2 15:04:05 2006",
+	"Mon Jan _2 15:04:05 MST 2006",
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999 -0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// DateTimeFromFeedDate parses the date of an RSS or Atom feed item. Feeds
+// rarely stick to the format their spec requires so RFC 2822 (including
+// RFC 822 and RFC 1123, see DateTimeFromRFC2822) is tried first followed by
+// each of FeedDateLayouts.
+func DateTimeFromFeedDate(str string) (DateTime, error) {
+	str = strings.TrimSpace(str)
+	if t, err := parseRFC2822(str); err == nil {
+		return DateTime{t: t}, nil
+	}
+
+	t, err := parseConfigString(str, FeedDateLayouts)
+	if err != nil {
+		return DateTime{}, &ParseError{Op: "parse", Kind: "datetime", Input: str, Err: err}
+	}
+	return DateTime{t: t}, nil
+}
@@ -0,0 +1,57 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestDateTimeFromFeedDate(t *testing.T) {
+	t.Parallel()
+
+	utc := chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	noSec := chrono.NewDateTime(2006, 1, 2, 15, 4, 0, 0, time.UTC)
+	tests := []struct {
+		In   string
+		Want chrono.DateTime
+	}{
+		{"Mon, 02 Jan 2006 15:04:05 GMT", utc},
+		{"Mon, 02 Jan 2006 08:04:05 -0700", utc},
+		{" Mon, 2 Jan 2006 15:04:05 +0000 ", utc},
+		{"02 Jan 06 15:04 GMT", noSec},
+		{"Mon, 02 Jan 2006 15:04:05 UTC", utc},
+		{"Mon, 2 January 2006 08:04:05 -0700", utc},
+		{"Monday, 2 January 2006 15:04:05 GMT", utc},
+		{"Monday, 02-Jan-06 15:04:05 UTC", utc},
+		{"Mon Jan  2 15:04:05 2006", utc},
+		{"2006-01-02T15:04:05Z", utc},
+		{"2006-01-02T17:04:05.000+02:00", utc},
+		{"2006-01-02T15:04Z", noSec},
+		{"2006-01-02 15:04:05Z", utc},
+		{"2006-01-02 17:04:05 +0200", utc},
+		{"2006-01-02T15:04:05", utc},
+		{"2006-01-02 15:04", noSec},
+		{"2006-01-02", chrono.NewDateTime(2006, 1, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		got, err := chrono.DateTimeFromFeedDate(test.In)
+		if err != nil {
+			t.Errorf("%q: %v", test.In, err)
+			continue
+		}
+		if !got.Equal(test.Want) {
+			t.Errorf("%q: want %v, got %v", test.In, test.Want, got)
+		}
+	}
+
+	for _, in := range []string{"", "yesterday", "2006-13-02", "Mon, 32 Jan 2006 15:04:05 GMT"} {
+		_, err := chrono.DateTimeFromFeedDate(in)
+		var perr *chrono.ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("%q: expected a parse error, got: %v", in, err)
+		}
+	}
+}
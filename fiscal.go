@@ -0,0 +1,173 @@
+package chrono
+
+import (
+	"fmt"
+	"time"
+)
+
+// FiscalPattern determines how a FiscalCalendar divides its year into periods
+type FiscalPattern int
+
+// Fiscal patterns
+const (
+	// FiscalMonths uses calendar months as periods, the fiscal year always
+	// starts on the 1st of the start month.
+	FiscalMonths FiscalPattern = iota
+	// Fiscal445 is a retail calendar where each quarter is 13 weeks long
+	// split into periods of 4, 4, and 5 weeks.
+	Fiscal445
+	// Fiscal454 is like Fiscal445 but with periods of 4, 5, and 4 weeks.
+	Fiscal454
+	// Fiscal544 is like Fiscal445 but with periods of 5, 4, and 4 weeks.
+	Fiscal544
+)
+
+// weeks returns the number of weeks in each period of a quarter
+func (f FiscalPattern) weeks() [3]int {
+	switch f {
+	case Fiscal454:
+		return [3]int{4, 5, 4}
+	case Fiscal544:
+		return [3]int{5, 4, 4}
+	default:
+		return [3]int{4, 4, 5}
+	}
+}
+
+// FiscalCalendar maps dates onto fiscal years, quarters and periods.
+//
+// Fiscal years are named after the calendar year in which they end, so with
+// a StartMonth of October, 2024-10-01 is in FY2025. Each fiscal year has 4
+// quarters and 12 periods.
+//
+// When using one of the week based (retail) patterns the fiscal year always
+// ends on the last WeekEnd day of the month before StartMonth, which makes
+// every year either 52 or 53 weeks long. In 53 week years the extra week is
+// added to the final period.
+type FiscalCalendar struct {
+	StartMonth time.Month
+	Pattern    FiscalPattern
+	WeekEnd    time.Weekday
+}
+
+// NewFiscalCalendar creates a fiscal calendar whose periods are calendar
+// months and whose year begins on the 1st of startMonth.
+func NewFiscalCalendar(startMonth time.Month) FiscalCalendar {
+	return FiscalCalendar{StartMonth: startMonth, Pattern: FiscalMonths}
+}
+
+// NewRetailCalendar creates a week based fiscal calendar whose year ends on
+// the last weekEnd day of the month before startMonth.
+func NewRetailCalendar(startMonth time.Month, pattern FiscalPattern, weekEnd time.Weekday) FiscalCalendar {
+	return FiscalCalendar{StartMonth: startMonth, Pattern: pattern, WeekEnd: weekEnd}
+}
+
+// FiscalYear returns the fiscal year that d falls in
+func (f FiscalCalendar) FiscalYear(d Date) int {
+	fy := d.Year()
+	if f.startMonth() != time.January && d.Month() >= f.startMonth() {
+		fy++
+	}
+
+	// Retail calendars can start and end a few days away from the month
+	// boundary so nudge it into the right year
+	if d.After(f.YearEnd(fy)) {
+		fy++
+	} else if d.Before(f.YearStart(fy)) {
+		fy--
+	}
+	return fy
+}
+
+// FiscalQuarter returns the fiscal quarter (1-4) that d falls in
+func (f FiscalCalendar) FiscalQuarter(d Date) int {
+	return (f.FiscalPeriod(d)-1)/3 + 1
+}
+
+// FiscalPeriod returns the fiscal period (1-12) that d falls in. For
+// FiscalMonths this is the month of the fiscal year.
+func (f FiscalCalendar) FiscalPeriod(d Date) int {
+	if f.Pattern == FiscalMonths {
+		return (int(d.Month())-int(f.startMonth())+12)%12 + 1
+	}
+
+	weeks := WeeksBetween(f.YearStart(f.FiscalYear(d)), d)
+	pattern := f.Pattern.weeks()
+	period := 1
+	for ; period < 12; period++ {
+		weeks -= pattern[(period-1)%3]
+		if weeks < 0 {
+			break
+		}
+	}
+	return period
+}
+
+// YearStart returns the first day of the fiscal year
+func (f FiscalCalendar) YearStart(fy int) Date {
+	if f.Pattern == FiscalMonths {
+		start := NewDate(fy, f.startMonth(), 1)
+		if f.startMonth() != time.January {
+			start = start.AddDate(-1, 0, 0)
+		}
+		return start
+	}
+
+	return f.YearEnd(fy-1).AddDate(0, 0, 1)
+}
+
+// YearEnd returns the last day of the fiscal year
+func (f FiscalCalendar) YearEnd(fy int) Date {
+	year := fy
+	if f.startMonth() == time.January {
+		year++
+	}
+	// Day 0 of the start month is the last day of the previous month
+	end := NewDate(year, f.startMonth(), 0)
+	if f.Pattern == FiscalMonths {
+		return end
+	}
+
+	return end.AddDate(0, 0, -((int(end.Weekday()) - int(f.WeekEnd) + 7) % 7))
+}
+
+// QuarterBounds returns the first and last day of the fiscal quarter (1-4)
+func (f FiscalCalendar) QuarterBounds(fy, quarter int) (start, end Date) {
+	start, _ = f.PeriodBounds(fy, (quarter-1)*3+1)
+	_, end = f.PeriodBounds(fy, quarter*3)
+	return start, end
+}
+
+// PeriodBounds returns the first and last day of the fiscal period (1-12)
+func (f FiscalCalendar) PeriodBounds(fy, period int) (start, end Date) {
+	yearStart := f.YearStart(fy)
+	if f.Pattern == FiscalMonths {
+		start = yearStart.AddDate(0, period-1, 0)
+		return start, start.AddDate(0, 1, -1)
+	}
+
+	pattern := f.Pattern.weeks()
+	weeks := 0
+	for p := 1; p < period; p++ {
+		weeks += pattern[(p-1)%3]
+	}
+	start = yearStart.AddDate(0, 0, weeks*7)
+	if period == 12 {
+		// The last period absorbs the 53rd week when there is one
+		return start, f.YearEnd(fy)
+	}
+	return start, start.AddDate(0, 0, pattern[(period-1)%3]*7-1)
+}
+
+// Format returns the fiscal year and quarter of d, eg. "FY2025 Q3"
+func (f FiscalCalendar) Format(d Date) string {
+	return fmt.Sprintf("FY%d Q%d", f.FiscalYear(d), f.FiscalQuarter(d))
+}
+
+// startMonth treats the zero value as January
+func (f FiscalCalendar) startMonth() time.Month {
+	if f.StartMonth == 0 {
+		return time.January
+	}
+	return f.StartMonth
+}
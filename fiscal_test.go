@@ -0,0 +1,116 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestFiscalCalendarMonths(t *testing.T) {
+	t.Parallel()
+
+	cal := chrono.NewFiscalCalendar(time.October)
+
+	tests := []struct {
+		Date    chrono.Date
+		Year    int
+		Quarter int
+		Period  int
+	}{
+		{chrono.NewDate(2024, 10, 1), 2025, 1, 1},
+		{chrono.NewDate(2024, 9, 30), 2024, 4, 12},
+		{chrono.NewDate(2025, 1, 15), 2025, 2, 4},
+		{chrono.NewDate(2025, 4, 1), 2025, 3, 7},
+	}
+
+	for _, test := range tests {
+		if v := cal.FiscalYear(test.Date); v != test.Year {
+			t.Errorf("%s: want year %d, got %d", test.Date, test.Year, v)
+		}
+		if v := cal.FiscalQuarter(test.Date); v != test.Quarter {
+			t.Errorf("%s: want quarter %d, got %d", test.Date, test.Quarter, v)
+		}
+		if v := cal.FiscalPeriod(test.Date); v != test.Period {
+			t.Errorf("%s: want period %d, got %d", test.Date, test.Period, v)
+		}
+	}
+
+	if s := cal.Format(chrono.NewDate(2025, 4, 1)); s != "FY2025 Q3" {
+		t.Error("format wrong", s)
+	}
+
+	start, end := cal.QuarterBounds(2025, 2)
+	if !start.Equal(chrono.NewDate(2025, 1, 1)) || !end.Equal(chrono.NewDate(2025, 3, 31)) {
+		t.Error("bounds wrong", start, end)
+	}
+	if v := cal.YearStart(2025); !v.Equal(chrono.NewDate(2024, 10, 1)) {
+		t.Error("year start wrong", v)
+	}
+	if v := cal.YearEnd(2025); !v.Equal(chrono.NewDate(2025, 9, 30)) {
+		t.Error("year end wrong", v)
+	}
+
+	jan := chrono.NewFiscalCalendar(time.January)
+	if v := jan.FiscalYear(chrono.NewDate(2025, 12, 31)); v != 2025 {
+		t.Error("year wrong", v)
+	}
+	if v := jan.YearEnd(2025); !v.Equal(chrono.NewDate(2025, 12, 31)) {
+		t.Error("year end wrong", v)
+	}
+}
+
+func TestFiscalCalendarRetail(t *testing.T) {
+	t.Parallel()
+
+	// Year ends on the last Saturday of January
+	cal := chrono.NewRetailCalendar(time.February, chrono.Fiscal445, time.Saturday)
+
+	if v := cal.YearEnd(2023); !v.Equal(chrono.NewDate(2023, 1, 28)) {
+		t.Error("year end wrong", v)
+	}
+	if v := cal.YearStart(2024); !v.Equal(chrono.NewDate(2023, 1, 29)) {
+		t.Error("year start wrong", v)
+	}
+	// 2024-01-27 is the last Saturday of January 2024, 52 weeks later
+	if v := cal.YearEnd(2024); !v.Equal(chrono.NewDate(2024, 1, 27)) {
+		t.Error("year end wrong", v)
+	}
+
+	if v := cal.FiscalYear(chrono.NewDate(2023, 1, 29)); v != 2024 {
+		t.Error("year wrong", v)
+	}
+	if v := cal.FiscalYear(chrono.NewDate(2023, 1, 28)); v != 2023 {
+		t.Error("year wrong", v)
+	}
+
+	// Periods are 4, 4, 5 weeks long
+	start, end := cal.PeriodBounds(2024, 3)
+	if !start.Equal(chrono.NewDate(2023, 3, 26)) || !end.Equal(chrono.NewDate(2023, 4, 29)) {
+		t.Error("period bounds wrong", start, end)
+	}
+	if v := cal.FiscalPeriod(chrono.NewDate(2023, 4, 29)); v != 3 {
+		t.Error("period wrong", v)
+	}
+	if v := cal.FiscalPeriod(chrono.NewDate(2023, 4, 30)); v != 4 {
+		t.Error("period wrong", v)
+	}
+	if v := cal.FiscalQuarter(chrono.NewDate(2023, 4, 30)); v != 2 {
+		t.Error("quarter wrong", v)
+	}
+
+	// FY2023 (2022-01-30 to 2023-01-28) is 52 weeks, FY2026 has 53
+	if days := cal.YearEnd(2023).RataDie() - cal.YearStart(2023).RataDie() + 1; days != 52*7 {
+		t.Error("wrong number of days", days)
+	}
+	if days := cal.YearEnd(2026).RataDie() - cal.YearStart(2026).RataDie() + 1; days != 53*7 {
+		t.Error("wrong number of days", days)
+	}
+	start, end = cal.QuarterBounds(2026, 4)
+	if days := end.RataDie() - start.RataDie() + 1; days != 14*7 {
+		t.Error("53rd week should be in the last quarter", start, end)
+	}
+	if v := cal.FiscalPeriod(cal.YearEnd(2026)); v != 12 {
+		t.Error("period wrong", v)
+	}
+}
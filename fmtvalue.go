@@ -0,0 +1,75 @@
+package chrono
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Date, Time and DateTime can't implement fmt.Formatter themselves because
+// their Format method takes a layout, Fmt wraps them in a FmtValue which does.
+
+// FmtValue is a Date, Time or DateTime that implements fmt.Formatter so the
+// fmt verbs can pick a representation, see Date.Fmt.
+type FmtValue struct {
+	v interface {
+		fmt.Stringer
+		fmt.GoStringer
+	}
+	epoch int64
+}
+
+// Fmt returns d as a fmt.Formatter. %v and %s are String, %q is the quoted
+// String, %#v is GoString and %d is EpochDays. Width and flags apply as they
+// do for strings and integers, eg. %12v or %+d.
+func (d Date) Fmt() FmtValue {
+	return FmtValue{v: d, epoch: d.epochDays()}
+}
+
+// Fmt returns t as a fmt.Formatter, see Date.Fmt. %d is the nanoseconds since
+// midnight.
+func (t Time) Fmt() FmtValue {
+	return FmtValue{v: t, epoch: int64(clockOffset(t))}
+}
+
+// Fmt returns d as a fmt.Formatter, see Date.Fmt. %d is the unix timestamp
+// in seconds.
+func (d DateTime) Fmt() FmtValue {
+	return FmtValue{v: d, epoch: d.t.Unix()}
+}
+
+// Format implements fmt.Formatter
+func (f FmtValue) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('#') {
+			fmt.Fprint(s, f.v.GoString())
+			return
+		}
+		fmt.Fprintf(s, fmtDirective(s, 's'), f.v.String())
+	case 's', 'q':
+		fmt.Fprintf(s, fmtDirective(s, verb), f.v.String())
+	case 'd':
+		fmt.Fprintf(s, fmtDirective(s, 'd'), f.epoch)
+	default:
+		fmt.Fprintf(s, "%%!%c(%T=%s)", verb, f.v, f.v.String())
+	}
+}
+
+// fmtDirective rebuilds the directive s was created from with verb, eg.
+// "%-12s", so the value can be formatted with the same flags
+func fmtDirective(s fmt.State, verb rune) string {
+	b := []byte{'%'}
+	for _, flag := range "+-# 0" {
+		if s.Flag(int(flag)) {
+			b = append(b, byte(flag))
+		}
+	}
+	if width, ok := s.Width(); ok {
+		b = strconv.AppendInt(b, int64(width), 10)
+	}
+	if prec, ok := s.Precision(); ok {
+		b = append(b, '.')
+		b = strconv.AppendInt(b, int64(prec), 10)
+	}
+	return string(append(b, string(verb)...))
+}
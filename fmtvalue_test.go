@@ -0,0 +1,39 @@
+package chrono_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestFmtValue(t *testing.T) {
+	t.Parallel()
+
+	d := chrono.NewDate(2000, 1, 2)
+	tm := chrono.NewTime(3, 4, 5, 6, time.UTC)
+	dt := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		Got  string
+		Want string
+	}{
+		{fmt.Sprintf("%v %s %q", d.Fmt(), d.Fmt(), d.Fmt()), `2000-01-02 2000-01-02 "2000-01-02"`},
+		{fmt.Sprintf("%12v|%-12s|%#q", d.Fmt(), d.Fmt(), d.Fmt()), "  2000-01-02|2000-01-02  |`2000-01-02`"},
+		{fmt.Sprintf("%#v", d.Fmt()), `chrono.Date(2000, January, 2)`},
+		{fmt.Sprintf("%d|%8d|%+d", d.Fmt(), d.Fmt(), d.Fmt()), `10958|   10958|+10958`},
+		{fmt.Sprintf("%d", chrono.NewDate(1969, 12, 31).Fmt()), `-1`},
+		{fmt.Sprintf("%v %d", tm.Fmt(), tm.Fmt()), tm.String() + " 11045000000006"},
+		{fmt.Sprintf("%#v", tm.Fmt()), tm.GoString()},
+		{fmt.Sprintf("%v %d", dt.Fmt(), dt.Fmt()), `2000-01-02T03:04:05Z 946782245`},
+		{fmt.Sprintf("%x", dt.Fmt()), `%!x(chrono.DateTime=2000-01-02T03:04:05Z)`},
+	}
+	for i, test := range tests {
+		if test.Got != test.Want {
+			t.Errorf("%d) want %s, got %s", i, test.Want, test.Got)
+		}
+	}
+
+	var _ fmt.Formatter = d.Fmt()
+}
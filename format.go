@@ -0,0 +1,125 @@
+package chrono
+
+import "time"
+
+// The functions in this file are append based formatters for the canonical
+// layouts. They produce exactly what time.Format would for dateLayout,
+// timeLayout, time.RFC3339 and time.RFC3339Nano without having to interpret the layout, years
+// that don't fit in 4 digits fall back to time.Format.
+
+// appendDate appends t formatted as dateLayout
+func appendDate(b []byte, t time.Time) []byte {
+	year, month, day := t.Date()
+	if year < 0 || year > 9999 {
+		return t.AppendFormat(b, dateLayout)
+	}
+	b = appendInt2(b, year/100)
+	b = appendInt2(b, year%100)
+	b = append(b, '-')
+	b = appendInt2(b, int(month))
+	b = append(b, '-')
+	return appendInt2(b, day)
+}
+
+// appendTime appends t formatted as timeLayout
+func appendTime(b []byte, t time.Time) []byte {
+	hour, min, sec := t.Clock()
+	b = appendInt2(b, hour)
+	b = append(b, ':')
+	b = appendInt2(b, min)
+	b = append(b, ':')
+	b = appendInt2(b, sec)
+	return appendOffset(b, t)
+}
+
+// appendRFC3339 appends t formatted as time.RFC3339
+func appendRFC3339(b []byte, t time.Time) []byte {
+	if year := t.Year(); year < 0 || year > 9999 {
+		return t.AppendFormat(b, time.RFC3339)
+	}
+	b = appendDate(b, t)
+	b = append(b, 'T')
+	return appendTime(b, t)
+}
+
+// appendRFC3339Nano appends t formatted as time.RFC3339Nano
+func appendRFC3339Nano(b []byte, t time.Time) []byte {
+	if year := t.Year(); year < 0 || year > 9999 {
+		return t.AppendFormat(b, time.RFC3339Nano)
+	}
+	b = appendDate(b, t)
+	b = append(b, 'T')
+	hour, min, sec := t.Clock()
+	b = appendInt2(b, hour)
+	b = append(b, ':')
+	b = appendInt2(b, min)
+	b = append(b, ':')
+	b = appendInt2(b, sec)
+	b = appendFraction(b, t.Nanosecond(), '.', 9, true)
+	return appendOffset(b, t)
+}
+
+// appendOffset appends Z for UTC or the offset as ±hh:mm, dropping any
+// seconds like time.Format does
+func appendOffset(b []byte, t time.Time) []byte {
+	_, offset := t.Zone()
+	if offset == 0 {
+		return append(b, 'Z')
+	}
+	return appendNumericOffset(b, offset)
+}
+
+// appendNumericOffset appends the offset in seconds as ±hh:mm, dropping any
+// seconds
+func appendNumericOffset(b []byte, offset int) []byte {
+	sign := byte('+')
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	offset /= 60
+	b = append(b, sign)
+	b = appendInt2(b, offset/60)
+	b = append(b, ':')
+	return appendInt2(b, offset%60)
+}
+
+// appendFraction appends the first precision (at most 9) digits of nsec
+// after sep. When
+// trim is set trailing zeros are removed, and if no digits remain neither is
+// sep, like the .999 form of time.Format.
+func appendFraction(b []byte, nsec int, sep byte, precision int, trim bool) []byte {
+	for i := precision; i < 9; i++ {
+		nsec /= 10
+	}
+	if trim {
+		for precision > 0 && nsec%10 == 0 {
+			nsec /= 10
+			precision--
+		}
+		if precision == 0 {
+			return b
+		}
+	}
+
+	b = append(b, sep)
+	start := len(b)
+	for i := 0; i < precision; i++ {
+		b = append(b, '0')
+	}
+	for i := len(b) - 1; i >= start; i-- {
+		b[i] = byte('0' + nsec%10)
+		nsec /= 10
+	}
+	return b
+}
+
+// appendInt2 appends a zero padded two digit number
+func appendInt2(b []byte, v int) []byte {
+	return append(b, byte('0'+v/10), byte('0'+v%10))
+}
+
+// appendInt4 appends a zero padded four digit number
+func appendInt4(b []byte, v int) []byte {
+	return appendInt2(appendInt2(b, v/100), v%100)
+}
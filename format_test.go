@@ -0,0 +1,136 @@
+package chrono_test
+
+import (
+	"fmt"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestFormatFastPath(t *testing.T) {
+	t.Parallel()
+
+	same := func(d chrono.DateTime) bool {
+		std := d.ToStdTime()
+		date, tm := d.ToDate(), chrono.TimeFromStdTime(std)
+		return d.String() == std.Format(time.RFC3339) &&
+			string(d.AppendFormat(nil, time.RFC3339)) == std.Format(time.RFC3339) &&
+			date.String() == std.Format("2006-01-02") &&
+			tm.String() == std.Format("15:04:05Z07:00")
+	}
+	if err := quick.Check(same, nil); err != nil {
+		t.Error(err)
+	}
+
+	// Offsets with seconds and years that don't fit in 4 digits
+	odd := []chrono.DateTime{
+		chrono.NewDateTime(1800, 1, 2, 3, 4, 5, 0, time.FixedZone("LMT", -17762)),
+		chrono.NewDateTime(10000, 1, 2, 3, 4, 5, 0, time.UTC),
+		chrono.NewDateTime(-1, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	for _, d := range odd {
+		if !same(d) {
+			t.Errorf("%s: formatted differently to time.Format", d.ToStdTime())
+		}
+	}
+
+	b, _ := chrono.NewDate(2000, 1, 2).MarshalJSON()
+	if string(b) != `"2000-01-02"` {
+		t.Error("value wrong", string(b))
+	}
+	b, _ = chrono.NewTime(3, 4, 5, 0, time.UTC).MarshalJSON()
+	if string(b) != `"03:04:05Z"` {
+		t.Error("value wrong", string(b))
+	}
+}
+
+func TestFormatFastPathAllocs(t *testing.T) {
+	d := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.FixedZone("", -7*60*60))
+	date, tm := d.ToDate(), d.ToTime()
+	buf := make([]byte, 0, 64)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = d.AppendFormat(buf[:0], time.RFC3339)
+		buf = date.AppendFormat(buf[:0], "2006-01-02")
+	})
+	if allocs != 0 {
+		t.Error("expected no allocations, got", allocs)
+	}
+
+	allocs = testing.AllocsPerRun(100, func() {
+		_ = d.String()
+		_ = date.String()
+		_ = tm.String()
+	})
+	if allocs > 3 {
+		t.Error("expected at most one allocation per string, got", allocs)
+	}
+}
+
+func TestFmtVerbs(t *testing.T) {
+	t.Parallel()
+
+	d := chrono.NewDate(2000, 1, 2)
+	tm := chrono.NewTime(3, 4, 5, 0, time.UTC)
+	dt := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		Got  string
+		Want string
+	}{
+		{fmt.Sprintf("%v %s %q", d, d, d), `2000-01-02 2000-01-02 "2000-01-02"`},
+		{fmt.Sprintf("%12v|%-12s|", d, d), `  2000-01-02|2000-01-02  |`},
+		{fmt.Sprintf("%#v", d), `chrono.Date(2000, January, 2)`},
+		{fmt.Sprintf("%v %q", tm, tm), `03:04:05Z "03:04:05Z"`},
+		{fmt.Sprintf("%#v", tm), `chrono.Time(3, 4, 5, 0, UTC)`},
+		{fmt.Sprintf("%v %q", dt, dt), `2000-01-02T03:04:05Z "2000-01-02T03:04:05Z"`},
+		{fmt.Sprintf("%#v", dt), `chrono.DateTime(2000, January, 2, 3, 4, 5, 0, UTC)`},
+	}
+	for _, test := range tests {
+		if test.Got != test.Want {
+			t.Errorf("want %s, got %s", test.Want, test.Got)
+		}
+	}
+}
+
+func BenchmarkDateString(b *testing.B) {
+	d := chrono.NewDate(2000, 1, 2)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = d.String()
+	}
+}
+
+func BenchmarkTimeString(b *testing.B) {
+	t := chrono.NewTime(3, 4, 5, 0, time.UTC)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = t.String()
+	}
+}
+
+func BenchmarkDateTimeString(b *testing.B) {
+	d := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.FixedZone("", -7*60*60))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = d.String()
+	}
+}
+
+func BenchmarkDateTimeStringTimeFormat(b *testing.B) {
+	t := time.Date(2000, 1, 2, 3, 4, 5, 0, time.FixedZone("", -7*60*60))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = t.Format(time.RFC3339)
+	}
+}
+
+func BenchmarkDateMarshalJSON(b *testing.B) {
+	d := chrono.NewDate(2000, 1, 2)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = d.MarshalJSON()
+	}
+}
@@ -0,0 +1,106 @@
+package chrono
+
+import "time"
+
+// Formatter formats datetimes with a layout that is interpreted once when the
+// Formatter is created rather than on every call, eg. for log encoders that
+// write the same layout millions of times. Layouts that only use numeric
+// components (years, months, days, 24 hour clocks, fractional seconds and
+// numeric offsets) are formatted without the time package, others produce
+// the same output by falling back to time.Format.
+//
+// A Formatter is immutable and safe for concurrent use.
+type Formatter struct {
+	layout string
+	// tokens is nil when the layout needs the time package
+	tokens []LayoutToken
+}
+
+// NewFormatter creates a formatter for layout
+func NewFormatter(layout string) *Formatter {
+	f := &Formatter{layout: layout}
+	tokens := DescribeLayout(layout).Tokens
+	for _, tok := range tokens {
+		if !fastFormatToken(tok) {
+			return f
+		}
+	}
+	f.tokens = tokens
+	return f
+}
+
+// fastFormatToken reports whether the Formatter can write tok itself
+func fastFormatToken(tok LayoutToken) bool {
+	switch tok.Kind {
+	case TokenLiteral:
+		return true
+	case TokenFraction:
+		return tok.Precision <= 9
+	case TokenYear:
+		return tok.Text == "2006"
+	case TokenMonth:
+		return tok.Text == "01"
+	case TokenDay:
+		return tok.Text == "02"
+	case TokenHour:
+		return tok.Text == "15"
+	case TokenMinute:
+		return tok.Text == "04"
+	case TokenSecond:
+		return tok.Text == "05"
+	case TokenZone:
+		return tok.Text[1:] == "07:00"
+	}
+	return false
+}
+
+// Layout returns the layout of the formatter
+func (f *Formatter) Layout() string {
+	return f.layout
+}
+
+// Format returns d formatted with the formatter's layout
+func (f *Formatter) Format(d DateTime) string {
+	return string(f.AppendTo(make([]byte, 0, len(f.layout)+10), d))
+}
+
+// AppendTo appends d formatted with the formatter's layout to b
+func (f *Formatter) AppendTo(b []byte, d DateTime) []byte {
+	return f.appendTime(b, d.t)
+}
+
+func (f *Formatter) appendTime(b []byte, t time.Time) []byte {
+	year, month, day := t.Date()
+	if f.tokens == nil || year < 0 || year > 9999 {
+		return t.AppendFormat(b, f.layout)
+	}
+
+	hour, min, sec := t.Clock()
+	for _, tok := range f.tokens {
+		switch tok.Kind {
+		case TokenLiteral:
+			b = append(b, tok.Text...)
+		case TokenYear:
+			b = appendInt4(b, year)
+		case TokenMonth:
+			b = appendInt2(b, int(month))
+		case TokenDay:
+			b = appendInt2(b, day)
+		case TokenHour:
+			b = appendInt2(b, hour)
+		case TokenMinute:
+			b = appendInt2(b, min)
+		case TokenSecond:
+			b = appendInt2(b, sec)
+		case TokenFraction:
+			b = appendFraction(b, t.Nanosecond(), tok.Text[0], tok.Precision, tok.TrimZeros)
+		case TokenZone:
+			if _, offset := t.Zone(); offset == 0 && tok.Zone == ZoneOffsetZ {
+				b = append(b, 'Z')
+			} else {
+				b = appendNumericOffset(b, offset)
+			}
+		}
+	}
+	return b
+}
@@ -0,0 +1,105 @@
+package chrono_test
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/chronotest"
+)
+
+func TestFormatter(t *testing.T) {
+	t.Parallel()
+
+	layouts := []string{
+		time.RFC3339,
+		time.RFC3339Nano,
+		time.RFC1123Z,
+		time.Kitchen,
+		time.StampMicro,
+		"2006-01-02 15:04:05.000-07:00",
+		"2006-01-02T15:04:05,999999Z07:00",
+		"20060102150405.000000000",
+		"02/01/2006 15h04",
+		"2006-01-02 15:04:05 -07:00 MST",
+	}
+	zones := []*time.Location{
+		time.UTC,
+		time.FixedZone("", 5*60*60+30*60),
+		time.FixedZone("", -(3*60*60 + 30*60)),
+		time.FixedZone("", 15),
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, layout := range layouts {
+		f := chrono.NewFormatter(layout)
+		if f.Layout() != layout {
+			t.Error("wrong layout:", f.Layout())
+		}
+		for i := 0; i < 200; i++ {
+			d := chronotest.RandomDateTime(r).In(zones[i%len(zones)])
+			if i%3 == 0 {
+				d = d.Truncate(time.Millisecond)
+			} else if i%5 == 0 {
+				d = d.Truncate(time.Second)
+			}
+			want := d.Format(layout)
+			if got := f.Format(d); got != want {
+				t.Errorf("%q: want %q, got %q", layout, want, got)
+			}
+		}
+	}
+
+	d := chrono.NewDateTime(12345, 1, 2, 3, 4, 5, 0, time.UTC)
+	if got, want := chrono.NewFormatter(time.RFC3339).Format(d), d.Format(time.RFC3339); got != want {
+		t.Errorf("big years: want %q, got %q", want, got)
+	}
+}
+
+func TestFormatterConcurrent(t *testing.T) {
+	t.Parallel()
+
+	f := chrono.NewFormatter(time.RFC3339Nano)
+	d := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 600, time.UTC)
+	want := d.Format(time.RFC3339Nano)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var buf []byte
+			for j := 0; j < 100; j++ {
+				if buf = f.AppendTo(buf[:0], d); string(buf) != want {
+					t.Error("wrong output:", string(buf))
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkFormatterAppendTo(b *testing.B) {
+	f := chrono.NewFormatter("2006-01-02 15:04:05.000Z07:00")
+	d := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 600000000, time.FixedZone("", 60*60))
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = f.AppendTo(buf[:0], d)
+	}
+}
+
+func BenchmarkFormatterAppendToTimeFormat(b *testing.B) {
+	layout := "2006-01-02 15:04:05.000Z07:00"
+	d := time.Date(2000, 1, 2, 3, 4, 5, 600000000, time.FixedZone("", 60*60))
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = d.AppendFormat(buf[:0], layout)
+	}
+}
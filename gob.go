@@ -0,0 +1,102 @@
+package chrono
+
+import (
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"time"
+)
+
+func init() {
+	// Registered so that the types can be gob encoded inside of interfaces
+	gob.Register(Date{})
+	gob.Register(Time{})
+	gob.Register(DateTime{})
+	gob.Register(LocalDateTime{})
+	gob.Register(Instant{})
+}
+
+// gobVersion1 is the first byte of the gob encodings of Date and Time. It
+// starts above the versions used by time.Time's MarshalBinary (1 and 2),
+// which is what gob used for Time before it had its own encoding, so that
+// both can be decoded.
+const gobVersion1 byte = 0x10
+
+// Flags in the last byte of the Time gob encoding
+const (
+	gobTimeUTC byte = 1 << iota
+	gobTimeZero
+)
+
+// GobEncode implements gob.GobEncoder. It is a version byte followed by
+// the 4 byte MarshalBinary encoding, so it has the same 0-9999 year limit.
+func (d Date) GobEncode() ([]byte, error) {
+	bin, err := d.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{gobVersion1}, bin...), nil
+}
+
+// GobDecode implements gob.GobDecoder. It also accepts the unversioned
+// MarshalBinary encoding gob used before GobEncode existed.
+func (d *Date) GobDecode(data []byte) error {
+	if len(data) == 4 {
+		return d.UnmarshalBinary(data)
+	}
+	if len(data) != 5 || data[0] != gobVersion1 {
+		return &ParseError{Op: "unmarshal", Kind: "date", Input: string(data), Err: errors.New("unsupported version or length")}
+	}
+	return d.UnmarshalBinary(data[1:])
+}
+
+// GobEncode implements gob.GobEncoder. It is a version byte followed by the
+// nanoseconds since midnight (8 bytes), the zone offset in seconds (4 bytes)
+// and flags for UTC and the zero value (1 byte), which is much smaller than
+// time.Time's encoding since it has no date.
+//
+// Like time.Time only the offset of the location is kept, it is decoded as
+// UTC, Local if it matches the local offset, or a fixed zone.
+func (t Time) GobEncode() ([]byte, error) {
+	hour, min, sec := t.t.Clock()
+	nsec := (int64(hour)*60*60+int64(min)*60+int64(sec))*int64(time.Second) + int64(t.t.Nanosecond())
+	_, offset := t.t.Zone()
+
+	buf := make([]byte, 14)
+	buf[0] = gobVersion1
+	binary.LittleEndian.PutUint64(buf[1:], uint64(nsec))
+	binary.LittleEndian.PutUint32(buf[9:], uint32(int32(offset)))
+	if t.t.Location() == time.UTC {
+		buf[13] |= gobTimeUTC
+	}
+	if t.IsZero() {
+		buf[13] |= gobTimeZero
+	}
+	return buf, nil
+}
+
+// GobDecode implements gob.GobDecoder. It also accepts time.Time's binary
+// encoding which gob used before GobEncode existed.
+func (t *Time) GobDecode(data []byte) error {
+	if len(data) == 0 || data[0] != gobVersion1 {
+		return t.UnmarshalBinary(data)
+	}
+	if len(data) != 14 {
+		return &ParseError{Op: "unmarshal", Kind: "time", Input: string(data), Err: errors.New("incorrect number of bytes")}
+	}
+
+	if data[13]&gobTimeZero != 0 {
+		t.t = time.Time{}
+		return nil
+	}
+
+	nsec := int64(binary.LittleEndian.Uint64(data[1:]))
+	if nsec < 0 || nsec >= 24*int64(time.Hour) {
+		return &ParseError{Op: "unmarshal", Kind: "time", Input: string(data), Err: errors.New("nanoseconds out of range")}
+	}
+	offset := int(int32(binary.LittleEndian.Uint32(data[9:])))
+
+	sec := int(nsec / int64(time.Second))
+	t.t = sqlTime(0, 1, 1, sec/(60*60), sec/60%60, sec%60, int(nsec%int64(time.Second)), offset, data[13]&gobTimeUTC != 0)
+	return nil
+}
@@ -0,0 +1,104 @@
+package chrono_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestGob(t *testing.T) {
+	t.Parallel()
+
+	type record struct {
+		Date   chrono.Date
+		Time   chrono.Time
+		Zoned  chrono.Time
+		Zero   chrono.Time
+		Any    any
+		AnyPtr any
+	}
+
+	loc := time.FixedZone("", 5*60*60+30*60)
+	want := record{
+		Date:   chrono.NewDate(2000, 1, 2),
+		Time:   chrono.NewTime(3, 4, 5, 6, time.UTC),
+		Zoned:  chrono.TimeFromStdTime(time.Date(0, 1, 1, 23, 59, 59, 999999999, loc)),
+		Any:    chrono.NewDate(2000, 1, 2),
+		AnyPtr: chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 6, time.UTC),
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatal(err)
+	}
+	var got record
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+
+	if !got.Date.Equal(want.Date) {
+		t.Error("date wrong:", got.Date)
+	}
+	if !got.Time.Equal(want.Time) || got.Time.Location() != time.UTC {
+		t.Error("time wrong:", got.Time)
+	}
+	if !got.Zoned.Equal(want.Zoned) {
+		t.Error("zoned time wrong:", got.Zoned)
+	}
+	if _, offset := got.Zoned.Zone(); offset != 5*60*60+30*60 {
+		t.Error("zoned time offset wrong:", offset)
+	}
+	if !got.Zero.IsZero() {
+		t.Error("zero time should stay zero:", got.Zero)
+	}
+	if d, ok := got.Any.(chrono.Date); !ok || !d.Equal(want.Any.(chrono.Date)) {
+		t.Error("date in interface wrong:", got.Any)
+	}
+	if d, ok := got.AnyPtr.(chrono.DateTime); !ok || !d.Equal(want.AnyPtr.(chrono.DateTime)) {
+		t.Error("datetime in interface wrong:", got.AnyPtr)
+	}
+}
+
+func TestGobSize(t *testing.T) {
+	t.Parallel()
+
+	date, _ := chrono.NewDate(2000, 1, 2).GobEncode()
+	if len(date) != 5 {
+		t.Error("date gob should be 5 bytes:", len(date))
+	}
+	tm, _ := chrono.NewTime(3, 4, 5, 6, time.UTC).GobEncode()
+	if len(tm) != 14 {
+		t.Error("time gob should be 14 bytes:", len(tm))
+	}
+}
+
+func TestGobLegacy(t *testing.T) {
+	t.Parallel()
+
+	// Before GobEncode existed gob used MarshalBinary
+	bin, _ := chrono.NewDate(2000, 1, 2).MarshalBinary()
+	var date chrono.Date
+	if err := date.GobDecode(bin); err != nil {
+		t.Error(err)
+	}
+	if !date.Equal(chrono.NewDate(2000, 1, 2)) {
+		t.Error("legacy date wrong:", date)
+	}
+
+	ref := chrono.NewTime(3, 4, 5, 6, time.UTC)
+	bin, _ = ref.MarshalBinary()
+	var tm chrono.Time
+	if err := tm.GobDecode(bin); err != nil {
+		t.Error(err)
+	}
+	if !tm.Equal(ref) {
+		t.Error("legacy time wrong:", tm)
+	}
+
+	if err := date.GobDecode([]byte{0x7f, 1, 2, 3, 4}); err == nil {
+		t.Error("expected an error for an unknown version")
+	}
+}
@@ -0,0 +1,45 @@
+package chrono
+
+// The methods in this file implement gorm's schema.GormDataTypeInterface.
+// Without them gorm infers the column type from the string returned by Value
+// and creates text columns. The names were chosen so that MySQL, Postgres
+// and SQLite all accept them unchanged:
+//
+//	Date          date
+//	Time          TIME (time without time zone)
+//	DateTime      gorm's time type: timestamptz, datetime(3) or datetime
+//	Instant       gorm's time type: timestamptz, datetime(3) or datetime
+//	LocalDateTime TIMESTAMP (timestamp without time zone)
+//
+// Pointers to the types are nullable columns. Use a `gorm:"type:..."` tag to
+// override these, eg. MySQL's TIMESTAMP is limited to 1970-2038 so datetime
+// is the better choice for a LocalDateTime there. Instant has no sql methods
+// of its own and MySQL rejects the offsets that DateTime and Time write, see
+// the gormchrono package for a serializer that handles both.
+
+// GormDataType implements schema.GormDataTypeInterface
+func (d Date) GormDataType() string {
+	return "date"
+}
+
+// GormDataType implements schema.GormDataTypeInterface. It is upper case on
+// purpose, gorm's lower case "time" means a timestamp.
+func (t Time) GormDataType() string {
+	return "TIME"
+}
+
+// GormDataType implements schema.GormDataTypeInterface
+func (d DateTime) GormDataType() string {
+	return "time"
+}
+
+// GormDataType implements schema.GormDataTypeInterface
+func (i Instant) GormDataType() string {
+	return "time"
+}
+
+// GormDataType implements schema.GormDataTypeInterface. It is upper case for
+// the same reason as Time's.
+func (l LocalDateTime) GormDataType() string {
+	return "TIMESTAMP"
+}
@@ -0,0 +1,33 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestGormDataType(t *testing.T) {
+	t.Parallel()
+
+	type gormDataTyper interface {
+		GormDataType() string
+	}
+
+	tests := []struct {
+		Value gormDataTyper
+		Want  string
+	}{
+		{chrono.Date{}, "date"},
+		{chrono.Time{}, "TIME"},
+		{chrono.DateTime{}, "time"},
+		{chrono.Instant{}, "time"},
+		{chrono.LocalDateTime{}, "TIMESTAMP"},
+		{&chrono.Date{}, "date"},
+	}
+
+	for _, test := range tests {
+		if got := test.Value.GormDataType(); got != test.Want {
+			t.Errorf("%T: want: %s, got: %s", test.Value, test.Want, got)
+		}
+	}
+}
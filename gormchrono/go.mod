@@ -0,0 +1,16 @@
+module github.com/aarondl/chrono/gormchrono
+
+go 1.18
+
+require (
+	github.com/aarondl/chrono v0.0.0
+	gorm.io/gorm v1.31.2
+)
+
+require (
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	golang.org/x/text v0.22.0 // indirect
+)
+
+replace github.com/aarondl/chrono => ../
@@ -0,0 +1,126 @@
+// Package gormchrono registers a gorm serializer for chrono's types.
+//
+// chrono's types already tell gorm what column type to use (see their
+// GormDataType methods) and scan and write themselves with their
+// sql.Scanner and driver.Valuer implementations. Some of those values don't
+// suit every database: MySQL rejects the offsets written by DateTime and
+// Time, and Instant has no sql methods at all. Importing this package
+// registers the "chrono" serializer which fixes both:
+//
+//	type Event struct {
+//		ID      int
+//		Day     chrono.Date
+//		At      chrono.DateTime  `gorm:"serializer:chrono"`
+//		Seen    *chrono.Instant  `gorm:"serializer:chrono"`
+//		Opens   chrono.Time      `gorm:"serializer:chrono"`
+//	}
+//
+// DateTime and Instant are given to the driver as a time.Time so that it can
+// convert them for the column itself, and Time is written without an offset.
+// Pointers are nullable, NULL scans into a nil pointer.
+package gormchrono
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"gorm.io/gorm/schema"
+)
+
+// SerializerName is the name the Serializer is registered under
+const SerializerName = "chrono"
+
+// timeLayout is how Times are written, microseconds are the most that MySQL
+// and Postgres store
+const timeLayout = "15:04:05.999999"
+
+func init() {
+	schema.RegisterSerializer(SerializerName, Serializer{})
+}
+
+// Serializer implements schema.SerializerInterface for chrono's types
+type Serializer struct{}
+
+// Scan implements schema.SerializerInterface
+func (Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue any) error {
+	fieldValue := reflect.New(field.FieldType).Elem()
+
+	if dbValue != nil {
+		ptr := reflect.New(field.IndirectFieldType)
+		switch target := ptr.Interface().(type) {
+		case *chrono.Time:
+			if err := scanTime(target, dbValue); err != nil {
+				return err
+			}
+		case *chrono.Instant:
+			var d chrono.DateTime
+			if err := d.Scan(dbValue); err != nil {
+				return err
+			}
+			*target = chrono.InstantFromDateTime(d)
+		case sql.Scanner:
+			if err := target.Scan(dbValue); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("gormchrono: cannot scan into %s", field.FieldType)
+		}
+
+		if field.FieldType.Kind() == reflect.Ptr {
+			fieldValue.Set(ptr)
+		} else {
+			fieldValue.Set(ptr.Elem())
+		}
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(fieldValue)
+	return nil
+}
+
+// scanTime accepts the times without offsets that Value writes and that
+// TIME columns return, anything else is left to chrono.Time's Scan
+func scanTime(t *chrono.Time, dbValue any) error {
+	var str string
+	switch v := dbValue.(type) {
+	case string:
+		str = v
+	case []byte:
+		str = string(v)
+	default:
+		return t.Scan(dbValue)
+	}
+
+	if parsed, err := time.Parse(timeLayout, str); err == nil {
+		*t = chrono.TimeFromStdTime(parsed)
+		return nil
+	}
+	return t.Scan(dbValue)
+}
+
+// Value implements schema.SerializerValuerInterface
+func (Serializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue any) (any, error) {
+	if rv := reflect.ValueOf(fieldValue); rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, nil
+		}
+		fieldValue = rv.Elem().Interface()
+	}
+
+	switch v := fieldValue.(type) {
+	case chrono.DateTime:
+		return v.ToStdTime(), nil
+	case chrono.Instant:
+		return v.ToStdTime(), nil
+	case chrono.Time:
+		return v.Format(timeLayout), nil
+	case driver.Valuer:
+		return v.Value()
+	}
+
+	return nil, fmt.Errorf("gormchrono: cannot serialize %T", fieldValue)
+}
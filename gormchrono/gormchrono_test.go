@@ -0,0 +1,149 @@
+package gormchrono_test
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/gormchrono"
+	"gorm.io/gorm/schema"
+)
+
+type event struct {
+	ID         int
+	Day        chrono.Date
+	Opens      chrono.Time          `gorm:"serializer:chrono"`
+	At         chrono.DateTime      `gorm:"serializer:chrono"`
+	Seen       *chrono.Instant      `gorm:"serializer:chrono"`
+	Local      chrono.LocalDateTime `gorm:"serializer:chrono"`
+	Cancelled  *chrono.DateTime     `gorm:"serializer:chrono"`
+	Plain      chrono.DateTime
+	PlainLocal *chrono.LocalDateTime
+}
+
+func parse(t *testing.T) *schema.Schema {
+	t.Helper()
+
+	s, err := schema.Parse(&event{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestDataTypes(t *testing.T) {
+	t.Parallel()
+
+	s := parse(t)
+	tests := map[string]schema.DataType{
+		"Day":        "date",
+		"Opens":      "TIME",
+		"At":         schema.Time,
+		"Seen":       schema.Time,
+		"Local":      "TIMESTAMP",
+		"Cancelled":  schema.Time,
+		"Plain":      schema.Time,
+		"PlainLocal": "TIMESTAMP",
+	}
+
+	for name, want := range tests {
+		field := s.LookUpField(name)
+		if field == nil {
+			t.Errorf("%s: field missing", name)
+			continue
+		}
+		if field.DataType != want {
+			t.Errorf("%s: want: %s, got: %s", name, want, field.DataType)
+		}
+	}
+
+	if _, ok := s.LookUpField("At").Serializer.(gormchrono.Serializer); !ok {
+		t.Error("serializer was not registered")
+	}
+}
+
+func TestValue(t *testing.T) {
+	t.Parallel()
+
+	s := parse(t)
+	loc := time.FixedZone("", -7*60*60)
+	ev := event{
+		Day:   chrono.NewDate(2000, 1, 2),
+		Opens: chrono.NewTime(3, 4, 5, 600000000, loc),
+		At:    chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, loc),
+		Local: chrono.NewLocalDateTime(2000, 1, 2, 3, 4, 5, 0),
+	}
+	instant := chrono.InstantFromUnix(946782245, 0)
+	ev.Seen = &instant
+
+	tests := map[string]any{
+		"Opens":     "03:04:05.6",
+		"At":        time.Date(2000, 1, 2, 3, 4, 5, 0, loc),
+		"Seen":      time.Unix(946782245, 0).UTC(),
+		"Local":     "2000-01-02 03:04:05",
+		"Cancelled": nil,
+	}
+
+	ctx := context.Background()
+	rv := reflect.ValueOf(&ev).Elem()
+	for name, want := range tests {
+		field := s.LookUpField(name)
+		fieldValue, _ := field.ValueOf(ctx, rv)
+		got, err := field.Serializer.Value(ctx, field, rv, fieldValue)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+
+		if wantTime, ok := want.(time.Time); ok {
+			if gotTime, ok := got.(time.Time); !ok || !gotTime.Equal(wantTime) {
+				t.Errorf("%s: want: %v, got: %v", name, want, got)
+			}
+		} else if got != want {
+			t.Errorf("%s: want: %#v, got: %#v", name, want, got)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	s := parse(t)
+	ctx := context.Background()
+	ev := event{Cancelled: &chrono.DateTime{}}
+	rv := reflect.ValueOf(&ev).Elem()
+
+	at := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	values := map[string]any{
+		"Opens":     []byte("03:04:05"),
+		"At":        at,
+		"Seen":      at,
+		"Local":     "2000-01-02 03:04:05",
+		"Cancelled": nil,
+	}
+	for name, value := range values {
+		field := s.LookUpField(name)
+		if err := field.Serializer.Scan(ctx, field, rv, value); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+
+	if ev.Opens.Hour() != 3 || ev.Opens.Minute() != 4 || ev.Opens.Second() != 5 {
+		t.Error("opens wrong:", ev.Opens)
+	}
+	if !ev.At.Equal(chrono.DateTimeFromStdTime(at)) {
+		t.Error("at wrong:", ev.At)
+	}
+	if ev.Seen == nil || *ev.Seen != chrono.InstantFromStdTime(at) {
+		t.Error("seen wrong:", ev.Seen)
+	}
+	if ev.Local != chrono.NewLocalDateTime(2000, 1, 2, 3, 4, 5, 0) {
+		t.Error("local wrong:", ev.Local)
+	}
+	if ev.Cancelled != nil {
+		t.Error("null should scan into a nil pointer:", ev.Cancelled)
+	}
+}
@@ -0,0 +1,69 @@
+package chrono
+
+import "io"
+
+// The methods in this file implement the graphql.Marshaler and
+// graphql.Unmarshaler interfaces from gqlgen so the types can be bound to
+// custom scalars directly:
+//
+//	models:
+//	  Date:
+//	    model: github.com/aarondl/chrono.Date
+//
+// Values are written as the same strings the JSON encoding uses. Since
+// graphql.Marshaler can't return an error, values the JSON encoding rejects
+// (such as years outside of [0,9999]) are written as null.
+
+// MarshalGQL implements graphql.Marshaler
+func (d Date) MarshalGQL(w io.Writer) {
+	writeGQL(w, d.MarshalJSON)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler, it accepts the same strings
+// as UnmarshalText
+func (d *Date) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return &TypeError{Op: "unmarshal", Kind: "date", Value: v}
+	}
+	return d.UnmarshalText([]byte(str))
+}
+
+// MarshalGQL implements graphql.Marshaler
+func (t Time) MarshalGQL(w io.Writer) {
+	writeGQL(w, t.MarshalJSON)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler, it accepts the same strings
+// as UnmarshalText
+func (t *Time) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return &TypeError{Op: "unmarshal", Kind: "time", Value: v}
+	}
+	return t.UnmarshalText([]byte(str))
+}
+
+// MarshalGQL implements graphql.Marshaler
+func (d DateTime) MarshalGQL(w io.Writer) {
+	writeGQL(w, d.MarshalJSON)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler, it accepts the same strings
+// as UnmarshalText
+func (d *DateTime) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return &TypeError{Op: "unmarshal", Kind: "datetime", Value: v}
+	}
+	return d.UnmarshalText([]byte(str))
+}
+
+// writeGQL writes the output of marshal to w, or null if it fails
+func writeGQL(w io.Writer, marshal func() ([]byte, error)) {
+	b, err := marshal()
+	if err != nil {
+		b = []byte("null")
+	}
+	_, _ = w.Write(b)
+}
@@ -0,0 +1,63 @@
+package chrono_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestGraphQL(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	chrono.NewDate(2000, 1, 2).MarshalGQL(&buf)
+	chrono.NewTime(3, 4, 5, 0, time.UTC).MarshalGQL(&buf)
+	chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 6, time.UTC).MarshalGQL(&buf)
+	if got := buf.String(); got != `"2000-01-02""03:04:05Z""2000-01-02T03:04:05.000000006Z"` {
+		t.Error("value wrong", got)
+	}
+
+	// Years JSON can't encode are written as null instead of nothing
+	buf.Reset()
+	chrono.NewDate(10000, 1, 1).MarshalGQL(&buf)
+	chrono.NewDateTime(-1, 1, 1, 0, 0, 0, 0, time.UTC).MarshalGQL(&buf)
+	if got := buf.String(); got != "nullnull" {
+		t.Error("value wrong", got)
+	}
+
+	var d chrono.Date
+	if err := d.UnmarshalGQL("2000-01-02"); err != nil {
+		t.Error(err)
+	}
+	if !d.Equal(chrono.NewDate(2000, 1, 2)) {
+		t.Error("value wrong", d)
+	}
+
+	var tm chrono.Time
+	if err := tm.UnmarshalGQL("03:04:05Z"); err != nil {
+		t.Error(err)
+	}
+	if tm.Hour() != 3 || tm.Minute() != 4 || tm.Second() != 5 {
+		t.Error("value wrong", tm)
+	}
+
+	var dt chrono.DateTime
+	if err := dt.UnmarshalGQL("2000-01-02T03:04:05.000000006Z"); err != nil {
+		t.Error(err)
+	}
+	if !dt.Equal(chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 6, time.UTC)) {
+		t.Error("value wrong", dt)
+	}
+
+	if err := d.UnmarshalGQL(5); err == nil {
+		t.Error("expected an error")
+	}
+	if err := tm.UnmarshalGQL(nil); err == nil {
+		t.Error("expected an error")
+	}
+	if err := dt.UnmarshalGQL("nope"); err == nil {
+		t.Error("expected an error")
+	}
+}
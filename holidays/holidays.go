@@ -0,0 +1,223 @@
+// Package holidays provides rule based holiday calendars for use with the
+// business day calculations in chrono.
+//
+// A Calendar is a set of Rules, each of which computes the date of a
+// single holiday for a given year. Presets for some common regions are
+// provided (see US, UK, EU, CA and AU), and custom calendars can be built from
+// the rule constructors:
+//
+//	cal := holidays.New("Company",
+//		holidays.Fixed("Founders Day", time.March, 14).Observed(holidays.NearestWeekday),
+//		holidays.LastWeekday("Summer Outing", time.July, time.Friday),
+//	)
+package holidays
+
+import (
+	"sort"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+// Observance moves a holiday that falls on a weekend to the day on which it
+// is observed. It must return d unchanged if no move is necessary.
+type Observance func(d chrono.Date) chrono.Date
+
+// NearestWeekday observes Saturday holidays on the Friday before and Sunday
+// holidays on the Monday after.
+func NearestWeekday(d chrono.Date) chrono.Date {
+	switch d.Weekday() {
+	case time.Saturday:
+		return d.AddDate(0, 0, -1)
+	case time.Sunday:
+		return d.AddDate(0, 0, 1)
+	}
+	return d
+}
+
+// NextMonday observes weekend holidays on the following Monday.
+func NextMonday(d chrono.Date) chrono.Date {
+	switch d.Weekday() {
+	case time.Saturday:
+		return d.AddDate(0, 0, 2)
+	case time.Sunday:
+		return d.AddDate(0, 0, 1)
+	}
+	return d
+}
+
+// TwoDaysLater observes weekend holidays two days later. This is used for
+// pairs of consecutive holidays such as Christmas Day and Boxing Day so that
+// they are observed on different days.
+func TwoDaysLater(d chrono.Date) chrono.Date {
+	switch d.Weekday() {
+	case time.Saturday, time.Sunday:
+		return d.AddDate(0, 0, 2)
+	}
+	return d
+}
+
+// Holiday is a single occurrence of a holiday
+type Holiday struct {
+	Name string
+	// Date is the day the holiday is observed
+	Date chrono.Date
+	// Actual is the day the holiday falls on, which differs from Date when
+	// it has been moved due to an Observance.
+	Actual chrono.Date
+}
+
+// Rule computes the date of a holiday in a given year
+type Rule struct {
+	Name string
+	// Date returns the actual date of the holiday in year
+	Date func(year int) chrono.Date
+	// Observe is optional and moves the holiday when it is not observed on
+	// the actual date
+	Observe Observance
+	// From and To are optional and bound the years (inclusive) in which the
+	// holiday exists
+	From, To int
+}
+
+// Fixed creates a rule for a holiday on the same day every year
+func Fixed(name string, month time.Month, day int) Rule {
+	return Rule{
+		Name: name,
+		Date: func(year int) chrono.Date {
+			return chrono.NewDate(year, month, day)
+		},
+	}
+}
+
+// NthWeekday creates a rule for a holiday on the nth weekday of the month,
+// eg. Thanksgiving in the US is the 4th Thursday of November. A negative n
+// counts from the end of the month so -1 is the last weekday of the month.
+func NthWeekday(name string, month time.Month, weekday time.Weekday, n int) Rule {
+	return Rule{
+		Name: name,
+		Date: func(year int) chrono.Date {
+			if n < 0 {
+				last := chrono.NewDate(year, month+1, 0)
+				back := (int(last.Weekday()) - int(weekday) + 7) % 7
+				return last.AddDate(0, 0, -back+(n+1)*7)
+			}
+
+			first := chrono.NewDate(year, month, 1)
+			forward := (int(weekday) - int(first.Weekday()) + 7) % 7
+			return first.AddDate(0, 0, forward+(n-1)*7)
+		},
+	}
+}
+
+// LastWeekday creates a rule for a holiday on the last weekday of the month,
+// eg. Memorial Day in the US is the last Monday of May.
+func LastWeekday(name string, month time.Month, weekday time.Weekday) Rule {
+	return NthWeekday(name, month, weekday, -1)
+}
+
+// WeekdayBefore creates a rule for a holiday on the last weekday strictly
+// before the given day of the month, eg. Victoria Day in Canada is the
+// Monday before May 25th.
+func WeekdayBefore(name string, month time.Month, day int, weekday time.Weekday) Rule {
+	return Rule{
+		Name: name,
+		Date: func(year int) chrono.Date {
+			d := chrono.NewDate(year, month, day).AddDate(0, 0, -1)
+			back := (int(d.Weekday()) - int(weekday) + 7) % 7
+			return d.AddDate(0, 0, -back)
+		},
+	}
+}
+
+// EasterOffset creates a rule for a holiday a number of days away from
+// (Gregorian) Easter Sunday, eg. Good Friday is -2.
+func EasterOffset(name string, days int) Rule {
+	return Rule{
+		Name: name,
+		Date: func(year int) chrono.Date {
+			return chrono.EasterSunday(year, chrono.EasterGregorian).AddDate(0, 0, days)
+		},
+	}
+}
+
+// Observed returns a copy of the rule using the observance
+func (r Rule) Observed(o Observance) Rule {
+	r.Observe = o
+	return r
+}
+
+// Between returns a copy of the rule that only applies between the years
+// from and to (inclusive), 0 leaves that side unbounded.
+func (r Rule) Between(from, to int) Rule {
+	r.From, r.To = from, to
+	return r
+}
+
+// holiday returns the holiday in year and false if the rule does not apply
+func (r Rule) holiday(year int) (Holiday, bool) {
+	if (r.From != 0 && year < r.From) || (r.To != 0 && year > r.To) {
+		return Holiday{}, false
+	}
+
+	actual := r.Date(year)
+	observed := actual
+	if r.Observe != nil {
+		observed = r.Observe(actual)
+	}
+	return Holiday{Name: r.Name, Date: observed, Actual: actual}, true
+}
+
+// Calendar is a set of holiday rules. It implements chrono.Calendar.
+type Calendar struct {
+	Name  string
+	Rules []Rule
+}
+
+// New creates a calendar from rules
+func New(name string, rules ...Rule) *Calendar {
+	return &Calendar{Name: name, Rules: rules}
+}
+
+// Add rules to the calendar
+func (c *Calendar) Add(rules ...Rule) {
+	c.Rules = append(c.Rules, rules...)
+}
+
+// Holidays returns every holiday that is observed in year in date order.
+// Holidays observed in an adjacent year (such as New Year's Day observed on
+// December 31st) are included in the year they're observed in.
+func (c *Calendar) Holidays(year int) []Holiday {
+	var out []Holiday
+	for y := year - 1; y <= year+1; y++ {
+		for _, r := range c.Rules {
+			h, ok := r.holiday(y)
+			if ok && h.Date.Year() == year {
+				out = append(out, h)
+			}
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Date.Before(out[j].Date)
+	})
+	return out
+}
+
+// Holiday returns the holiday observed on d if there is one
+func (c *Calendar) Holiday(d chrono.Date) (Holiday, bool) {
+	for y := d.Year() - 1; y <= d.Year()+1; y++ {
+		for _, r := range c.Rules {
+			if h, ok := r.holiday(y); ok && h.Date.Equal(d) {
+				return h, true
+			}
+		}
+	}
+	return Holiday{}, false
+}
+
+// IsHoliday returns true if a holiday is observed on d
+func (c *Calendar) IsHoliday(d chrono.Date) bool {
+	_, ok := c.Holiday(d)
+	return ok
+}
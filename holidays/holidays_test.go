@@ -0,0 +1,100 @@
+package holidays_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/holidays"
+)
+
+var _ chrono.Calendar = &holidays.Calendar{}
+
+func TestRules(t *testing.T) {
+	t.Parallel()
+
+	cal := holidays.New("Test",
+		holidays.Fixed("Fixed", time.March, 14).Observed(holidays.NearestWeekday),
+		holidays.NthWeekday("Second Tuesday", time.March, time.Tuesday, 2),
+		holidays.LastWeekday("Last Friday", time.July, time.Friday),
+		holidays.NthWeekday("Second Last Friday", time.July, time.Friday, -2),
+		holidays.WeekdayBefore("Monday Before", time.May, 25, time.Monday),
+		holidays.EasterOffset("Easter", 0),
+		holidays.Fixed("Bounded", time.April, 1).Between(2020, 2021),
+	)
+
+	tests := []struct {
+		Name string
+		Year int
+		Want chrono.Date
+	}{
+		// 2021-03-14 is a Sunday
+		{"Fixed", 2021, chrono.NewDate(2021, 3, 15)},
+		{"Second Tuesday", 2021, chrono.NewDate(2021, 3, 9)},
+		{"Last Friday", 2021, chrono.NewDate(2021, 7, 30)},
+		{"Second Last Friday", 2021, chrono.NewDate(2021, 7, 23)},
+		{"Monday Before", 2021, chrono.NewDate(2021, 5, 24)},
+		{"Monday Before", 2022, chrono.NewDate(2022, 5, 23)},
+		{"Easter", 2000, chrono.NewDate(2000, 4, 23)},
+		{"Easter", 2019, chrono.NewDate(2019, 4, 21)},
+		{"Easter", 2024, chrono.NewDate(2024, 3, 31)},
+		{"Easter", 2025, chrono.NewDate(2025, 4, 20)},
+		{"Bounded", 2021, chrono.NewDate(2021, 4, 1)},
+	}
+
+	for _, test := range tests {
+		h, ok := cal.Holiday(test.Want)
+		if !ok {
+			t.Errorf("%s %d: expected a holiday on %s", test.Name, test.Year, test.Want)
+			continue
+		}
+		if h.Name != test.Name {
+			t.Errorf("%s %d: got %s", test.Name, test.Year, h.Name)
+		}
+	}
+
+	if cal.IsHoliday(chrono.NewDate(2022, 4, 1)) {
+		t.Error("bounded holiday should not exist in 2022")
+	}
+	if cal.IsHoliday(chrono.NewDate(2021, 3, 14)) {
+		t.Error("fixed holiday should have been observed on monday")
+	}
+	h, _ := cal.Holiday(chrono.NewDate(2021, 3, 15))
+	if !h.Actual.Equal(chrono.NewDate(2021, 3, 14)) {
+		t.Error("actual date wrong", h.Actual)
+	}
+}
+
+func TestCalendarHolidays(t *testing.T) {
+	t.Parallel()
+
+	cal := holidays.US()
+
+	// New Year's Day 2022 is a Saturday and is observed in 2021
+	hs := cal.Holidays(2021)
+	last := hs[len(hs)-1]
+	if last.Name != "New Year's Day" || !last.Date.Equal(chrono.NewDate(2021, 12, 31)) {
+		t.Error("last holiday wrong", last)
+	}
+	for _, h := range cal.Holidays(2022) {
+		if h.Name == "New Year's Day" {
+			t.Error("new year's day was observed in 2021")
+		}
+	}
+
+	for i := 1; i < len(hs); i++ {
+		if hs[i].Date.Before(hs[i-1].Date) {
+			t.Error("holidays should be sorted")
+		}
+	}
+
+	cal.Add(holidays.Fixed("Company Day", time.August, 1))
+	if !cal.IsHoliday(chrono.NewDate(2022, 8, 1)) {
+		t.Error("added rule should apply")
+	}
+
+	// Works with chrono's business day math, 2022-07-01 is a Friday
+	if d := chrono.NewDate(2022, 7, 1).AddBusinessDays(1, cal); !d.Equal(chrono.NewDate(2022, 7, 5)) {
+		t.Error("value wrong", d)
+	}
+}
@@ -0,0 +1,78 @@
+package holidays
+
+import "time"
+
+// US returns a calendar of United States federal holidays
+func US() *Calendar {
+	return New("US",
+		Fixed("New Year's Day", time.January, 1).Observed(NearestWeekday),
+		NthWeekday("Martin Luther King Jr. Day", time.January, time.Monday, 3).Between(1986, 0),
+		NthWeekday("Washington's Birthday", time.February, time.Monday, 3),
+		LastWeekday("Memorial Day", time.May, time.Monday),
+		Fixed("Juneteenth National Independence Day", time.June, 19).Observed(NearestWeekday).Between(2021, 0),
+		Fixed("Independence Day", time.July, 4).Observed(NearestWeekday),
+		NthWeekday("Labor Day", time.September, time.Monday, 1),
+		NthWeekday("Columbus Day", time.October, time.Monday, 2),
+		Fixed("Veterans Day", time.November, 11).Observed(NearestWeekday),
+		NthWeekday("Thanksgiving Day", time.November, time.Thursday, 4),
+		Fixed("Christmas Day", time.December, 25).Observed(NearestWeekday),
+	)
+}
+
+// UK returns a calendar of bank holidays in England and Wales. One-off bank
+// holidays (such as those for coronations and jubilees) are not included.
+func UK() *Calendar {
+	return New("UK",
+		Fixed("New Year's Day", time.January, 1).Observed(NextMonday),
+		EasterOffset("Good Friday", -2),
+		EasterOffset("Easter Monday", 1),
+		NthWeekday("Early May Bank Holiday", time.May, time.Monday, 1),
+		LastWeekday("Spring Bank Holiday", time.May, time.Monday),
+		LastWeekday("Summer Bank Holiday", time.August, time.Monday),
+		Fixed("Christmas Day", time.December, 25).Observed(TwoDaysLater),
+		Fixed("Boxing Day", time.December, 26).Observed(TwoDaysLater),
+	)
+}
+
+// EU returns a calendar of the TARGET2 closing days observed by the European
+// Central Bank, which are the only holidays common to the whole EU.
+func EU() *Calendar {
+	return New("EU",
+		Fixed("New Year's Day", time.January, 1),
+		EasterOffset("Good Friday", -2),
+		EasterOffset("Easter Monday", 1),
+		Fixed("Labour Day", time.May, 1),
+		Fixed("Christmas Day", time.December, 25),
+		Fixed("Boxing Day", time.December, 26),
+	)
+}
+
+// CA returns a calendar of Canadian federal statutory holidays
+func CA() *Calendar {
+	return New("CA",
+		Fixed("New Year's Day", time.January, 1).Observed(NextMonday),
+		EasterOffset("Good Friday", -2),
+		WeekdayBefore("Victoria Day", time.May, 25, time.Monday),
+		Fixed("Canada Day", time.July, 1).Observed(NextMonday),
+		NthWeekday("Labour Day", time.September, time.Monday, 1),
+		Fixed("National Day for Truth and Reconciliation", time.September, 30).Observed(NextMonday).Between(2021, 0),
+		NthWeekday("Thanksgiving", time.October, time.Monday, 2),
+		Fixed("Remembrance Day", time.November, 11).Observed(NextMonday),
+		Fixed("Christmas Day", time.December, 25).Observed(TwoDaysLater),
+		Fixed("Boxing Day", time.December, 26).Observed(TwoDaysLater),
+	)
+}
+
+// AU returns a calendar of Australian national public holidays. States add
+// their own holidays on top of these, which can be done with Add.
+func AU() *Calendar {
+	return New("AU",
+		Fixed("New Year's Day", time.January, 1).Observed(NextMonday),
+		Fixed("Australia Day", time.January, 26).Observed(NextMonday),
+		EasterOffset("Good Friday", -2),
+		EasterOffset("Easter Monday", 1),
+		Fixed("Anzac Day", time.April, 25),
+		Fixed("Christmas Day", time.December, 25).Observed(TwoDaysLater),
+		Fixed("Boxing Day", time.December, 26).Observed(TwoDaysLater),
+	)
+}
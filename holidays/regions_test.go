@@ -0,0 +1,48 @@
+package holidays_test
+
+import (
+	"testing"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/holidays"
+)
+
+func TestRegions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Calendar *holidays.Calendar
+		Date     chrono.Date
+		Name     string
+	}{
+		{holidays.US(), chrono.NewDate(2021, 6, 18), "Juneteenth National Independence Day"},
+		{holidays.US(), chrono.NewDate(2021, 7, 5), "Independence Day"},
+		{holidays.US(), chrono.NewDate(2022, 5, 30), "Memorial Day"},
+		{holidays.US(), chrono.NewDate(2022, 11, 24), "Thanksgiving Day"},
+		{holidays.UK(), chrono.NewDate(2022, 4, 15), "Good Friday"},
+		{holidays.UK(), chrono.NewDate(2022, 12, 26), "Boxing Day"},
+		{holidays.UK(), chrono.NewDate(2022, 12, 27), "Christmas Day"},
+		{holidays.UK(), chrono.NewDate(2021, 12, 27), "Christmas Day"},
+		{holidays.UK(), chrono.NewDate(2021, 12, 28), "Boxing Day"},
+		{holidays.EU(), chrono.NewDate(2022, 5, 1), "Labour Day"},
+		{holidays.CA(), chrono.NewDate(2022, 5, 23), "Victoria Day"},
+		{holidays.CA(), chrono.NewDate(2022, 10, 10), "Thanksgiving"},
+		{holidays.AU(), chrono.NewDate(2022, 1, 26), "Australia Day"},
+		{holidays.AU(), chrono.NewDate(2022, 4, 18), "Easter Monday"},
+	}
+
+	for _, test := range tests {
+		h, ok := test.Calendar.Holiday(test.Date)
+		if !ok {
+			t.Errorf("%s: expected %s on %s", test.Calendar.Name, test.Name, test.Date)
+			continue
+		}
+		if h.Name != test.Name {
+			t.Errorf("%s: expected %s on %s, got %s", test.Calendar.Name, test.Name, test.Date, h.Name)
+		}
+	}
+
+	if holidays.US().IsHoliday(chrono.NewDate(2020, 6, 19)) {
+		t.Error("juneteenth was not a federal holiday in 2020")
+	}
+}
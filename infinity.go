@@ -0,0 +1,103 @@
+package chrono
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Postgres' date and timestamp types can hold infinity and -infinity, they
+// are represented by the Min and Max sentinels which Scan and Value convert
+// to and from the text "infinity" and "-infinity". Value only writes them
+// when the current Dialect has Infinity set, other databases reject them.
+
+// PosInfinity returns the DateTime used for infinity, MaxDateTime
+func PosInfinity() DateTime {
+	return MaxDateTime
+}
+
+// NegInfinity returns the DateTime used for -infinity, MinDateTime
+func NegInfinity() DateTime {
+	return MinDateTime
+}
+
+// PosInfinityDate returns the Date used for infinity, MaxDate
+func PosInfinityDate() Date {
+	return MaxDate
+}
+
+// NegInfinityDate returns the Date used for -infinity, MinDate
+func NegInfinityDate() Date {
+	return MinDate
+}
+
+// IsInfinite returns true if d is PosInfinity or NegInfinity, in any location
+func (d DateTime) IsInfinite() bool {
+	return d.infinity() != 0
+}
+
+// IsInfinite returns true if d is PosInfinityDate or NegInfinityDate
+func (d Date) IsInfinite() bool {
+	return d.infinity() != 0
+}
+
+// infinity returns 1 for PosInfinity, -1 for NegInfinity and 0 otherwise
+func (d DateTime) infinity() int {
+	switch {
+	case d.t.Equal(MaxDateTime.t):
+		return 1
+	case d.t.Equal(MinDateTime.t):
+		return -1
+	}
+	return 0
+}
+
+// infinity returns 1 for PosInfinityDate, -1 for NegInfinityDate and 0
+// otherwise
+func (d Date) infinity() int {
+	switch {
+	case d.t.Equal(MaxDate.t):
+		return 1
+	case d.t.Equal(MinDate.t):
+		return -1
+	}
+	return 0
+}
+
+// infiniteDateTime returns PosInfinity or NegInfinity depending on sign
+func infiniteDateTime(sign int) DateTime {
+	if sign > 0 {
+		return PosInfinity()
+	}
+	return NegInfinity()
+}
+
+// infiniteDate returns PosInfinityDate or NegInfinityDate depending on sign
+func infiniteDate(sign int) Date {
+	if sign > 0 {
+		return PosInfinityDate()
+	}
+	return NegInfinityDate()
+}
+
+// sqlInfinity returns 1 for "infinity", -1 for "-infinity" and 0 otherwise
+func sqlInfinity[T byteString](b T) int {
+	switch string(b) {
+	case "infinity":
+		return 1
+	case "-infinity":
+		return -1
+	}
+	return 0
+}
+
+// infinitySQL returns the text for an infinity of sign, or an error wrapping
+// ErrTimestampRange if the current Dialect has no infinity
+func infinitySQL(sign int, kind string) (driver.Value, error) {
+	if dialect := currentDialect(); !dialect.Infinity {
+		return nil, fmt.Errorf("%s infinity in dialect %s: %w", kind, dialect.Name, ErrTimestampRange)
+	}
+	if sign > 0 {
+		return "infinity", nil
+	}
+	return "-infinity", nil
+}
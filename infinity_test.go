@@ -0,0 +1,75 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestInfinitySQL(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		Text string
+		Want chrono.DateTime
+	}{
+		{"infinity", chrono.PosInfinity()},
+		{"-infinity", chrono.NegInfinity()},
+	} {
+		var d chrono.DateTime
+		if err := d.Scan(test.Text); err != nil || !d.Equal(test.Want) || !d.IsInfinite() {
+			t.Errorf("%s: wrong datetime: %v %v", test.Text, d, err)
+		}
+		if err := d.Scan([]byte(test.Text)); err != nil || !d.Equal(test.Want) {
+			t.Errorf("%s: wrong datetime from bytes: %v %v", test.Text, d, err)
+		}
+		if v, err := d.In(time.FixedZone("", 60*60)).Value(); err != nil || v != test.Text {
+			t.Errorf("%s: wrong value: %v %v", test.Text, v, err)
+		}
+
+		var date chrono.Date
+		if err := date.Scan(test.Text); err != nil || !date.IsInfinite() {
+			t.Errorf("%s: wrong date: %v %v", test.Text, date, err)
+		}
+		if err := date.Scan([]byte(test.Text)); err != nil || !date.IsInfinite() {
+			t.Errorf("%s: wrong date from bytes: %v %v", test.Text, date, err)
+		}
+		if v, err := date.Value(); err != nil || v != test.Text {
+			t.Errorf("%s: wrong date value: %v %v", test.Text, v, err)
+		}
+	}
+
+	if !chrono.PosInfinityDate().Equal(chrono.MaxDate) || !chrono.NegInfinityDate().Equal(chrono.MinDate) {
+		t.Error("date infinities should be the limits")
+	}
+	if chrono.NewDateTime(2000, 1, 2, 0, 0, 0, 0, time.UTC).IsInfinite() || chrono.NewDate(2000, 1, 2).IsInfinite() {
+		t.Error("finite values should not be infinite")
+	}
+
+	var d chrono.DateTime
+	if err := d.Scan("Infinity "); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestInfinitySQLDialect(t *testing.T) {
+	// Not parallel, changes package level configuration
+	defer chrono.SetDialect(chrono.CurrentDialect())
+
+	for _, dialect := range []chrono.Dialect{chrono.DialectSQLServer, chrono.DialectStandard} {
+		chrono.SetDialect(dialect)
+		if v, err := chrono.PosInfinity().Value(); !errors.Is(err, chrono.ErrTimestampRange) {
+			t.Errorf("%s: expected an error: %v", dialect.Name, v)
+		}
+		if v, err := chrono.NegInfinityDate().Value(); !errors.Is(err, chrono.ErrTimestampRange) {
+			t.Errorf("%s: expected an error: %v", dialect.Name, v)
+		}
+
+		var d chrono.DateTime
+		if err := d.Scan("infinity"); err != nil || !d.IsInfinite() {
+			t.Errorf("%s: infinity should still scan: %v %v", dialect.Name, d, err)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package chrono
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// Instant is an absolute moment in time stored as nanoseconds since the unix
+// epoch. It has no location, which makes it ideal for things like event logs
+// and message timestamps where the zone is irrelevant.
+//
+// Because it's stored as int64 nanoseconds it can only represent moments
+// between the years 1678 and 2262.
+type Instant struct {
+	nsec int64
+}
+
+// InstantFromNow returns the current moment in time
+func InstantFromNow() Instant {
+	return Instant{nsec: DefaultClock.Now().t.UnixNano()}
+}
+
+// InstantFromUnix creates an instant from a unix timestamp in seconds and
+// nanoseconds.
+func InstantFromUnix(sec int64, nsec int64) Instant {
+	return Instant{nsec: time.Unix(sec, nsec).UnixNano()}
+}
+
+// InstantFromUnixMilli creates an instant from a unix timestamp in
+// milliseconds. Timestamps outside of the years Instant can represent are
+// clamped to the first or last instant, use InstantFromUnixMilliChecked to
+// detect them.
+func InstantFromUnixMilli(msec int64) Instant {
+	i, _ := instantFromUnit(msec, time.Millisecond)
+	return i
+}
+
+// InstantFromUnixMicro creates an instant from a unix timestamp in
+// microseconds. Timestamps outside of the years Instant can represent are
+// clamped like InstantFromUnixMilli.
+func InstantFromUnixMicro(usec int64) Instant {
+	i, _ := instantFromUnit(usec, time.Microsecond)
+	return i
+}
+
+// InstantFromUnixMilliChecked is like InstantFromUnixMilli but returns an
+// error wrapping ErrTimestampRange if msec can't be represented
+func InstantFromUnixMilliChecked(msec int64) (Instant, error) {
+	return instantFromUnit(msec, time.Millisecond)
+}
+
+// InstantFromUnixMicroChecked is like InstantFromUnixMicro but returns an
+// error wrapping ErrTimestampRange if usec can't be represented
+func InstantFromUnixMicroChecked(usec int64) (Instant, error) {
+	return instantFromUnit(usec, time.Microsecond)
+}
+
+// instantFromUnit returns n units since the unix epoch as an instant, if it
+// overflows the instant is clamped and an error returned
+func instantFromUnit(n int64, unit time.Duration) (Instant, error) {
+	switch {
+	case n > math.MaxInt64/int64(unit):
+		return Instant{nsec: math.MaxInt64}, fmt.Errorf("unix time %d in %s: %w", n, unit, ErrTimestampRange)
+	case n < math.MinInt64/int64(unit):
+		return Instant{nsec: math.MinInt64}, fmt.Errorf("unix time %d in %s: %w", n, unit, ErrTimestampRange)
+	}
+	return Instant{nsec: n * int64(unit)}, nil
+}
+
+// InstantFromUnixNano creates an instant from a unix timestamp in nanoseconds.
+func InstantFromUnixNano(nsec int64) Instant {
+	return Instant{nsec: nsec}
+}
+
+// InstantFromDateTime converts a DateTime to an Instant, discarding the
+// location.
+func InstantFromDateTime(d DateTime) Instant {
+	return Instant{nsec: d.t.UnixNano()}
+}
+
+// InstantFromStdTime converts a time.Time to an Instant, discarding the
+// location.
+func InstantFromStdTime(t time.Time) Instant {
+	return Instant{nsec: t.UnixNano()}
+}
+
+// ToDateTime returns the instant as a DateTime in UTC
+func (i Instant) ToDateTime() DateTime {
+	return DateTime{t: time.Unix(0, i.nsec).UTC()}
+}
+
+// ToStdTime returns the instant as a time.Time in UTC
+func (i Instant) ToStdTime() time.Time {
+	return time.Unix(0, i.nsec).UTC()
+}
+
+// In returns the instant as a DateTime in the specified location
+func (i Instant) In(loc *time.Location) DateTime {
+	return DateTime{t: time.Unix(0, i.nsec).In(loc)}
+}
+
+// Add returns the instant i+dur
+func (i Instant) Add(dur time.Duration) Instant {
+	return Instant{nsec: i.nsec + int64(dur)}
+}
+
+// After returns true if i is after rhs
+func (i Instant) After(rhs Instant) bool {
+	return i.nsec > rhs.nsec
+}
+
+// Before returns true if i is before rhs
+func (i Instant) Before(rhs Instant) bool {
+	return i.nsec < rhs.nsec
+}
+
+// Equal returns true if rhs == i
+func (i Instant) Equal(rhs Instant) bool {
+	return i.nsec == rhs.nsec
+}
+
+// GoString implements fmt.GoStringer
+func (i Instant) GoString() string {
+	return fmt.Sprintf("chrono.Instant(%d)", i.nsec)
+}
+
+// IsZero returns true if the Instant is the zero value, which is the unix
+// epoch.
+func (i Instant) IsZero() bool {
+	return i.nsec == 0
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. Is always
+// a width of 64 bits (8 bytes).
+func (i Instant) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, uint64(i.nsec))
+	return buf, nil
+}
+
+// MarshalJSON implements json.Marshaller. The instant is encoded as a number of
+// milliseconds since the unix epoch, sub-millisecond precision is truncated.
+func (i Instant) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, i.UnixMilli(), 10), nil
+}
+
+// MarshalText implements encoding.TextMarshaller
+func (i Instant) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// String returns an RFC3339 timestamp with nanosecond precision in UTC
+func (i Instant) String() string {
+	return i.ToStdTime().Format(time.RFC3339Nano)
+}
+
+// Sub returns the duration between the two instants
+func (i Instant) Sub(u Instant) time.Duration {
+	return time.Duration(i.nsec - u.nsec)
+}
+
+// Truncate rounds i down to a multiple of dur since the unix epoch
+func (i Instant) Truncate(dur time.Duration) Instant {
+	if dur <= 0 {
+		return i
+	}
+	rem := i.nsec % int64(dur)
+	if rem < 0 {
+		rem += int64(dur)
+	}
+	return Instant{nsec: i.nsec - rem}
+}
+
+// Unix timestamp
+func (i Instant) Unix() int64 {
+	return i.ToStdTime().Unix()
+}
+
+// UnixMicro returns a unix timestamp in microseconds
+func (i Instant) UnixMicro() int64 {
+	return i.ToStdTime().UnixMicro()
+}
+
+// UnixMilli returns a unix timestamp in milliseconds
+func (i Instant) UnixMilli() int64 {
+	return i.ToStdTime().UnixMilli()
+}
+
+// UnixNano returns a unix timestamp in nanoseconds
+func (i Instant) UnixNano() int64 {
+	return i.nsec
+}
+
+// UnmarshalBinary
+func (i *Instant) UnmarshalBinary(data []byte) error {
+	if len(data) != 8 {
+		return &ParseError{Op: "unmarshal", Kind: "instant", Input: string(data), Err: errors.New("incorrect number of bytes")}
+	}
+	i.nsec = int64(binary.LittleEndian.Uint64(data))
+	return nil
+}
+
+// UnmarshalJSON parses a number of milliseconds since the unix epoch. It's an
+// error wrapping ErrTimestampRange if it can't be represented.
+func (i *Instant) UnmarshalJSON(data []byte) error {
+	msec, err := strconv.ParseInt(string(data), 10, 64)
+	if err == nil {
+		*i, err = InstantFromUnixMilliChecked(msec)
+	}
+	if err != nil {
+		*i = Instant{}
+		return &ParseError{Op: "unmarshal", Kind: "instant", Input: string(data), Err: err}
+	}
+	return nil
+}
+
+// UnmarshalText parses an RFC3339 timestamp
+func (i *Instant) UnmarshalText(data []byte) error {
+	t, err := time.Parse(time.RFC3339Nano, string(data))
+	if err != nil {
+		return &ParseError{Op: "unmarshal", Kind: "instant", Input: string(data), Layout: time.RFC3339Nano, Err: err}
+	}
+	*i = InstantFromStdTime(t)
+	return nil
+}
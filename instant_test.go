@@ -0,0 +1,140 @@
+package chrono_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestInstantConversions(t *testing.T) {
+	t.Parallel()
+
+	est := time.FixedZone("EST", -5*60*60)
+	dt := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 6, est)
+	ref := chrono.InstantFromDateTime(dt)
+
+	if !ref.ToDateTime().Equal(dt) {
+		t.Error("should be the same moment")
+	}
+	if ref.ToDateTime().Location() != time.UTC {
+		t.Error("should be in utc")
+	}
+	if ref.In(est).Location() != est {
+		t.Error("should be in est")
+	}
+	if !chrono.InstantFromUnixNano(dt.UnixNano()).Equal(ref) {
+		t.Error("should be equal")
+	}
+	if !chrono.InstantFromUnix(dt.Unix(), 6).Equal(ref) {
+		t.Error("should be equal")
+	}
+	if v := chrono.InstantFromUnixMilli(dt.UnixMilli()).UnixMilli(); v != dt.UnixMilli() {
+		t.Error("value wrong", v)
+	}
+	if v := chrono.InstantFromUnixMicro(dt.UnixMicro()).UnixMicro(); v != dt.UnixMicro() {
+		t.Error("value wrong", v)
+	}
+	if s := ref.String(); s != "2000-01-02T08:04:05.000000006Z" {
+		t.Error("string wrong", s)
+	}
+}
+
+func TestInstantArithmetic(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.InstantFromUnix(100, 0)
+	later := ref.Add(time.Second)
+
+	if !later.After(ref) || later.Before(ref) || later.Equal(ref) {
+		t.Error("comparisons wrong")
+	}
+	if d := later.Sub(ref); d != time.Second {
+		t.Error("sub wrong", d)
+	}
+	if tr := chrono.InstantFromUnix(100, 999).Truncate(time.Second); !tr.Equal(ref) {
+		t.Error("truncate wrong", tr)
+	}
+	if tr := chrono.InstantFromUnix(-1, 5).Truncate(time.Second); tr.Unix() != -1 {
+		t.Error("truncate wrong for negative", tr)
+	}
+}
+
+func TestInstantMarshalling(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.InstantFromUnixMilli(946782270123)
+
+	js, err := ref.MarshalJSON()
+	if err != nil {
+		t.Error(err)
+	}
+	if string(js) != "946782270123" {
+		t.Error("value wrong", string(js))
+	}
+	var unjs chrono.Instant
+	if err = unjs.UnmarshalJSON(js); err != nil {
+		t.Error(err)
+	}
+	if !unjs.Equal(ref) {
+		t.Error("value was wrong")
+	}
+
+	bin, err := ref.MarshalBinary()
+	if err != nil {
+		t.Error(err)
+	}
+	if len(bin) != 8 {
+		t.Error("wrong length", len(bin))
+	}
+	var unbin chrono.Instant
+	if err = unbin.UnmarshalBinary(bin); err != nil {
+		t.Error(err)
+	}
+	if !unbin.Equal(ref) {
+		t.Error("value was wrong")
+	}
+
+	txt, err := ref.MarshalText()
+	if err != nil {
+		t.Error(err)
+	}
+	var untxt chrono.Instant
+	if err = untxt.UnmarshalText(txt); err != nil {
+		t.Error(err)
+	}
+	if !untxt.Equal(ref) {
+		t.Error("value was wrong")
+	}
+}
+
+func TestInstantRange(t *testing.T) {
+	t.Parallel()
+
+	if _, err := chrono.InstantFromUnixMilliChecked(math.MaxInt64 / 1000); !errors.Is(err, chrono.ErrTimestampRange) {
+		t.Error("expected range error:", err)
+	}
+	if _, err := chrono.InstantFromUnixMicroChecked(math.MinInt64 / 100); !errors.Is(err, chrono.ErrTimestampRange) {
+		t.Error("expected range error:", err)
+	}
+	if i, err := chrono.InstantFromUnixMilliChecked(946782270123); err != nil || i.UnixMilli() != 946782270123 {
+		t.Error("wrong instant:", i, err)
+	}
+
+	// Clamped rather than wrapping around to the other side of the epoch
+	if i := chrono.InstantFromUnixMilli(math.MaxInt64 / 1000); i.UnixNano() != math.MaxInt64 {
+		t.Error("should clamp to the last instant:", i.UnixNano())
+	}
+	if i := chrono.InstantFromUnixMicro(math.MinInt64 / 100); i.UnixNano() != math.MinInt64 {
+		t.Error("should clamp to the first instant:", i.UnixNano())
+	}
+
+	var i chrono.Instant
+	err := i.UnmarshalJSON([]byte("9300000000000000"))
+	var perr *chrono.ParseError
+	if !errors.As(err, &perr) || !errors.Is(err, chrono.ErrTimestampRange) {
+		t.Error("expected a parse error wrapping the range error:", err)
+	}
+}
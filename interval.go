@@ -0,0 +1,254 @@
+package chrono
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Interval is a half-open range of time [Start, End)
+type Interval struct {
+	Start DateTime
+	End   DateTime
+}
+
+// NewInterval creates an interval
+func NewInterval(start, end DateTime) Interval {
+	return Interval{Start: start, End: end}
+}
+
+// Contains returns true if d is within the interval
+func (i Interval) Contains(d DateTime) bool {
+	return d.AfterOrEqual(i.Start) && d.Before(i.End)
+}
+
+// Duration returns the length of the interval
+func (i Interval) Duration() time.Duration {
+	return i.End.Sub(i.Start)
+}
+
+// Intersect returns the overlapping portion of the two intervals and false if
+// they do not overlap
+func (i Interval) Intersect(o Interval) (Interval, bool) {
+	out := i
+	if o.Start.After(out.Start) {
+		out.Start = o.Start
+	}
+	if o.End.Before(out.End) {
+		out.End = o.End
+	}
+	if out.IsEmpty() {
+		return Interval{}, false
+	}
+	return out, true
+}
+
+// IsEmpty returns true if the interval contains no time
+func (i Interval) IsEmpty() bool {
+	return !i.End.After(i.Start)
+}
+
+// Overlaps returns true if the two intervals share any time
+func (i Interval) Overlaps(o Interval) bool {
+	return i.Start.Before(o.End) && o.Start.Before(i.End)
+}
+
+// Overlaps returns true if the half-open ranges [aStart, aEnd) and [bStart,
+// bEnd) share any time, the same as Interval.Overlaps
+func Overlaps(aStart, aEnd, bStart, bEnd DateTime) bool {
+	return NewInterval(aStart, aEnd).Overlaps(NewInterval(bStart, bEnd))
+}
+
+// String returns the interval in ISO8601 format (start/end)
+func (i Interval) String() string {
+	return i.Start.String() + "/" + i.End.String()
+}
+
+// GoString implements fmt.GoStringer
+func (i Interval) GoString() string {
+	return fmt.Sprintf("chrono.Interval(%s)", i)
+}
+
+// IntervalSet is a set of time made up of sorted, non-overlapping and
+// non-adjacent intervals. It's always kept normalized, adding an interval
+// that overlaps or touches another merges them together.
+//
+// The zero value is an empty set ready to use.
+type IntervalSet struct {
+	intervals []Interval
+}
+
+// NewIntervalSet creates a set from the union of intervals
+func NewIntervalSet(intervals ...Interval) IntervalSet {
+	var s IntervalSet
+	s.Add(intervals...)
+	return s
+}
+
+// Add the intervals to the set, empty intervals are ignored
+func (s *IntervalSet) Add(intervals ...Interval) {
+	// A new slice so that copies of the set don't see the change
+	all := make([]Interval, len(s.intervals), len(s.intervals)+len(intervals))
+	copy(all, s.intervals)
+	for _, i := range intervals {
+		if !i.IsEmpty() {
+			all = append(all, i)
+		}
+	}
+	s.intervals = normalizeIntervals(all)
+}
+
+// Subtract removes the interval's time from the set
+func (s *IntervalSet) Subtract(sub Interval) {
+	if sub.IsEmpty() {
+		return
+	}
+
+	out := make([]Interval, 0, len(s.intervals)+1)
+	for _, i := range s.intervals {
+		if !i.Overlaps(sub) {
+			out = append(out, i)
+			continue
+		}
+		if i.Start.Before(sub.Start) {
+			out = append(out, Interval{Start: i.Start, End: sub.Start})
+		}
+		if i.End.After(sub.End) {
+			out = append(out, Interval{Start: sub.End, End: i.End})
+		}
+	}
+	s.intervals = out
+}
+
+// Intersect returns a new set containing only the time in both sets
+func (s IntervalSet) Intersect(o IntervalSet) IntervalSet {
+	var out []Interval
+	for a, b := 0, 0; a < len(s.intervals) && b < len(o.intervals); {
+		if i, ok := s.intervals[a].Intersect(o.intervals[b]); ok {
+			out = append(out, i)
+		}
+		// Whichever ends first can't overlap anything else in the other set
+		if s.intervals[a].End.Before(o.intervals[b].End) {
+			a++
+		} else {
+			b++
+		}
+	}
+	return IntervalSet{intervals: out}
+}
+
+// Union returns a new set containing the time in either set
+func (s IntervalSet) Union(o IntervalSet) IntervalSet {
+	return NewIntervalSet(append(s.Intervals(), o.intervals...)...)
+}
+
+// Complement returns a new set containing the time within the interval that
+// is not in this set.
+func (s IntervalSet) Complement(within Interval) IntervalSet {
+	out := NewIntervalSet(within)
+	for _, i := range s.intervals {
+		out.Subtract(i)
+	}
+	return out
+}
+
+// Contains returns true if d is in the set
+func (s IntervalSet) Contains(d DateTime) bool {
+	idx := sort.Search(len(s.intervals), func(i int) bool {
+		return s.intervals[i].End.After(d)
+	})
+	return idx < len(s.intervals) && s.intervals[idx].Contains(d)
+}
+
+// Gaps returns the intervals of time between the intervals in the set
+func (s IntervalSet) Gaps() []Interval {
+	if len(s.intervals) < 2 {
+		return nil
+	}
+
+	out := make([]Interval, 0, len(s.intervals)-1)
+	for i := 1; i < len(s.intervals); i++ {
+		out = append(out, Interval{Start: s.intervals[i-1].End, End: s.intervals[i].Start})
+	}
+	return out
+}
+
+// Intervals returns a copy of the intervals in the set in order
+func (s IntervalSet) Intervals() []Interval {
+	out := make([]Interval, len(s.intervals))
+	copy(out, s.intervals)
+	return out
+}
+
+// IsEmpty returns true if the set contains no time
+func (s IntervalSet) IsEmpty() bool {
+	return len(s.intervals) == 0
+}
+
+// TotalDuration returns the sum of the length of all intervals
+func (s IntervalSet) TotalDuration() time.Duration {
+	var total time.Duration
+	for _, i := range s.intervals {
+		total += i.Duration()
+	}
+	return total
+}
+
+// normalizeIntervals sorts and merges overlapping or adjacent intervals into
+// a new slice, intervals is sorted in place
+func normalizeIntervals(intervals []Interval) []Interval {
+	if len(intervals) < 2 {
+		return intervals
+	}
+
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].Start.Before(intervals[j].Start)
+	})
+
+	out := make([]Interval, 1, len(intervals))
+	out[0] = intervals[0]
+	for _, i := range intervals[1:] {
+		last := &out[len(out)-1]
+		if i.Start.After(last.End) {
+			out = append(out, i)
+			continue
+		}
+		if i.End.After(last.End) {
+			last.End = i.End
+		}
+	}
+	return out
+}
+
+// FindFreeSlots returns every slot of length slotLen within window that does
+// not overlap any of the busy intervals. Candidate slots start at
+// window.Start and every step after it, so a step of 30 minutes with a window
+// starting on the hour yields slots on the hour and half hour. If step is not
+// positive slotLen is used.
+func FindFreeSlots(busy []Interval, window Interval, slotLen time.Duration, step time.Duration) []Interval {
+	if slotLen <= 0 {
+		return nil
+	}
+	if step <= 0 {
+		step = slotLen
+	}
+
+	var out []Interval
+	for _, free := range NewIntervalSet(busy...).Complement(window).intervals {
+		// Jump to the first candidate at or after the start of the free time
+		offset := free.Start.Sub(window.Start)
+		steps := offset / step
+		if offset%step != 0 {
+			steps++
+		}
+
+		for start := window.Start.Add(steps * step); ; start = start.Add(step) {
+			end := start.Add(slotLen)
+			if end.After(free.End) {
+				break
+			}
+			out = append(out, Interval{Start: start, End: end})
+		}
+	}
+	return out
+}
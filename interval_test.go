@@ -0,0 +1,159 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+// hours creates an interval between two hours on 2000-01-01 in UTC
+func hours(start, end int) chrono.Interval {
+	return chrono.NewInterval(
+		chrono.NewDateTime(2000, 1, 1, start, 0, 0, 0, time.UTC),
+		chrono.NewDateTime(2000, 1, 1, end, 0, 0, 0, time.UTC),
+	)
+}
+
+func checkIntervals(t *testing.T, got []chrono.Interval, want ...chrono.Interval) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Errorf("want %d intervals, got %d: %v", len(want), len(got), got)
+		return
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) {
+			t.Errorf("interval %d: want %s, got %s", i, want[i], got[i])
+		}
+	}
+}
+
+func TestInterval(t *testing.T) {
+	t.Parallel()
+
+	i := hours(1, 3)
+	if i.Duration() != 2*time.Hour {
+		t.Error("duration wrong")
+	}
+	if !i.Contains(i.Start) || i.Contains(i.End) {
+		t.Error("should be half open")
+	}
+	if !i.Overlaps(hours(2, 4)) || i.Overlaps(hours(3, 4)) {
+		t.Error("overlaps wrong")
+	}
+	if x, ok := i.Intersect(hours(2, 4)); !ok || !x.Start.Equal(hours(2, 3).Start) || !x.End.Equal(hours(2, 3).End) {
+		t.Error("intersect wrong", x)
+	}
+	if _, ok := i.Intersect(hours(3, 4)); ok {
+		t.Error("should not intersect")
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	t.Parallel()
+
+	a, b, c := hours(1, 3), hours(2, 4), hours(3, 4)
+	if !chrono.Overlaps(a.Start, a.End, b.Start, b.End) || !chrono.Overlaps(b.Start, b.End, a.Start, a.End) {
+		t.Error("should overlap")
+	}
+	if chrono.Overlaps(a.Start, a.End, c.Start, c.End) || chrono.Overlaps(c.Start, c.End, a.Start, a.End) {
+		t.Error("touching ranges should not overlap")
+	}
+}
+
+func TestIntervalSetAdd(t *testing.T) {
+	t.Parallel()
+
+	s := chrono.NewIntervalSet(hours(5, 6), hours(1, 2), hours(2, 3), hours(8, 10), hours(9, 11), hours(4, 4))
+	checkIntervals(t, s.Intervals(), hours(1, 3), hours(5, 6), hours(8, 11))
+
+	if s.TotalDuration() != 6*time.Hour {
+		t.Error("total wrong", s.TotalDuration())
+	}
+	checkIntervals(t, s.Gaps(), hours(3, 5), hours(6, 8))
+
+	if !s.Contains(hours(2, 3).Start) || s.Contains(hours(3, 4).Start) || !s.Contains(hours(10, 11).Start) {
+		t.Error("contains wrong")
+	}
+
+	s.Add(hours(0, 12))
+	checkIntervals(t, s.Intervals(), hours(0, 12))
+}
+
+func TestIntervalSetAddCopy(t *testing.T) {
+	t.Parallel()
+
+	// Built from 3 intervals so there is spare capacity after merging
+	s := chrono.NewIntervalSet(hours(1, 3), hours(2, 4), hours(6, 7))
+	c := s
+	c.Add(hours(0, 10))
+	checkIntervals(t, c.Intervals(), hours(0, 10))
+	checkIntervals(t, s.Intervals(), hours(1, 4), hours(6, 7))
+}
+
+func TestIntervalSetSubtract(t *testing.T) {
+	t.Parallel()
+
+	s := chrono.NewIntervalSet(hours(1, 5), hours(6, 10))
+	s.Subtract(hours(2, 3))
+	checkIntervals(t, s.Intervals(), hours(1, 2), hours(3, 5), hours(6, 10))
+
+	s.Subtract(hours(4, 7))
+	checkIntervals(t, s.Intervals(), hours(1, 2), hours(3, 4), hours(7, 10))
+
+	s.Subtract(hours(0, 24))
+	if !s.IsEmpty() {
+		t.Error("should be empty")
+	}
+}
+
+func TestIntervalSetIntersectComplement(t *testing.T) {
+	t.Parallel()
+
+	a := chrono.NewIntervalSet(hours(1, 5), hours(7, 10))
+	b := chrono.NewIntervalSet(hours(0, 2), hours(4, 8), hours(9, 12))
+
+	checkIntervals(t, a.Intersect(b).Intervals(), hours(1, 2), hours(4, 5), hours(7, 8), hours(9, 10))
+	checkIntervals(t, a.Union(b).Intervals(), hours(0, 12))
+	checkIntervals(t, a.Complement(hours(0, 12)).Intervals(), hours(0, 1), hours(5, 7), hours(10, 12))
+	checkIntervals(t, a.Complement(hours(2, 8)).Intervals(), hours(5, 7))
+
+	var empty chrono.IntervalSet
+	checkIntervals(t, empty.Complement(hours(1, 2)).Intervals(), hours(1, 2))
+}
+
+func TestFindFreeSlots(t *testing.T) {
+	t.Parallel()
+
+	at := func(hour, min int) chrono.DateTime {
+		return chrono.NewDateTime(2000, 1, 1, hour, min, 0, 0, time.UTC)
+	}
+	slot := func(h1, m1, h2, m2 int) chrono.Interval {
+		return chrono.NewInterval(at(h1, m1), at(h2, m2))
+	}
+
+	busy := []chrono.Interval{
+		slot(9, 0, 10, 0),
+		slot(10, 15, 11, 0),
+		slot(11, 30, 12, 0),
+	}
+
+	got := chrono.FindFreeSlots(busy, slot(9, 0, 13, 0), time.Hour, 30*time.Minute)
+	checkIntervals(t, got, slot(12, 0, 13, 0))
+
+	got = chrono.FindFreeSlots(busy, slot(9, 0, 13, 0), 30*time.Minute, 15*time.Minute)
+	checkIntervals(t, got,
+		slot(11, 0, 11, 30),
+		slot(12, 0, 12, 30),
+		slot(12, 15, 12, 45),
+		slot(12, 30, 13, 0),
+	)
+
+	got = chrono.FindFreeSlots(nil, slot(9, 0, 10, 0), 30*time.Minute, 0)
+	checkIntervals(t, got, slot(9, 0, 9, 30), slot(9, 30, 10, 0))
+
+	if got = chrono.FindFreeSlots(busy, slot(9, 0, 10, 0), time.Hour, 0); len(got) != 0 {
+		t.Error("should be no free time", got)
+	}
+}
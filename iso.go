@@ -0,0 +1,333 @@
+package chrono
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ParseISO leniently parses the ISO8601 family of date and date time
+// representations. The returned value is a Date if str contained only a date
+// and a DateTime if it also contained a time.
+//
+// Accepted date forms (extended and basic):
+//
+//	2000-01-02  20000102  calendar date
+//	2000-W01-6  2000W016  week date (the weekday may be omitted)
+//	2000-002    2000002   ordinal date
+//
+// A time may follow after a 'T' (or a space) as hh, hh:mm or hh:mm:ss (or the
+// basic forms hhmm and hhmmss) where the last component may carry a decimal
+// fraction using either '.' or ','. An offset of Z, ±hh, ±hh:mm or ±hhmm
+// may follow, when no offset is present the result is in UTC.
+func ParseISO(str string) (any, error) {
+	date, rest, err := parseISODate(str)
+	if err != nil {
+		return nil, &ParseError{Op: "parse", Kind: "iso8601", Input: str, Err: err}
+	}
+	if len(rest) == 0 {
+		return date, nil
+	}
+
+	if rest[0] != 'T' && rest[0] != 't' && rest[0] != ' ' {
+		return nil, &ParseError{Op: "parse", Kind: "iso8601", Input: str, Err: fmt.Errorf("unexpected %q after date", rest)}
+	}
+
+	dt, err := parseISOTime(date, rest[1:])
+	if err != nil {
+		return nil, &ParseError{Op: "parse", Kind: "iso8601", Input: str, Err: err}
+	}
+	return dt, nil
+}
+
+// ParseISODate is like ParseISO but only accepts a date
+func ParseISODate(str string) (Date, error) {
+	date, rest, err := parseISODate(str)
+	if err != nil {
+		return Date{}, &ParseError{Op: "parse", Kind: "iso8601 date", Input: str, Err: err}
+	}
+	if len(rest) != 0 {
+		return Date{}, &ParseError{Op: "parse", Kind: "iso8601 date", Input: str, Err: fmt.Errorf("unexpected %q after date", rest)}
+	}
+	return date, nil
+}
+
+// ParseISODateTime is like ParseISO but always returns a DateTime. When
+// str contains only a date the result is midnight UTC of that date.
+func ParseISODateTime(str string) (DateTime, error) {
+	v, err := ParseISO(str)
+	if err != nil {
+		return DateTime{}, err
+	}
+
+	switch val := v.(type) {
+	case Date:
+		return DateTime{t: val.t}, nil
+	default:
+		return val.(DateTime), nil
+	}
+}
+
+// parseISODate parses the date portion of an ISO8601 string and returns the
+// remaining unparsed portion
+func parseISODate(str string) (Date, string, error) {
+	year, ok := atoiN(str, 4)
+	if !ok {
+		return Date{}, "", errors.New("expected 4 digit year")
+	}
+	str = str[4:]
+
+	extended := len(str) > 0 && str[0] == '-'
+	if extended {
+		str = str[1:]
+	}
+
+	// Week date
+	if len(str) > 0 && str[0] == 'W' {
+		week, ok := atoiN(str[1:], 2)
+		if !ok {
+			return Date{}, "", errors.New("expected 2 digit week")
+		}
+		str = str[3:]
+
+		weekday := 1
+		if len(str) > 0 && (!extended || str[0] == '-') {
+			rest := str
+			if extended {
+				rest = rest[1:]
+			}
+			if wd, ok := atoiN(rest, 1); ok {
+				weekday = wd
+				str = rest[1:]
+			} else if extended {
+				return Date{}, "", errors.New("expected 1 digit weekday")
+			}
+		}
+
+		d, err := isoWeekDate(year, week, weekday)
+		return d, str, err
+	}
+
+	// Ordinal date is exactly 3 digits that aren't followed by a 4th
+	if countDigits(str) == 3 {
+		yday, _ := atoiN(str, 3)
+		if yday < 1 || yday > daysInYear(year) {
+			return Date{}, "", fmt.Errorf("ordinal day %d out of range", yday)
+		}
+		return Date{t: time.Date(year, 1, yday, 0, 0, 0, 0, time.UTC)}, str[3:], nil
+	}
+
+	month, ok := atoiN(str, 2)
+	if !ok {
+		return Date{}, "", errors.New("expected 2 digit month")
+	}
+	str = str[2:]
+	if extended {
+		if len(str) == 0 || str[0] != '-' {
+			return Date{}, "", errors.New("expected '-' after month")
+		}
+		str = str[1:]
+	}
+	day, ok := atoiN(str, 2)
+	if !ok {
+		return Date{}, "", errors.New("expected 2 digit day")
+	}
+	str = str[2:]
+
+	if month < 1 || month > 12 {
+		return Date{}, "", fmt.Errorf("month %d out of range", month)
+	}
+	if day < 1 || day > daysIn(time.Month(month), year) {
+		return Date{}, "", fmt.Errorf("day %d out of range", day)
+	}
+
+	return NewDate(year, time.Month(month), day), str, nil
+}
+
+// parseISOTime parses the time portion of an ISO8601 string and combines it
+// with date.
+func parseISOTime(date Date, str string) (DateTime, error) {
+	var components [3]int
+	var fraction time.Duration
+	n := 0
+
+	for n < 3 {
+		v, ok := atoiN(str, 2)
+		if !ok {
+			if n == 0 {
+				return DateTime{}, errors.New("expected 2 digit hour")
+			}
+			return DateTime{}, errors.New("expected 2 digits")
+		}
+		components[n] = v
+		str = str[2:]
+		n++
+
+		if len(str) > 0 && (str[0] == '.' || str[0] == ',') {
+			var err error
+			if fraction, str, err = parseISOFraction(str[1:], n); err != nil {
+				return DateTime{}, err
+			}
+			break
+		}
+
+		if len(str) > 0 && str[0] == ':' {
+			str = str[1:]
+			continue
+		}
+		if countDigits(str) < 2 {
+			break
+		}
+	}
+
+	hour, min, sec := components[0], components[1], components[2]
+	if hour > 23 {
+		return DateTime{}, fmt.Errorf("hour %d out of range", hour)
+	}
+	if min > 59 {
+		return DateTime{}, fmt.Errorf("minute %d out of range", min)
+	}
+	if sec > 59 {
+		return DateTime{}, fmt.Errorf("second %d out of range", sec)
+	}
+
+	loc, err := parseISOZone(str)
+	if err != nil {
+		return DateTime{}, err
+	}
+
+	y, m, d := date.Date()
+	t := time.Date(y, m, d, hour, min, sec, 0, loc).Add(fraction)
+	return DateTime{t: t}, nil
+}
+
+// parseISOFraction parses the decimal fraction of the nth component (1 for
+// hours, 2 for minutes, 3 for seconds) and returns it as a duration
+func parseISOFraction(str string, n int) (time.Duration, string, error) {
+	digits := countDigits(str)
+	if digits == 0 {
+		return 0, "", errors.New("expected digits after decimal separator")
+	}
+
+	unit := time.Second
+	switch n {
+	case 1:
+		unit = time.Hour
+	case 2:
+		unit = time.Minute
+	}
+
+	var frac time.Duration
+	scale := unit / 10
+	for i := 0; i < digits && scale > 0; i++ {
+		frac += time.Duration(str[i]-'0') * scale
+		scale /= 10
+	}
+	return frac, str[digits:], nil
+}
+
+// parseISOZone parses Z, ±hh, ±hh:mm, ±hhmm. An empty string is UTC.
+func parseISOZone(str string) (*time.Location, error) {
+	if len(str) == 0 {
+		return time.UTC, nil
+	}
+	offset, err := parseISOOffset(str)
+	if err != nil {
+		return nil, err
+	}
+	return offset.Location(), nil
+}
+
+// parseISOOffset parses Z, ±hh, ±hh:mm, ±hhmm
+func parseISOOffset(str string) (Offset, error) {
+	if str == "Z" || str == "z" {
+		return 0, nil
+	}
+
+	sign := 1
+	switch {
+	case len(str) == 0:
+		return 0, errors.New("expected an offset")
+	case str[0] == '+':
+	case str[0] == '-':
+		sign = -1
+	default:
+		return 0, fmt.Errorf("unexpected %q after time", str)
+	}
+	str = str[1:]
+
+	hours, ok := atoiN(str, 2)
+	if !ok {
+		return 0, errors.New("expected 2 digit offset hours")
+	}
+	str = str[2:]
+	var mins int
+	if len(str) > 0 {
+		if str[0] == ':' {
+			str = str[1:]
+		}
+		if mins, ok = atoiN(str, 2); !ok || len(str) != 2 {
+			return 0, errors.New("expected 2 digit offset minutes")
+		}
+	}
+	if hours > 23 || mins > 59 {
+		return 0, errors.New("offset out of range")
+	}
+
+	return Offset(sign * (hours*60*60 + mins*60)), nil
+}
+
+// isoWeekDate returns the date for the ISO8601 year, week and weekday
+// (1 = Monday, 7 = Sunday).
+func isoWeekDate(year, week, weekday int) (Date, error) {
+	if weekday < 1 || weekday > 7 {
+		return Date{}, fmt.Errorf("weekday %d out of range", weekday)
+	}
+	if week < 1 || week > isoWeeksInYear(year) {
+		return Date{}, fmt.Errorf("week %d out of range", week)
+	}
+
+	return NewDateFromISOWeek(year, week, time.Weekday(weekday%7)), nil
+}
+
+// isoWeeksInYear returns 52 or 53, December 28th is always in the last week
+func isoWeeksInYear(year int) int {
+	_, week := time.Date(year, 12, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+// daysIn returns the number of days in the month
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// daysInYear returns 365 or 366
+func daysInYear(year int) int {
+	return time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+// atoiN parses exactly n leading ascii digits from str
+func atoiN(str string, n int) (int, bool) {
+	if len(str) < n {
+		return 0, false
+	}
+	v := 0
+	for i := 0; i < n; i++ {
+		c := str[i]
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		v = v*10 + int(c-'0')
+	}
+	return v, true
+}
+
+// countDigits returns the number of leading ascii digits in str
+func countDigits(str string) int {
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return i
+		}
+	}
+	return len(str)
+}
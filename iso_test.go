@@ -0,0 +1,130 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestParseISODates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   string
+		Want chrono.Date
+	}{
+		{"2000-01-02", chrono.NewDate(2000, 1, 2)},
+		{"20000102", chrono.NewDate(2000, 1, 2)},
+		{"2000-002", chrono.NewDate(2000, 1, 2)},
+		{"2000002", chrono.NewDate(2000, 1, 2)},
+		{"2000-366", chrono.NewDate(2000, 12, 31)},
+		{"2000-W01-6", chrono.NewDate(2000, 1, 8)},
+		{"2000W016", chrono.NewDate(2000, 1, 8)},
+		{"2000-W01", chrono.NewDate(2000, 1, 3)},
+		{"2004-W53-6", chrono.NewDate(2005, 1, 1)},
+		{"2009-W01-1", chrono.NewDate(2008, 12, 29)},
+	}
+
+	for _, test := range tests {
+		v, err := chrono.ParseISO(test.In)
+		if err != nil {
+			t.Error(test.In, err)
+			continue
+		}
+		d, ok := v.(chrono.Date)
+		if !ok {
+			t.Errorf("%s: expected a date, got %T", test.In, v)
+			continue
+		}
+		if !d.Equal(test.Want) {
+			t.Errorf("%s: want %s, got %s", test.In, test.Want, d)
+		}
+	}
+}
+
+func TestParseISODateTimes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   string
+		Want chrono.DateTime
+	}{
+		{"2000-01-02T03:04:05Z", chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{"20000102T030405Z", chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{"2000-01-02T03:04", chrono.NewDateTime(2000, 1, 2, 3, 4, 0, 0, time.UTC)},
+		{"2000-01-02 03:04:05", chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{"2000-01-02T03:04:05,5Z", chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 500000000, time.UTC)},
+		{"2000-01-02T03:04:05.123456789Z", chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 123456789, time.UTC)},
+		{"2000-01-02T03:04.5Z", chrono.NewDateTime(2000, 1, 2, 3, 4, 30, 0, time.UTC)},
+		{"2000-01-02T03,25", chrono.NewDateTime(2000, 1, 2, 3, 15, 0, 0, time.UTC)},
+		{"2000-01-02T03:04:05+01:00", chrono.NewDateTime(2000, 1, 2, 2, 4, 5, 0, time.UTC)},
+		{"20000102T0304-0130", chrono.NewDateTime(2000, 1, 2, 4, 34, 0, 0, time.UTC)},
+		{"2000-W01-6T03:04:05-01", chrono.NewDateTime(2000, 1, 8, 4, 4, 5, 0, time.UTC)},
+		{"2000-002T03:04:05Z", chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		v, err := chrono.ParseISO(test.In)
+		if err != nil {
+			t.Error(test.In, err)
+			continue
+		}
+		dt, ok := v.(chrono.DateTime)
+		if !ok {
+			t.Errorf("%s: expected a datetime, got %T", test.In, v)
+			continue
+		}
+		if !dt.Equal(test.Want) {
+			t.Errorf("%s: want %s, got %s", test.In, test.Want, dt)
+		}
+	}
+}
+
+func TestParseISOErrors(t *testing.T) {
+	t.Parallel()
+
+	bad := []string{
+		"",
+		"200",
+		"2000-13-01",
+		"2001-02-29",
+		"2000-367",
+		"2000-W54-1",
+		"2000-W01-8",
+		"2000-01-02T25:00",
+		"2000-01-02T03:61",
+		"2000-01-02T03:04:05X",
+		"2000-01-02X03:04:05",
+		"2000-01-02T03:04:05.Z",
+	}
+
+	for _, in := range bad {
+		if v, err := chrono.ParseISO(in); err == nil {
+			t.Errorf("%s: expected an error, got %v", in, v)
+		}
+	}
+}
+
+func TestParseISOVariants(t *testing.T) {
+	t.Parallel()
+
+	d, err := chrono.ParseISODate("2000-W01-6")
+	if err != nil {
+		t.Error(err)
+	}
+	if !d.Equal(chrono.NewDate(2000, 1, 8)) {
+		t.Error("value wrong", d)
+	}
+	if _, err = chrono.ParseISODate("2000-01-02T03:04"); err == nil {
+		t.Error("expected an error")
+	}
+
+	dt, err := chrono.ParseISODateTime("2000-01-02")
+	if err != nil {
+		t.Error(err)
+	}
+	if !dt.Equal(chrono.NewDateTime(2000, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Error("value wrong", dt)
+	}
+}
@@ -0,0 +1,105 @@
+package chrono
+
+import (
+	"fmt"
+	"time"
+)
+
+// The longest element each of the JSON array encoders writes, including the
+// quotes and the comma that separates it from the next
+const (
+	maxDateJSONLen     = len(quotedDateLayout) + 1
+	maxTimeJSONLen     = len(quotedTimeLayout) + 1
+	maxDateTimeJSONLen = len(time.RFC3339Nano) + 3
+)
+
+// EncodeJSONArray appends datetimes to buf as a JSON array, producing the
+// same output as json.Marshal but growing buf at most once no matter how many
+// datetimes there are. Like json.Marshal a nil slice is written as null, and
+// it's an error if any year is outside of 0000-9999, in which case buf is
+// returned without anything appended.
+func EncodeJSONArray(datetimes []DateTime, buf []byte) ([]byte, error) {
+	if datetimes == nil {
+		return append(buf, "null"...), nil
+	}
+
+	start := len(buf)
+	buf = growJSONArray(buf, len(datetimes), maxDateTimeJSONLen)
+	buf = append(buf, '[')
+	for i, d := range datetimes {
+		if err := checkJSONArrayYear("EncodeJSONArray", i, d.t); err != nil {
+			return buf[:start], err
+		}
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = append(buf, '"')
+		buf = appendRFC3339Nano(buf, d.t)
+		buf = append(buf, '"')
+	}
+	return append(buf, ']'), nil
+}
+
+// EncodeDateJSONArray is like EncodeJSONArray for dates
+func EncodeDateJSONArray(dates []Date, buf []byte) ([]byte, error) {
+	if dates == nil {
+		return append(buf, "null"...), nil
+	}
+
+	start := len(buf)
+	buf = growJSONArray(buf, len(dates), maxDateJSONLen)
+	buf = append(buf, '[')
+	for i, d := range dates {
+		if err := checkJSONArrayYear("EncodeDateJSONArray", i, d.t); err != nil {
+			return buf[:start], err
+		}
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = append(buf, '"')
+		buf = appendDate(buf, d.t)
+		buf = append(buf, '"')
+	}
+	return append(buf, ']'), nil
+}
+
+// EncodeTimeJSONArray is like EncodeJSONArray for times, since times have no
+// year it can't fail
+func EncodeTimeJSONArray(times []Time, buf []byte) []byte {
+	if times == nil {
+		return append(buf, "null"...)
+	}
+
+	buf = growJSONArray(buf, len(times), maxTimeJSONLen)
+	buf = append(buf, '[')
+	for i, t := range times {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = append(buf, '"')
+		buf = appendTime(buf, t.t)
+		buf = append(buf, '"')
+	}
+	return append(buf, ']')
+}
+
+// checkJSONArrayYear returns an error if the year of the element at index i
+// can't be encoded
+func checkJSONArrayYear(fn string, i int, t time.Time) error {
+	if y := t.Year(); y < 0 || y > 9999 {
+		return fmt.Errorf("%s: element %d: year outside of range [0,9999]", fn, i)
+	}
+	return nil
+}
+
+// growJSONArray makes sure buf has room for an array of n elements that are
+// at most size bytes each
+func growJSONArray(buf []byte, n, size int) []byte {
+	need := len(buf) + n*size + 2
+	if cap(buf) >= need {
+		return buf
+	}
+	grown := make([]byte, len(buf), need)
+	copy(grown, buf)
+	return grown
+}
@@ -0,0 +1,116 @@
+package chrono_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/chronotest"
+)
+
+func TestEncodeJSONArray(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	zones := []*time.Location{time.UTC, time.FixedZone("", -(9*60*60 + 30*60))}
+
+	datetimes := make([]chrono.DateTime, 100)
+	dates := make([]chrono.Date, 100)
+	times := make([]chrono.Time, 100)
+	for i := range datetimes {
+		datetimes[i] = chronotest.RandomDateTime(r).In(zones[i%len(zones)])
+		if i%3 == 0 {
+			datetimes[i] = datetimes[i].Truncate(time.Millisecond)
+		} else if i%5 == 0 {
+			datetimes[i] = datetimes[i].Truncate(time.Second)
+		}
+		dates[i] = chronotest.RandomDate(r)
+		times[i] = chronotest.RandomTime(r)
+	}
+
+	check := func(name string, v any, got []byte) {
+		t.Helper()
+		want, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("%s: want %s, got %s", name, want, got)
+		}
+	}
+
+	encode := func(b []byte, err error) []byte {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	check("datetimes", datetimes, encode(chrono.EncodeJSONArray(datetimes, nil)))
+	check("dates", dates, encode(chrono.EncodeDateJSONArray(dates, nil)))
+	check("times", times, chrono.EncodeTimeJSONArray(times, nil))
+
+	check("nil", []chrono.DateTime(nil), encode(chrono.EncodeJSONArray(nil, nil)))
+	check("empty", []chrono.Date{}, encode(chrono.EncodeDateJSONArray([]chrono.Date{}, nil)))
+
+	if got := chrono.EncodeTimeJSONArray(times[:1], []byte(`{"t":`)); string(got[:5]) != `{"t":` || got[5] != '[' {
+		t.Error("did not append to buf:", string(got))
+	}
+}
+
+func TestEncodeJSONArrayYearRange(t *testing.T) {
+	t.Parallel()
+
+	// Like json.Marshal years that can't be parsed back are an error
+	prefix := []byte(`{"d":`)
+	datetimes := []chrono.DateTime{
+		chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 6, time.UTC),
+		chrono.NewDateTime(10000, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if _, err := json.Marshal(datetimes); err == nil {
+		t.Fatal("json.Marshal should fail")
+	}
+	if got, err := chrono.EncodeJSONArray(datetimes, prefix); err == nil || string(got) != string(prefix) {
+		t.Error("expected an error and buf unchanged:", string(got), err)
+	}
+
+	dates := []chrono.Date{chrono.NewDate(2000, 1, 2), chrono.NewDate(-1, 1, 1)}
+	if _, err := json.Marshal(dates); err == nil {
+		t.Fatal("json.Marshal should fail")
+	}
+	if got, err := chrono.EncodeDateJSONArray(dates, prefix); err == nil || string(got) != string(prefix) {
+		t.Error("expected an error and buf unchanged:", string(got), err)
+	}
+}
+
+func TestEncodeJSONArrayAllocs(t *testing.T) {
+	datetimes := chronotest.Sequence(chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 123456789, time.UTC), time.Second, 1000)
+	allocs := testing.AllocsPerRun(10, func() {
+		_, _ = chrono.EncodeJSONArray(datetimes, nil)
+	})
+	if allocs != 1 {
+		t.Error("expected 1 allocation, got", allocs)
+	}
+}
+
+func BenchmarkEncodeJSONArray(b *testing.B) {
+	datetimes := chronotest.Sequence(chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 123456789, time.UTC), time.Second, 1000)
+	buf := make([]byte, 0, 64*1024)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf, _ = chrono.EncodeJSONArray(datetimes, buf[:0])
+	}
+}
+
+func BenchmarkEncodeJSONArrayMarshal(b *testing.B) {
+	datetimes := chronotest.Sequence(chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 123456789, time.UTC), time.Second, 1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = json.Marshal(datetimes)
+	}
+}
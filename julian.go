@@ -0,0 +1,42 @@
+package chrono
+
+import "math"
+
+const (
+	secondsPerDay = 24 * 60 * 60
+	// unixEpochJulianDay is the Julian Day at 1970-01-01T00:00:00Z
+	unixEpochJulianDay = 2440587.5
+	// unixEpochRataDie is the Rata Die of 1970-01-01, where 0001-01-01 in the
+	// proleptic Gregorian calendar is day 1.
+	unixEpochRataDie = 719163
+)
+
+// DateFromJulianDay converts a Julian Day into a date. Julian Days begin at
+// noon UTC so the fractional portion determines which calendar day it is,
+// eg. 2451545.0 is noon of 2000-01-01 while 2451544.5 is its midnight.
+func DateFromJulianDay(jd float64) Date {
+	return DateFromEpochDays(int(math.Floor(jd - unixEpochJulianDay)))
+}
+
+// DateFromRataDie converts a Rata Die (days since 0000-12-31 in the proleptic
+// Gregorian calendar, making 0001-01-01 day 1) into a date.
+func DateFromRataDie(rd int) Date {
+	return DateFromEpochDays(rd - unixEpochRataDie)
+}
+
+// JulianDay returns the Julian Day at midnight UTC of the date, it will
+// always have a fractional part of .5
+func (d Date) JulianDay() float64 {
+	return float64(d.EpochDays()) + unixEpochJulianDay
+}
+
+// JulianDayNumber returns the integer Julian Day Number of the date, which is
+// the Julian Day at noon UTC.
+func (d Date) JulianDayNumber() int {
+	return d.EpochDays() + int(unixEpochJulianDay+0.5)
+}
+
+// RataDie returns the Rata Die of the date (0001-01-01 is day 1).
+func (d Date) RataDie() int {
+	return d.EpochDays() + unixEpochRataDie
+}
@@ -0,0 +1,61 @@
+package chrono_test
+
+import (
+	"testing"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestJulianDay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Date chrono.Date
+		JD   float64
+		JDN  int
+	}{
+		{chrono.NewDate(2000, 1, 1), 2451544.5, 2451545},
+		{chrono.NewDate(1970, 1, 1), 2440587.5, 2440588},
+		{chrono.NewDate(1858, 11, 17), 2400000.5, 2400001},
+		{chrono.NewDate(-4713, 11, 24), -0.5, 0},
+	}
+
+	for _, test := range tests {
+		if jd := test.Date.JulianDay(); jd != test.JD {
+			t.Errorf("%s: want jd %f, got %f", test.Date, test.JD, jd)
+		}
+		if jdn := test.Date.JulianDayNumber(); jdn != test.JDN {
+			t.Errorf("%s: want jdn %d, got %d", test.Date, test.JDN, jdn)
+		}
+		if d := chrono.DateFromJulianDay(test.JD); !d.Equal(test.Date) {
+			t.Errorf("%f: want %s, got %s", test.JD, test.Date, d)
+		}
+		// Any time before the next midnight is the same date
+		if d := chrono.DateFromJulianDay(test.JD + 0.99); !d.Equal(test.Date) {
+			t.Errorf("%f: want %s, got %s", test.JD+0.99, test.Date, d)
+		}
+	}
+}
+
+func TestRataDie(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Date chrono.Date
+		RD   int
+	}{
+		{chrono.NewDate(1, 1, 1), 1},
+		{chrono.NewDate(0, 12, 31), 0},
+		{chrono.NewDate(1970, 1, 1), 719163},
+		{chrono.NewDate(2000, 1, 1), 730120},
+	}
+
+	for _, test := range tests {
+		if rd := test.Date.RataDie(); rd != test.RD {
+			t.Errorf("%s: want %d, got %d", test.Date, test.RD, rd)
+		}
+		if d := chrono.DateFromRataDie(test.RD); !d.Equal(test.Date) {
+			t.Errorf("%d: want %s, got %s", test.RD, test.Date, d)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package chrono
+
+import "time"
+
+// Key returns the date as the number of days since 1970-01-01, the same as
+// EpochDays, for use as a map key. The value is stable across versions of
+// this package and can be stored.
+func (d Date) Key() int64 {
+	return d.epochDays()
+}
+
+// DateFromKey returns the date for a value returned by Date.Key
+func DateFromKey(key int64) Date {
+	return dateFromEpochDays(key)
+}
+
+// DateTimeKey is a comparable representation of the instant of a DateTime.
+// Two datetimes have the same key exactly when they're Equal, no matter their
+// locations or monotonic clock readings, which isn't the case for == on the
+// DateTimes themselves. It's stable across versions of this package.
+type DateTimeKey struct {
+	// Unix is the number of seconds since 1970-01-01T00:00:00Z
+	Unix int64
+	// Nanos is the nanoseconds within the second, 0-999999999
+	Nanos int32
+}
+
+// Key returns the instant of d for use as a map key or with ==
+func (d DateTime) Key() DateTimeKey {
+	return DateTimeKey{Unix: d.t.Unix(), Nanos: int32(d.t.Nanosecond())}
+}
+
+// DateTime returns the instant of the key in UTC
+func (k DateTimeKey) DateTime() DateTime {
+	return DateTime{t: time.Unix(k.Unix, int64(k.Nanos)).UTC()}
+}
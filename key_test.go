@@ -0,0 +1,55 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestDateKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Date chrono.Date
+		Key  int64
+	}{
+		{chrono.NewDate(1970, 1, 1), 0},
+		{chrono.NewDate(1970, 1, 2), 1},
+		{chrono.NewDate(1969, 12, 31), -1},
+		{chrono.NewDate(2000, 1, 1), 10957},
+	}
+	for _, test := range tests {
+		if got := test.Date.Key(); got != test.Key {
+			t.Errorf("%s: want %d, got %d", test.Date, test.Key, got)
+		}
+		if got := chrono.DateFromKey(test.Key); got != test.Date {
+			t.Errorf("%d: want %s, got %s", test.Key, test.Date, got)
+		}
+	}
+}
+
+func TestDateTimeKey(t *testing.T) {
+	t.Parallel()
+
+	utc := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 6, time.UTC)
+	east := utc.In(time.FixedZone("", 60*60))
+
+	seen := map[chrono.DateTimeKey]int{}
+	seen[utc.Key()]++
+	seen[east.Key()]++
+	seen[chrono.DateTimeFromNow().Key()]++
+	if len(seen) != 2 || seen[utc.Key()] != 2 {
+		t.Error("equal instants should share a key:", seen)
+	}
+	if utc.Key() == utc.Add(time.Nanosecond).Key() {
+		t.Error("different instants should have different keys")
+	}
+
+	before := chrono.NewDateTime(1900, 1, 1, 0, 0, 0, 999999999, time.UTC)
+	for _, d := range []chrono.DateTime{utc, east, before} {
+		if got := d.Key().DateTime(); !got.Equal(d) || got.Location() != time.UTC {
+			t.Errorf("%s: did not round trip %s", d, got)
+		}
+	}
+}
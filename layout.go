@@ -0,0 +1,278 @@
+package chrono
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LayoutTokenKind is the kind of component a LayoutToken formats
+type LayoutTokenKind int
+
+// Layout token kinds
+const (
+	// TokenLiteral is text that is copied as is, eg. "T" or ", "
+	TokenLiteral LayoutTokenKind = iota
+	TokenYear
+	TokenMonth
+	TokenDay
+	TokenYearDay
+	TokenWeekday
+	TokenHour
+	TokenMinute
+	TokenSecond
+	// TokenFraction is fractional seconds, see LayoutToken.Precision
+	TokenFraction
+	// TokenAMPM is the PM or pm marker of a 12 hour clock
+	TokenAMPM
+	// TokenZone is a zone name or offset, see LayoutToken.Zone
+	TokenZone
+)
+
+// String returns a lowercase name for the kind, eg. "year"
+func (l LayoutTokenKind) String() string {
+	switch l {
+	case TokenLiteral:
+		return "literal"
+	case TokenYear:
+		return "year"
+	case TokenMonth:
+		return "month"
+	case TokenDay:
+		return "day"
+	case TokenYearDay:
+		return "year day"
+	case TokenWeekday:
+		return "weekday"
+	case TokenHour:
+		return "hour"
+	case TokenMinute:
+		return "minute"
+	case TokenSecond:
+		return "second"
+	case TokenFraction:
+		return "fraction"
+	case TokenAMPM:
+		return "am/pm"
+	case TokenZone:
+		return "zone"
+	default:
+		return "unknown"
+	}
+}
+
+// ZoneStyle is how a TokenZone is written
+type ZoneStyle int
+
+// Zone styles
+const (
+	// ZoneNone is used for tokens that aren't a zone
+	ZoneNone ZoneStyle = iota
+	// ZoneAbbreviation is a zone abbreviation like MST
+	ZoneAbbreviation
+	// ZoneOffset is a numeric offset like -07:00
+	ZoneOffset
+	// ZoneOffsetZ is a numeric offset that is written as Z for UTC, eg. Z07:00
+	ZoneOffsetZ
+)
+
+// LayoutToken is a single component of a time.Format layout
+type LayoutToken struct {
+	Kind LayoutTokenKind
+	// Text is the part of the layout the token was made from, eg. "Jan"
+	Text string
+	// Precision is the number of fractional second digits of a TokenFraction
+	Precision int
+	// TrimZeros is true for a TokenFraction whose trailing zeros are removed
+	// (the .999 form rather than .000)
+	TrimZeros bool
+	// Zone is the style of a TokenZone
+	Zone ZoneStyle
+}
+
+// LayoutDescription is a time.Format layout broken into its tokens
+type LayoutDescription struct {
+	Tokens []LayoutToken
+}
+
+// DescribeLayout breaks a time.Format layout into its tokens the same way the
+// time package interprets it, eg. "2006-01-02" is a year, "-", a month, "-"
+// and a day. It can be used to validate or explain user supplied layouts.
+func DescribeLayout(layout string) LayoutDescription {
+	var desc LayoutDescription
+	for len(layout) > 0 {
+		prefix, tok, rest := nextLayoutToken(layout)
+		if len(prefix) > 0 {
+			desc.addLiteral(prefix)
+		}
+		if len(tok.Text) > 0 {
+			desc.Tokens = append(desc.Tokens, tok)
+		}
+		layout = rest
+	}
+	return desc
+}
+
+func (l *LayoutDescription) addLiteral(text string) {
+	if n := len(l.Tokens); n > 0 && l.Tokens[n-1].Kind == TokenLiteral {
+		l.Tokens[n-1].Text += text
+		return
+	}
+	l.Tokens = append(l.Tokens, LayoutToken{Kind: TokenLiteral, Text: text})
+}
+
+// Has reports whether the layout contains any of the kinds of tokens
+func (l LayoutDescription) Has(kinds ...LayoutTokenKind) bool {
+	for _, tok := range l.Tokens {
+		for _, k := range kinds {
+			if tok.Kind == k {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasDate reports whether the layout formats any part of a date
+func (l LayoutDescription) HasDate() bool {
+	return l.Has(TokenYear, TokenMonth, TokenDay, TokenYearDay, TokenWeekday)
+}
+
+// HasClock reports whether the layout formats any part of a time of day
+func (l LayoutDescription) HasClock() bool {
+	return l.Has(TokenHour, TokenMinute, TokenSecond, TokenFraction, TokenAMPM)
+}
+
+// HasZone reports whether the layout formats a zone
+func (l LayoutDescription) HasZone() bool {
+	return l.Has(TokenZone)
+}
+
+// LayoutError is returned by SafeFormat when a layout contains a token the
+// type being formatted doesn't have, eg. an hour when formatting a Date.
+type LayoutError struct {
+	Layout string
+	// Type is the type being formatted, date or time
+	Type  string
+	Token LayoutToken
+}
+
+// Error implements error
+func (l *LayoutError) Error() string {
+	return fmt.Sprintf("layout %q formats %s (%q) which a %s does not have", l.Layout, l.Token.Kind, l.Token.Text, l.Type)
+}
+
+// checkLayout returns a *LayoutError for the first token in layout that is
+// one of the disallowed kinds
+func checkLayout(layout, typ string, disallowed ...LayoutTokenKind) error {
+	for _, tok := range DescribeLayout(layout).Tokens {
+		for _, k := range disallowed {
+			if tok.Kind == k {
+				return &LayoutError{Layout: layout, Type: typ, Token: tok}
+			}
+		}
+	}
+	return nil
+}
+
+// nextLayoutToken mirrors the time package's tokenizer, it returns the
+// literal text before the next token, the token and the rest of the layout.
+// If there is no token left tok.Text is empty.
+func nextLayoutToken(layout string) (prefix string, tok LayoutToken, rest string) {
+	for i := 0; i < len(layout); i++ {
+		tail := layout[i:]
+		token := func(kind LayoutTokenKind, n int) (string, LayoutToken, string) {
+			return layout[:i], LayoutToken{Kind: kind, Text: tail[:n]}, tail[n:]
+		}
+
+		switch c := layout[i]; c {
+		case 'J':
+			if strings.HasPrefix(tail, "January") {
+				return token(TokenMonth, 7)
+			}
+			if strings.HasPrefix(tail, "Jan") {
+				return token(TokenMonth, 3)
+			}
+		case 'M':
+			if strings.HasPrefix(tail, "Monday") {
+				return token(TokenWeekday, 6)
+			}
+			if strings.HasPrefix(tail, "Mon") {
+				return token(TokenWeekday, 3)
+			}
+			if strings.HasPrefix(tail, "MST") {
+				prefix, tok, rest := token(TokenZone, 3)
+				tok.Zone = ZoneAbbreviation
+				return prefix, tok, rest
+			}
+		case '0':
+			if len(tail) >= 2 && '1' <= tail[1] && tail[1] <= '6' {
+				return token([...]LayoutTokenKind{TokenMonth, TokenDay, TokenHour, TokenMinute, TokenSecond, TokenYear}[tail[1]-'1'], 2)
+			}
+			if strings.HasPrefix(tail, "002") {
+				return token(TokenYearDay, 3)
+			}
+		case '1':
+			if strings.HasPrefix(tail, "15") {
+				return token(TokenHour, 2)
+			}
+			return token(TokenMonth, 1)
+		case '2':
+			if strings.HasPrefix(tail, "2006") {
+				return token(TokenYear, 4)
+			}
+			return token(TokenDay, 1)
+		case '_':
+			if strings.HasPrefix(tail, "_2006") {
+				// A literal _ followed by the year
+				return layout[:i+1], LayoutToken{Kind: TokenYear, Text: "2006"}, tail[5:]
+			}
+			if strings.HasPrefix(tail, "_2") {
+				return token(TokenDay, 2)
+			}
+			if strings.HasPrefix(tail, "__2") {
+				return token(TokenYearDay, 3)
+			}
+		case '3':
+			return token(TokenHour, 1)
+		case '4':
+			return token(TokenMinute, 1)
+		case '5':
+			return token(TokenSecond, 1)
+		case 'P':
+			if strings.HasPrefix(tail, "PM") {
+				return token(TokenAMPM, 2)
+			}
+		case 'p':
+			if strings.HasPrefix(tail, "pm") {
+				return token(TokenAMPM, 2)
+			}
+		case '-', 'Z':
+			style := ZoneOffset
+			if c == 'Z' {
+				style = ZoneOffsetZ
+			}
+			for _, std := range [...]string{"070000", "07:00:00", "0700", "07:00", "07"} {
+				if strings.HasPrefix(tail[1:], std) {
+					prefix, tok, rest := token(TokenZone, len(std)+1)
+					tok.Zone = style
+					return prefix, tok, rest
+				}
+			}
+		case '.', ',':
+			if len(tail) > 1 && (tail[1] == '0' || tail[1] == '9') {
+				j := 1
+				for j < len(tail) && tail[j] == tail[1] {
+					j++
+				}
+				// Only a fraction if the run of digits ends here
+				if j == len(tail) || tail[j] < '0' || tail[j] > '9' {
+					prefix, tok, rest := token(TokenFraction, j)
+					tok.Precision = j - 1
+					tok.TrimZeros = tail[1] == '9'
+					return prefix, tok, rest
+				}
+			}
+		}
+	}
+	return layout, LayoutToken{}, ""
+}
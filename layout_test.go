@@ -0,0 +1,80 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestDescribeLayout(t *testing.T) {
+	t.Parallel()
+
+	desc := chrono.DescribeLayout(time.RFC3339Nano)
+	want := []chrono.LayoutToken{
+		{Kind: chrono.TokenYear, Text: "2006"},
+		{Kind: chrono.TokenLiteral, Text: "-"},
+		{Kind: chrono.TokenMonth, Text: "01"},
+		{Kind: chrono.TokenLiteral, Text: "-"},
+		{Kind: chrono.TokenDay, Text: "02"},
+		{Kind: chrono.TokenLiteral, Text: "T"},
+		{Kind: chrono.TokenHour, Text: "15"},
+		{Kind: chrono.TokenLiteral, Text: ":"},
+		{Kind: chrono.TokenMinute, Text: "04"},
+		{Kind: chrono.TokenLiteral, Text: ":"},
+		{Kind: chrono.TokenSecond, Text: "05"},
+		{Kind: chrono.TokenFraction, Text: ".999999999", Precision: 9, TrimZeros: true},
+		{Kind: chrono.TokenZone, Text: "Z07:00", Zone: chrono.ZoneOffsetZ},
+	}
+	if len(desc.Tokens) != len(want) {
+		t.Fatalf("want %d tokens, got %d: %#v", len(want), len(desc.Tokens), desc.Tokens)
+	}
+	for i, tok := range desc.Tokens {
+		if tok != want[i] {
+			t.Errorf("%d: want %#v, got %#v", i, want[i], tok)
+		}
+	}
+	if !desc.HasDate() || !desc.HasClock() || !desc.HasZone() {
+		t.Error("should have a date, clock and zone")
+	}
+
+	kinds := func(layout string) string {
+		var s string
+		for _, tok := range chrono.DescribeLayout(layout).Tokens {
+			if len(s) > 0 {
+				s += " "
+			}
+			s += tok.Kind.String()
+		}
+		return s
+	}
+	tests := []struct {
+		Layout string
+		Want   string
+	}{
+		{time.RFC1123, "weekday literal day literal month literal year literal hour literal minute literal second literal zone"},
+		{"Monday January _2 3:04PM", "weekday literal month literal day literal hour literal minute am/pm"},
+		{"_2006 __2 002", "literal year literal year day literal year day"},
+		{"05.000 -0700", "second fraction literal zone"},
+		{"05,00 1.01", "second fraction literal month literal month"},
+		{"version 1.99999", "literal month fraction"},
+		{"Mond", "weekday literal"},
+		{"T", "literal"},
+		{"", ""},
+	}
+	for _, test := range tests {
+		if got := kinds(test.Layout); got != test.Want {
+			t.Errorf("%q: want %q, got %q", test.Layout, test.Want, got)
+		}
+	}
+
+	if d := chrono.DescribeLayout("Jan 2"); !d.HasDate() || d.HasClock() || d.HasZone() {
+		t.Error("expected only a date")
+	}
+	if d := chrono.DescribeLayout("3pm MST"); d.HasDate() || !d.HasClock() || !d.HasZone() {
+		t.Error("expected a clock and zone")
+	}
+	if d := chrono.DescribeLayout("-07"); d.Tokens[0].Zone != chrono.ZoneOffset {
+		t.Error("expected a numeric offset")
+	}
+}
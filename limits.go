@@ -0,0 +1,45 @@
+package chrono
+
+import "time"
+
+// The earliest and latest values, eg. for "no expiry". They span the years
+// -999999999 to 999999999 like java.time does, which is well inside the range
+// of time.Time so that arithmetic near them doesn't overflow. They can't be
+// marshalled to formats limited to 4 digit years such as RFC3339, JSON and
+// the binary and gob encodings of Date return an error for them.
+var (
+	MinDate     = NewDate(-999999999, time.January, 1)
+	MaxDate     = NewDate(999999999, time.December, 31)
+	MinDateTime = DateTime{t: MinDate.t}
+	MaxDateTime = DateTime{t: MaxDate.t.Add(24*time.Hour - time.Nanosecond)}
+
+	// The dates closest to the limits that aren't used for infinity
+	minFiniteDate = MinDate.AddDate(0, 0, 1)
+	maxFiniteDate = MaxDate.AddDate(0, 0, -1)
+)
+
+// AddSaturating is like Add but the result is clamped to MinDateTime and
+// MaxDateTime, in d's location, instead of going past them
+func (d DateTime) AddSaturating(dur time.Duration) DateTime {
+	t := d.t.Add(dur)
+	switch {
+	case t.After(MaxDateTime.t):
+		return DateTime{t: MaxDateTime.t.In(d.t.Location())}
+	case t.Before(MinDateTime.t):
+		return DateTime{t: MinDateTime.t.In(d.t.Location())}
+	}
+	return DateTime{t: t}
+}
+
+// AddDaysSaturating adds days to d, the result is clamped to MinDate and
+// MaxDate instead of going past them
+func (d Date) AddDaysSaturating(days int) Date {
+	cur := d.epochDays()
+	switch {
+	case int64(days) > MaxDate.epochDays()-cur:
+		return MaxDate
+	case int64(days) < MinDate.epochDays()-cur:
+		return MinDate
+	}
+	return dateFromEpochDays(cur + int64(days))
+}
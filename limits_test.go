@@ -0,0 +1,143 @@
+package chrono_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestLimits(t *testing.T) {
+	t.Parallel()
+
+	if got := chrono.MaxDate.String(); got != "999999999-12-31" {
+		t.Error("wrong max date:", got)
+	}
+	if got := chrono.MinDate.String(); got != "-999999999-01-01" {
+		t.Error("wrong min date:", got)
+	}
+	if !chrono.MaxDateTime.ToDate().Equal(chrono.MaxDate) || !chrono.MinDateTime.ToDate().Equal(chrono.MinDate) {
+		t.Error("datetime limits should be on the date limits")
+	}
+	if got := chrono.MaxDateTime.Add(time.Nanosecond).ToDate(); !got.Equal(chrono.NewDate(1000000000, 1, 1)) {
+		t.Error("max datetime should be the last instant of max date:", got)
+	}
+}
+
+func TestLimitsMarshal(t *testing.T) {
+	t.Parallel()
+
+	for _, d := range []chrono.Date{chrono.MinDate, chrono.MaxDate, chrono.NewDate(10000, 1, 1), chrono.NewDate(-1, 12, 31)} {
+		if _, err := d.MarshalBinary(); err == nil {
+			t.Error(d, "binary should fail")
+		}
+		if _, err := d.MarshalJSON(); err == nil {
+			t.Error(d, "json should fail")
+		}
+		if _, err := d.MarshalText(); err == nil {
+			t.Error(d, "text should fail")
+		}
+		if err := gob.NewEncoder(new(bytes.Buffer)).Encode(d); err == nil {
+			t.Error(d, "gob should fail")
+		}
+	}
+	for _, d := range []chrono.DateTime{chrono.MinDateTime, chrono.MaxDateTime} {
+		if _, err := json.Marshal(d); err == nil {
+			t.Error(d, "json should fail")
+		}
+	}
+
+	// The edges of the range still round trip
+	for _, d := range []chrono.Date{chrono.NewDate(0, 1, 1), chrono.NewDate(9999, 12, 31)} {
+		b, err := d.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var bin chrono.Date
+		if err := bin.UnmarshalBinary(b); err != nil || bin != d {
+			t.Error("binary round trip failed:", d, bin, err)
+		}
+
+		b, err = json.Marshal(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var js chrono.Date
+		if err := json.Unmarshal(b, &js); err != nil || js != d {
+			t.Error("json round trip failed:", d, js, err)
+		}
+
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(d); err != nil {
+			t.Fatal(err)
+		}
+		var gd chrono.Date
+		if err := gob.NewDecoder(&buf).Decode(&gd); err != nil || gd != d {
+			t.Error("gob round trip failed:", d, gd, err)
+		}
+	}
+}
+
+func TestDateTimeAddSaturating(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("", 60*60)
+	near := chrono.MaxDateTime.Add(-time.Hour).In(loc)
+	if got := near.AddSaturating(math.MaxInt64); !got.Equal(chrono.MaxDateTime) || got.Location() != loc {
+		t.Error("should clamp to max:", got)
+	}
+	if got := chrono.MinDateTime.AddSaturating(-time.Nanosecond); !got.Equal(chrono.MinDateTime) {
+		t.Error("should clamp to min:", got)
+	}
+
+	d := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	if got := d.AddSaturating(time.Hour); !got.Equal(d.Add(time.Hour)) {
+		t.Error("should add normally:", got)
+	}
+}
+
+func TestDateAddDaysSaturating(t *testing.T) {
+	t.Parallel()
+
+	d := chrono.NewDate(2000, 1, 2)
+	if got := d.AddDaysSaturating(30); !got.Equal(chrono.NewDate(2000, 2, 1)) {
+		t.Error("should add normally:", got)
+	}
+	if got := chrono.MaxDate.AddDaysSaturating(1); !got.Equal(chrono.MaxDate) {
+		t.Error("should clamp to max:", got)
+	}
+	if got := chrono.MinDate.AddDaysSaturating(-1); !got.Equal(chrono.MinDate) {
+		t.Error("should clamp to min:", got)
+	}
+	if got := chrono.MaxDate.AddDaysSaturating(-1); !got.Equal(chrono.NewDate(999999999, 12, 30)) {
+		t.Error("should move away from max:", got)
+	}
+	if got := chrono.MinDate.AddDaysSaturating(1); !got.Equal(chrono.NewDate(-999999999, 1, 2)) {
+		t.Error("should move away from min:", got)
+	}
+
+	// An int of days only reaches the limits on 64 bit platforms
+	if strconv.IntSize == 64 {
+		if got := d.AddDaysSaturating(math.MaxInt); !got.Equal(chrono.MaxDate) {
+			t.Error("should clamp to max:", got)
+		}
+		if got := d.AddDaysSaturating(math.MinInt); !got.Equal(chrono.MinDate) {
+			t.Error("should clamp to min:", got)
+		}
+	}
+
+	// The limits have more days than a 32 bit int but their keys don't wrap
+	for _, d := range []chrono.Date{chrono.MinDate, chrono.MaxDate} {
+		if got := chrono.DateFromKey(d.Key()); got != d {
+			t.Error("key should round trip:", d, got)
+		}
+	}
+	if chrono.MaxDate.Key() <= 0 || chrono.MinDate.Key() >= 0 {
+		t.Error("keys should not wrap:", chrono.MinDate.Key(), chrono.MaxDate.Key())
+	}
+}
@@ -0,0 +1,354 @@
+package chrono
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+const (
+	localDateTimeLayout       = "2006-01-02T15:04:05.999999999"
+	quotedLocalDateTimeLayout = `"` + localDateTimeLayout + `"`
+	// LocalDateTimeSQLLayout is exported so you can change this for your
+	// project but the default should be sufficient. It uses microsecond
+	// precision to align with postgres/mysql and has no offset since it's
+	// intended for columns like DATETIME or TIMESTAMP WITHOUT TIME ZONE.
+	LocalDateTimeSQLLayout = "2006-01-02 15:04:05.999999"
+)
+
+// LocalDateTime is a date and a time with no location or offset. It represents
+// a wall-clock reading (eg. what's stored in a DATETIME column) rather than
+// a moment in time, and so it can only become a DateTime once a location has
+// been attached to it with AtZone.
+type LocalDateTime struct {
+	// t is always stored in UTC, but the location is meaningless
+	t time.Time
+}
+
+// NewLocalDateTime from all components
+func NewLocalDateTime(year int, month time.Month, day, hour, min, sec, nsec int) LocalDateTime {
+	return LocalDateTime{t: time.Date(year, month, day, hour, min, sec, nsec, time.UTC)}
+}
+
+// LocalDateTimeFrom takes the wall-clock reading of the DateTime in its
+// current location, discarding the location itself.
+func LocalDateTimeFrom(d DateTime) LocalDateTime {
+	return LocalDateTimeFromStdTime(d.t)
+}
+
+// LocalDateTimeFromString parses an ISO8601 local date time
+// (2006-01-02T15:04:05 with optional fractional seconds).
+func LocalDateTimeFromString(str string) (LocalDateTime, error) {
+	t, err := time.Parse(localDateTimeLayout, str)
+	if err != nil {
+		return LocalDateTime{}, &ParseError{Op: "parse", Kind: "local datetime", Input: str, Layout: localDateTimeLayout, Err: err}
+	}
+
+	return LocalDateTime{t: t}, nil
+}
+
+// LocalDateTimeFromLayout parses a local date time by layout. Any offset
+// information parsed from the string is discarded.
+func LocalDateTimeFromLayout(layout, str string) (LocalDateTime, error) {
+	t, err := time.Parse(layout, str)
+	if err != nil {
+		return LocalDateTime{}, &ParseError{Op: "parse", Kind: "local datetime", Input: str, Layout: layout, Err: err}
+	}
+
+	return LocalDateTimeFromStdTime(t), nil
+}
+
+// LocalDateTimeFromStdTime takes the wall-clock reading of a time.Time,
+// discarding its location.
+func LocalDateTimeFromStdTime(t time.Time) LocalDateTime {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	return NewLocalDateTime(year, month, day, hour, min, sec, t.Nanosecond())
+}
+
+// AtZone attaches a location to the wall-clock reading to produce a DateTime.
+// The same caveats as time.Date apply for readings that are skipped or
+// repeated by a DST transition in loc.
+func (l LocalDateTime) AtZone(loc *time.Location) DateTime {
+	year, month, day := l.t.Date()
+	hour, min, sec := l.t.Clock()
+	return NewDateTime(year, month, day, hour, min, sec, l.t.Nanosecond(), loc)
+}
+
+// ToDate discards the time component
+func (l LocalDateTime) ToDate() Date {
+	return DateFromStdTime(l.t)
+}
+
+// ToTime discards the date component, the resulting Time is in UTC.
+func (l LocalDateTime) ToTime() Time {
+	return TimeFromStdTime(l.t)
+}
+
+// Add returns the local date time l+dur. Since there is no location, days are
+// always exactly 24 hours long.
+func (l LocalDateTime) Add(dur time.Duration) LocalDateTime {
+	return LocalDateTime{t: l.t.Add(dur)}
+}
+
+// AddDate to l and return
+func (l LocalDateTime) AddDate(years int, months int, days int) LocalDateTime {
+	return LocalDateTime{t: l.t.AddDate(years, months, days)}
+}
+
+// After returns true if l is after rhs
+func (l LocalDateTime) After(rhs LocalDateTime) bool {
+	return l.t.After(rhs.t)
+}
+
+// AfterOrEqual returns true if l is equal to or after rhs
+func (l LocalDateTime) AfterOrEqual(rhs LocalDateTime) bool {
+	return l.t.After(rhs.t) || l.t.Equal(rhs.t)
+}
+
+// AppendFormat is like Format but appends the textual representation to b and
+// returns the extended buffer. Zone information in the layout will always
+// be rendered as UTC.
+func (l LocalDateTime) AppendFormat(b []byte, layout string) []byte {
+	return l.t.AppendFormat(b, layout)
+}
+
+// Before returns true if l is before rhs
+func (l LocalDateTime) Before(rhs LocalDateTime) bool {
+	return l.t.Before(rhs.t)
+}
+
+// BeforeOrEqual returns true if l is equal to or before rhs
+func (l LocalDateTime) BeforeOrEqual(rhs LocalDateTime) bool {
+	return l.t.Before(rhs.t) || l.t.Equal(rhs.t)
+}
+
+// Between returns true if l is in the exclusive time range (start, end)
+func (l LocalDateTime) Between(start, end LocalDateTime) bool {
+	return l.t.After(start.t) && l.t.Before(end.t)
+}
+
+// BetweenBounds returns true if l is in the time range from start to end
+// including or excluding each of them according to bounds, eg.
+// BoundsClosedOpen for the half-open range [start, end).
+func (l LocalDateTime) BetweenBounds(start, end LocalDateTime, bounds BoundType) bool {
+	return inBounds(l.t, start.t, end.t, bounds)
+}
+
+// BetweenOrEqual returns true if l is in the inclusive time range [start, end]
+func (l LocalDateTime) BetweenOrEqual(start, end LocalDateTime) bool {
+	return l.AfterOrEqual(start) && l.BeforeOrEqual(end)
+}
+
+// Clock returns the time components
+func (l LocalDateTime) Clock() (hour, min, sec int) {
+	return l.t.Clock()
+}
+
+// Date returns the date components
+func (l LocalDateTime) Date() (year int, month time.Month, day int) {
+	return l.t.Date()
+}
+
+// Day returns the day of the month
+func (l LocalDateTime) Day() int {
+	return l.t.Day()
+}
+
+// Equal returns true if rhs == l
+func (l LocalDateTime) Equal(rhs LocalDateTime) bool {
+	return l.t.Equal(rhs.t)
+}
+
+// Format using a layout string from time.Time. Zone information in the layout
+// will always be rendered as UTC so caution must be used.
+func (l LocalDateTime) Format(layout string) string {
+	return l.t.Format(layout)
+}
+
+// GoString implements fmt.GoStringer
+func (l LocalDateTime) GoString() string {
+	y, m, day := l.t.Date()
+	hr, min, sec := l.t.Clock()
+	nsec := l.t.Nanosecond()
+	return fmt.Sprintf("chrono.LocalDateTime(%d, %s, %d, %d, %d, %d, %d)", y, m, day, hr, min, sec, nsec)
+}
+
+// Hour returns the hour
+func (l LocalDateTime) Hour() int {
+	return l.t.Hour()
+}
+
+// IsAfter returns true if l is after rhs, the same as After
+func (l LocalDateTime) IsAfter(rhs LocalDateTime) bool {
+	return l.After(rhs)
+}
+
+// IsBefore returns true if l is before rhs, the same as Before
+func (l LocalDateTime) IsBefore(rhs LocalDateTime) bool {
+	return l.Before(rhs)
+}
+
+// IsOnOrAfter returns true if l is equal to or after rhs, the same as
+// AfterOrEqual
+func (l LocalDateTime) IsOnOrAfter(rhs LocalDateTime) bool {
+	return l.AfterOrEqual(rhs)
+}
+
+// IsOnOrBefore returns true if l is equal to or before rhs, the same as
+// BeforeOrEqual
+func (l LocalDateTime) IsOnOrBefore(rhs LocalDateTime) bool {
+	return l.BeforeOrEqual(rhs)
+}
+
+// IsZero returns true if the LocalDateTime is the zero value.
+func (l LocalDateTime) IsZero() bool {
+	return l.t.IsZero()
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (l LocalDateTime) MarshalBinary() ([]byte, error) {
+	return l.t.MarshalBinary()
+}
+
+// MarshalJSON implements json.Marshaller
+func (l LocalDateTime) MarshalJSON() ([]byte, error) {
+	return []byte(l.t.Format(quotedLocalDateTimeLayout)), nil
+}
+
+// MarshalText implements encoding.TextMarshaller
+func (l LocalDateTime) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// Minute returns the minute of the hour
+func (l LocalDateTime) Minute() int {
+	return l.t.Minute()
+}
+
+// Month returns the month
+func (l LocalDateTime) Month() time.Month {
+	return l.t.Month()
+}
+
+// Nanosecond returns the nanosecond offset
+func (l LocalDateTime) Nanosecond() int {
+	return l.t.Nanosecond()
+}
+
+// Second returns the second of the minute
+func (l LocalDateTime) Second() int {
+	return l.t.Second()
+}
+
+// String returns an ISO8601 local date time (no offset)
+func (l LocalDateTime) String() string {
+	return l.t.Format(localDateTimeLayout)
+}
+
+// Sub returns the duration between the two wall-clock readings
+func (l LocalDateTime) Sub(u LocalDateTime) time.Duration {
+	return l.t.Sub(u.t)
+}
+
+// UnmarshalBinary
+func (l *LocalDateTime) UnmarshalBinary(data []byte) error {
+	var t time.Time
+	if err := t.UnmarshalBinary(data); err != nil {
+		return &ParseError{Op: "unmarshal", Kind: "local datetime", Input: string(data), Err: err}
+	}
+	*l = LocalDateTimeFromStdTime(t)
+	return nil
+}
+
+// UnmarshalJSON parses a quoted ISO8601 local date time
+func (l *LocalDateTime) UnmarshalJSON(data []byte) error {
+	t, err := time.Parse(quotedLocalDateTimeLayout, string(data))
+	if err != nil {
+		return &ParseError{Op: "unmarshal", Kind: "local datetime", Input: string(data), Layout: quotedLocalDateTimeLayout, Err: err}
+	}
+	l.t = t
+	return nil
+}
+
+// UnmarshalText parses a byte string with an ISO8601 local date time
+func (l *LocalDateTime) UnmarshalText(data []byte) error {
+	t, err := time.Parse(localDateTimeLayout, string(data))
+	if err != nil {
+		return &ParseError{Op: "unmarshal", Kind: "local datetime", Input: string(data), Layout: localDateTimeLayout, Err: err}
+	}
+	l.t = t
+	return nil
+}
+
+// Weekday returns the day of the week
+func (l LocalDateTime) Weekday() time.Weekday {
+	return l.t.Weekday()
+}
+
+// Year returns the year
+func (l LocalDateTime) Year() int {
+	return l.t.Year()
+}
+
+// YearDay returns the day of the year
+func (l LocalDateTime) YearDay() int {
+	return l.t.YearDay()
+}
+
+// Value implements driver.Valuer. SQL requires the use of ISO8601, the
+// current Dialect's LocalDateTimeLayout is used.
+func (l LocalDateTime) Value() (driver.Value, error) {
+	return l.t.Format(currentDialect().LocalDateTimeLayout), nil
+}
+
+// Scan implements sql.Scanner. SQL requires the use of ISO8601 but the
+// current Dialect's LocalDateTimeScanLayouts are also accepted.
+//
+// When scanning a time.Time the wall-clock reading is kept and the location
+// is discarded. Drivers like mysql return DATETIME columns in UTC (or
+// whatever loc is configured) so this preserves what was stored.
+func (l *LocalDateTime) Scan(value any) error {
+	if value == nil {
+		l.t = time.Time{}
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		if t, ok := parseSQLLocalDateTime(v); ok {
+			l.t = t
+			return nil
+		}
+		t, err := time.Parse(LocalDateTimeSQLLayout, v)
+		if err != nil {
+			if t, ok := parseLayouts(v, currentDialect().LocalDateTimeScanLayouts); ok {
+				*l = LocalDateTimeFromStdTime(t)
+				return nil
+			}
+			return &ParseError{Op: "scan", Kind: "local datetime", Input: v, Layout: LocalDateTimeSQLLayout, Err: err}
+		}
+		l.t = t
+		return nil
+	case []byte:
+		if t, ok := parseSQLLocalDateTime(v); ok {
+			l.t = t
+			return nil
+		}
+		t, err := time.Parse(LocalDateTimeSQLLayout, string(v))
+		if err != nil {
+			if t, ok := parseLayouts(string(v), currentDialect().LocalDateTimeScanLayouts); ok {
+				*l = LocalDateTimeFromStdTime(t)
+				return nil
+			}
+			return &ParseError{Op: "scan", Kind: "local datetime", Input: string(v), Layout: LocalDateTimeSQLLayout, Err: err}
+		}
+		l.t = t
+		return nil
+	case time.Time:
+		*l = LocalDateTimeFromStdTime(v)
+		return nil
+	}
+
+	return &TypeError{Op: "scan", Kind: "local datetime", Value: value}
+}
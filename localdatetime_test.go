@@ -0,0 +1,138 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestLocalDateTimeConstructors(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewLocalDateTime(2000, 1, 2, 3, 4, 5, 0)
+	ldt, err := chrono.LocalDateTimeFromString("2000-01-02T03:04:05")
+	if err != nil {
+		t.Error(err)
+	}
+	if !ref.Equal(ldt) {
+		t.Error("should be equal", ldt)
+	}
+	ldt, err = chrono.LocalDateTimeFromLayout("2006-01-02 15:04:05 -07:00", "2000-01-02 03:04:05 +05:00")
+	if err != nil {
+		t.Error(err)
+	}
+	if !ref.Equal(ldt) {
+		t.Error("offset should have been discarded", ldt)
+	}
+
+	est := time.FixedZone("EST", -5*60*60)
+	ldt = chrono.LocalDateTimeFrom(chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, est))
+	if !ref.Equal(ldt) {
+		t.Error("should keep wall clock", ldt)
+	}
+}
+
+func TestLocalDateTimeAtZone(t *testing.T) {
+	t.Parallel()
+
+	est := time.FixedZone("EST", -5*60*60)
+	ref := chrono.NewLocalDateTime(2000, 1, 2, 3, 4, 5, 0)
+
+	dt := ref.AtZone(est)
+	if !dt.Equal(chrono.NewDateTime(2000, 1, 2, 8, 4, 5, 0, time.UTC)) {
+		t.Error("wrong moment:", dt)
+	}
+	if dt.Location() != est {
+		t.Error("wrong location")
+	}
+	if back := chrono.LocalDateTimeFrom(dt); !back.Equal(ref) {
+		t.Error("should round trip", back)
+	}
+
+	if d := ref.ToDate(); !d.Equal(chrono.NewDate(2000, 1, 2)) {
+		t.Error("wrong date", d)
+	}
+	if h, m, s := ref.ToTime().Clock(); h != 3 || m != 4 || s != 5 {
+		t.Error("wrong time", h, m, s)
+	}
+}
+
+func TestLocalDateTimeFormatting(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewLocalDateTime(2000, 1, 2, 3, 4, 5, 10)
+	if s := ref.String(); s != "2000-01-02T03:04:05.00000001" {
+		t.Error("string was wrong:", s)
+	}
+	if s := ref.GoString(); s != "chrono.LocalDateTime(2000, January, 2, 3, 4, 5, 10)" {
+		t.Error("string was wrong:", s)
+	}
+}
+
+func TestLocalDateTimeMarshalling(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewLocalDateTime(2000, 1, 2, 3, 4, 5, 0)
+
+	js, err := ref.MarshalJSON()
+	if err != nil {
+		t.Error(err)
+	}
+	if string(js) != `"2000-01-02T03:04:05"` {
+		t.Error("value wrong", string(js))
+	}
+	var unjs chrono.LocalDateTime
+	if err = unjs.UnmarshalJSON(js); err != nil {
+		t.Error(err)
+	}
+	if !unjs.Equal(ref) {
+		t.Error("value was wrong")
+	}
+
+	bin, err := ref.MarshalBinary()
+	if err != nil {
+		t.Error(err)
+	}
+	var unbin chrono.LocalDateTime
+	if err = unbin.UnmarshalBinary(bin); err != nil {
+		t.Error(err)
+	}
+	if !unbin.Equal(ref) {
+		t.Error("value was wrong")
+	}
+}
+
+func TestLocalDateTimeSQL(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewLocalDateTime(2000, 1, 2, 3, 4, 5, 0)
+	if v, err := ref.Value(); err != nil {
+		t.Error(err)
+	} else if v.(string) != "2000-01-02 03:04:05" {
+		t.Error("value was wrong", v)
+	}
+
+	var ldt chrono.LocalDateTime
+	if err := ldt.Scan("2000-01-02 03:04:05"); err != nil {
+		t.Error(err)
+	}
+	if !ldt.Equal(ref) {
+		t.Error("value was wrong")
+	}
+
+	ldt = chrono.LocalDateTime{}
+	if err := ldt.Scan(time.Date(2000, 1, 2, 3, 4, 5, 0, time.Local)); err != nil {
+		t.Error(err)
+	}
+	if !ldt.Equal(ref) {
+		t.Error("value was wrong")
+	}
+
+	if err := ldt.Scan(nil); err != nil {
+		t.Error(err)
+	}
+	if !ldt.IsZero() {
+		t.Error("should be zero")
+	}
+}
@@ -0,0 +1,58 @@
+package chrono
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ErrTimestampRange is returned (wrapped) when a datetime can't be
+// represented by a timestamp, or a timestamp by a datetime
+var ErrTimestampRange = errors.New("datetime out of range for timestamp")
+
+// kafkaNoTimestamp is the timestamp of a Kafka record that doesn't have one
+const kafkaNoTimestamp = -1
+
+// DateTimeFromKafkaTimestamp creates a UTC datetime from a Kafka record
+// timestamp, milliseconds since the unix epoch. Kafka uses -1 for a record
+// without a timestamp, it and any other negative value return false.
+func DateTimeFromKafkaTimestamp(msec int64) (DateTime, bool) {
+	if msec < 0 {
+		return DateTime{}, false
+	}
+	return DateTimeFromTimestampMillis(msec), true
+}
+
+// ToKafkaTimestamp returns d as a Kafka record timestamp, milliseconds since
+// the unix epoch. The zero DateTime is -1 (no timestamp). Kafka doesn't allow
+// timestamps before the epoch, those and datetimes too far in the future
+// return an error wrapping ErrTimestampRange.
+func (d DateTime) ToKafkaTimestamp() (int64, error) {
+	if d.t.IsZero() {
+		return kafkaNoTimestamp, nil
+	}
+	sec := d.t.Unix()
+	if sec < 0 || sec > math.MaxInt64/1000-1 {
+		return 0, fmt.Errorf("kafka timestamp of %s: %w", d, ErrTimestampRange)
+	}
+	return d.t.UnixMilli(), nil
+}
+
+// DateTimeFromNATSTimestamp creates a UTC datetime from the timestamp in
+// NATS JetStream message metadata, nanoseconds since the unix epoch
+func DateTimeFromNATSTimestamp(nsec int64) DateTime {
+	return DateTimeFromTimestampNanos(nsec)
+}
+
+// ToNATSTimestamp returns d as a NATS JetStream metadata timestamp,
+// nanoseconds since the unix epoch. Datetimes outside of the years 1678 to
+// 2262 can't be represented and return an error wrapping ErrTimestampRange.
+func (d DateTime) ToNATSTimestamp() (int64, error) {
+	const maxSec = math.MaxInt64 / int64(time.Second)
+	sec := d.t.Unix()
+	if sec < -maxSec || sec >= maxSec {
+		return 0, fmt.Errorf("nats timestamp of %s: %w", d, ErrTimestampRange)
+	}
+	return d.t.UnixNano(), nil
+}
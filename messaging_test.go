@@ -0,0 +1,60 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestKafkaTimestamp(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 678000000, time.UTC)
+	ts, err := ref.ToKafkaTimestamp()
+	if err != nil || ts != 946782245678 {
+		t.Error("wrong timestamp:", ts, err)
+	}
+	if got, ok := chrono.DateTimeFromKafkaTimestamp(ts); !ok || !got.Equal(ref) {
+		t.Error("wrong datetime:", got, ok)
+	}
+
+	if _, ok := chrono.DateTimeFromKafkaTimestamp(-1); ok {
+		t.Error("-1 should have no timestamp")
+	}
+	if ts, err := (chrono.DateTime{}).ToKafkaTimestamp(); err != nil || ts != -1 {
+		t.Error("zero value should be -1:", ts, err)
+	}
+
+	for _, d := range []chrono.DateTime{
+		chrono.NewDateTime(1969, 12, 31, 23, 59, 59, 0, time.UTC),
+		chrono.NewDateTime(300000000, 1, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		if _, err := d.ToKafkaTimestamp(); !errors.Is(err, chrono.ErrTimestampRange) {
+			t.Error("expected a range error, got:", err)
+		}
+	}
+}
+
+func TestNATSTimestamp(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 678912345, time.UTC)
+	ts, err := ref.ToNATSTimestamp()
+	if err != nil || ts != 946782245678912345 {
+		t.Error("wrong timestamp:", ts, err)
+	}
+	if got := chrono.DateTimeFromNATSTimestamp(ts); !got.Equal(ref) {
+		t.Error("wrong datetime:", got)
+	}
+
+	for _, d := range []chrono.DateTime{
+		chrono.NewDateTime(1600, 1, 1, 0, 0, 0, 0, time.UTC),
+		chrono.NewDateTime(2300, 1, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		if _, err := d.ToNATSTimestamp(); !errors.Is(err, chrono.ErrTimestampRange) {
+			t.Error("expected a range error, got:", err)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package chrono
+
+import "database/sql/driver"
+
+// NullDate is a Date that may be NULL, like sql.NullTime. Date.Scan turns
+// NULL into the zero Date, NullDate can be used to tell the two apart.
+type NullDate struct {
+	Date  Date
+	Valid bool
+}
+
+// Scan implements sql.Scanner
+func (n *NullDate) Scan(value any) error {
+	n.Date, n.Valid = Date{}, false
+	if value == nil {
+		return nil
+	}
+	if err := n.Date.Scan(value); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer
+func (n NullDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Date.Value()
+}
+
+// MarshalJSON implements json.Marshaler, an invalid NullDate is null
+func (n NullDate) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Date.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, null is an invalid NullDate.
+// So is "" unless EmptyJSONIsZero is false, in which case it's an error.
+func (n *NullDate) UnmarshalJSON(data []byte) error {
+	n.Date, n.Valid = Date{}, false
+	if nullJSON(data) {
+		return nil
+	}
+	if err := n.Date.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// NullTime is a Time that may be NULL, like sql.NullTime. Time.Scan turns
+// NULL into the zero Time, NullTime can be used to tell the two apart.
+type NullTime struct {
+	Time  Time
+	Valid bool
+}
+
+// Scan implements sql.Scanner
+func (n *NullTime) Scan(value any) error {
+	n.Time, n.Valid = Time{}, false
+	if value == nil {
+		return nil
+	}
+	if err := n.Time.Scan(value); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer
+func (n NullTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Time.Value()
+}
+
+// MarshalJSON implements json.Marshaler, an invalid NullTime is null
+func (n NullTime) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Time.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, null is an invalid NullTime.
+// So is "" unless EmptyJSONIsZero is false, in which case it's an error.
+func (n *NullTime) UnmarshalJSON(data []byte) error {
+	n.Time, n.Valid = Time{}, false
+	if nullJSON(data) {
+		return nil
+	}
+	if err := n.Time.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// NullDateTime is a DateTime that may be NULL, like sql.NullTime.
+// DateTime.Scan turns NULL into the zero DateTime, NullDateTime can be used
+// to tell the two apart.
+type NullDateTime struct {
+	DateTime DateTime
+	Valid    bool
+}
+
+// Scan implements sql.Scanner
+func (n *NullDateTime) Scan(value any) error {
+	n.DateTime, n.Valid = DateTime{}, false
+	if value == nil {
+		return nil
+	}
+	if err := n.DateTime.Scan(value); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer
+func (n NullDateTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.DateTime.Value()
+}
+
+// MarshalJSON implements json.Marshaler, an invalid NullDateTime is null
+func (n NullDateTime) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.DateTime.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, null is an invalid NullDateTime.
+// So is "" unless EmptyJSONIsZero is false, in which case it's an error.
+func (n *NullDateTime) UnmarshalJSON(data []byte) error {
+	n.DateTime, n.Valid = DateTime{}, false
+	if nullJSON(data) {
+		return nil
+	}
+	if err := n.DateTime.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// nullJSON reports whether data is a JSON null, or "" when EmptyJSONIsZero
+// allows it, so that both unmarshal to an invalid value rather than only null
+func nullJSON(data []byte) bool {
+	return string(data) == "null" || (EmptyJSONIsZero && string(data) == `""`)
+}
@@ -0,0 +1,138 @@
+package chrono_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestDateTimeScanNull(t *testing.T) {
+	t.Parallel()
+
+	d := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err := d.Scan(nil); err != nil || !d.IsZero() {
+		t.Error("null should scan into the zero value:", d, err)
+	}
+}
+
+func TestNullSQL(t *testing.T) {
+	t.Parallel()
+
+	var nd chrono.NullDate
+	if err := nd.Scan("2000-01-02"); err != nil || !nd.Valid || nd.Date != chrono.NewDate(2000, 1, 2) {
+		t.Error("wrong date:", nd, err)
+	}
+	if v, err := nd.Value(); err != nil || v != "2000-01-02" {
+		t.Error("wrong value:", v, err)
+	}
+	if err := nd.Scan(nil); err != nil || nd.Valid || !nd.Date.IsZero() {
+		t.Error("null should be invalid:", nd, err)
+	}
+	if v, err := nd.Value(); err != nil || v != nil {
+		t.Error("invalid should be nil:", v, err)
+	}
+	if err := nd.Scan("garbage"); err == nil || nd.Valid {
+		t.Error("expected an error and invalid:", nd, err)
+	}
+
+	var nt chrono.NullTime
+	if err := nt.Scan("03:04:05Z"); err != nil || !nt.Valid || !nt.Time.Equal(chrono.NewTime(3, 4, 5, 0, time.UTC)) {
+		t.Error("wrong time:", nt, err)
+	}
+	if err := nt.Scan(nil); err != nil || nt.Valid {
+		t.Error("null should be invalid:", nt, err)
+	}
+	if v, err := nt.Value(); err != nil || v != nil {
+		t.Error("invalid should be nil:", v, err)
+	}
+
+	var ndt chrono.NullDateTime
+	want := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err := ndt.Scan("2000-01-02 03:04:05Z"); err != nil || !ndt.Valid || !ndt.DateTime.Equal(want) {
+		t.Error("wrong datetime:", ndt, err)
+	}
+	if v, err := ndt.Value(); err != nil || v == nil {
+		t.Error("wrong value:", v, err)
+	}
+	if err := ndt.Scan(nil); err != nil || ndt.Valid {
+		t.Error("null should be invalid:", ndt, err)
+	}
+	if v, err := ndt.Value(); err != nil || v != nil {
+		t.Error("invalid should be nil:", v, err)
+	}
+}
+
+func TestNullJSON(t *testing.T) {
+	t.Parallel()
+
+	type row struct {
+		Date     chrono.NullDate     `json:"date"`
+		Time     chrono.NullTime     `json:"time"`
+		DateTime chrono.NullDateTime `json:"datetime"`
+	}
+
+	b, err := json.Marshal(row{})
+	if err != nil || string(b) != `{"date":null,"time":null,"datetime":null}` {
+		t.Error("wrong json:", string(b), err)
+	}
+
+	in := `{"date":"2000-01-02","time":"03:04:05Z","datetime":"2000-01-02T03:04:05Z"}`
+	var r row
+	if err := json.Unmarshal([]byte(in), &r); err != nil {
+		t.Fatal(err)
+	}
+	if !r.Date.Valid || !r.Time.Valid || !r.DateTime.Valid {
+		t.Error("all should be valid:", r)
+	}
+	if b, err := json.Marshal(r); err != nil || string(b) != in {
+		t.Error("wrong round trip:", string(b), err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"date":null,"time":null,"datetime":null}`), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Date.Valid || r.Time.Valid || r.DateTime.Valid {
+		t.Error("all should be invalid:", r)
+	}
+}
+
+func TestNullJSONEmpty(t *testing.T) {
+	// Not parallel, changes package level configuration
+	defer func(old bool) { chrono.EmptyJSONIsZero = old }(chrono.EmptyJSONIsZero)
+
+	type row struct {
+		Date     chrono.NullDate     `json:"date"`
+		Time     chrono.NullTime     `json:"time"`
+		DateTime chrono.NullDateTime `json:"datetime"`
+	}
+	const in = `{"date":"","time":"","datetime":""}`
+
+	chrono.EmptyJSONIsZero = true
+	r := row{Date: chrono.NullDate{Valid: true}, Time: chrono.NullTime{Valid: true}, DateTime: chrono.NullDateTime{Valid: true}}
+	if err := json.Unmarshal([]byte(in), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Date.Valid || r.Time.Valid || r.DateTime.Valid {
+		t.Error(`"" should be invalid like null:`, r)
+	}
+
+	chrono.EmptyJSONIsZero = false
+	var n chrono.NullDate
+	if err := n.UnmarshalJSON([]byte(`""`)); !errors.Is(err, chrono.ErrEmptyJSON) {
+		t.Error("expected an empty json error:", err)
+	}
+	var nt chrono.NullTime
+	if err := nt.UnmarshalJSON([]byte(`""`)); !errors.Is(err, chrono.ErrEmptyJSON) {
+		t.Error("expected an empty json error:", err)
+	}
+	var ndt chrono.NullDateTime
+	if err := ndt.UnmarshalJSON([]byte(`""`)); !errors.Is(err, chrono.ErrEmptyJSON) {
+		t.Error("expected an empty json error:", err)
+	}
+	if err := n.UnmarshalJSON([]byte("null")); err != nil || n.Valid {
+		t.Error("null should still be invalid:", n, err)
+	}
+}
@@ -0,0 +1,42 @@
+package chrono
+
+import (
+	"math"
+	"time"
+)
+
+// DateTimeFromOTelTimestamp creates a UTC datetime from an OpenTelemetry
+// timestamp, nanoseconds since the unix epoch. OpenTelemetry uses 0 for a
+// missing timestamp so 0 is the zero DateTime.
+func DateTimeFromOTelTimestamp(nsec uint64) DateTime {
+	if nsec == 0 {
+		return DateTime{}
+	}
+	return DateTime{t: time.Unix(int64(nsec/uint64(time.Second)), int64(nsec%uint64(time.Second))).UTC()}
+}
+
+// ToOTelTimestamp returns d as an OpenTelemetry timestamp, nanoseconds since
+// the unix epoch. The zero DateTime and datetimes before the epoch are 0
+// (missing), datetimes after the year 2554 saturate at math.MaxUint64.
+func (d DateTime) ToOTelTimestamp() uint64 {
+	sec := d.t.Unix()
+	if d.t.IsZero() || sec < 0 {
+		return 0
+	}
+	if uint64(sec) > (math.MaxUint64-uint64(d.t.Nanosecond()))/uint64(time.Second) {
+		return math.MaxUint64
+	}
+	return uint64(sec)*uint64(time.Second) + uint64(d.t.Nanosecond())
+}
+
+// DateTimeFromPrometheusTimestamp creates a UTC datetime from a Prometheus
+// sample timestamp, milliseconds since the unix epoch
+func DateTimeFromPrometheusTimestamp(msec int64) DateTime {
+	return DateTimeFromTimestampMillis(msec)
+}
+
+// ToPrometheusTimestamp returns d as a Prometheus sample timestamp,
+// milliseconds since the unix epoch. Sub-millisecond precision is truncated.
+func (d DateTime) ToPrometheusTimestamp() int64 {
+	return d.ToTimestampMillis()
+}
@@ -0,0 +1,53 @@
+package chrono_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestOTelTimestamp(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 678912345, time.FixedZone("", 60*60))
+	ts := ref.ToOTelTimestamp()
+	if ts != 946778645678912345 {
+		t.Error("wrong timestamp:", ts)
+	}
+	if got := chrono.DateTimeFromOTelTimestamp(ts); !got.Equal(ref) || got.Location() != time.UTC {
+		t.Error("wrong datetime:", got)
+	}
+
+	if got := chrono.DateTimeFromOTelTimestamp(0); !got.IsZero() {
+		t.Error("0 should be the zero value:", got)
+	}
+	if got := (chrono.DateTime{}).ToOTelTimestamp(); got != 0 {
+		t.Error("zero value should be 0:", got)
+	}
+	if got := chrono.NewDateTime(1969, 12, 31, 23, 59, 59, 0, time.UTC).ToOTelTimestamp(); got != 0 {
+		t.Error("before the epoch should be 0:", got)
+	}
+	if got := chrono.NewDateTime(2600, 1, 1, 0, 0, 0, 0, time.UTC).ToOTelTimestamp(); got != math.MaxUint64 {
+		t.Error("should saturate:", got)
+	}
+
+	// Past the range of int64 nanoseconds
+	late := chrono.NewDateTime(2300, 1, 1, 0, 0, 0, 1, time.UTC)
+	if got := chrono.DateTimeFromOTelTimestamp(late.ToOTelTimestamp()); !got.Equal(late) {
+		t.Error("wrong late datetime:", got)
+	}
+}
+
+func TestPrometheusTimestamp(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 678912345, time.UTC)
+	if got := ref.ToPrometheusTimestamp(); got != 946782245678 {
+		t.Error("wrong timestamp:", got)
+	}
+	if got := chrono.DateTimeFromPrometheusTimestamp(946782245678); !got.Equal(ref.Truncate(time.Millisecond)) {
+		t.Error("wrong datetime:", got)
+	}
+}
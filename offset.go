@@ -0,0 +1,98 @@
+package chrono
+
+import "time"
+
+// Offset is a fixed offset from UTC in seconds, positive east of Greenwich.
+// It's the second value returned by Zone in a form that can be formatted,
+// parsed and serialized.
+type Offset int
+
+// NewOffset creates an offset from hours and minutes, both must have the
+// same sign, eg. NewOffset(-3, -30) for -03:30.
+func NewOffset(hours, mins int) Offset {
+	return Offset(hours*60*60 + mins*60)
+}
+
+// OffsetOf returns the offset from UTC of d in its location
+func OffsetOf(d DateTime) Offset {
+	_, offset := d.t.Zone()
+	return Offset(offset)
+}
+
+// ParseOffset parses an ISO8601 offset: Z, ±hh, ±hh:mm or ±hhmm
+func ParseOffset(str string) (Offset, error) {
+	o, err := parseISOOffset(str)
+	if err != nil {
+		return 0, &ParseError{Op: "parse", Kind: "offset", Input: str, Err: err}
+	}
+	return o, nil
+}
+
+// Duration returns the offset as a time.Duration
+func (o Offset) Duration() time.Duration {
+	return time.Duration(o) * time.Second
+}
+
+// Location returns a fixed zone with the offset, or time.UTC for 0
+func (o Offset) Location() *time.Location {
+	if o == 0 {
+		return time.UTC
+	}
+	return time.FixedZone("", int(o))
+}
+
+// Minutes returns the offset in whole minutes
+func (o Offset) Minutes() int {
+	return int(o) / 60
+}
+
+// Seconds returns the offset in seconds
+func (o Offset) Seconds() int {
+	return int(o)
+}
+
+// String returns the offset as ±hh:mm, eg. +05:30. Offsets that aren't a
+// whole number of minutes, which only occur in historical local mean times,
+// are written as ±hh:mm:ss.
+func (o Offset) String() string {
+	return string(o.appendFormat(make([]byte, 0, 9)))
+}
+
+// MarshalText implements encoding.TextMarshaler, it uses String
+func (o Offset) MarshalText() ([]byte, error) {
+	return o.appendFormat(make([]byte, 0, 9)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it accepts the same
+// forms as ParseOffset
+func (o *Offset) UnmarshalText(data []byte) error {
+	v, err := parseISOOffset(string(data))
+	if err != nil {
+		return &ParseError{Op: "unmarshal", Kind: "offset", Input: string(data), Err: err}
+	}
+	*o = v
+	return nil
+}
+
+func (o Offset) appendFormat(b []byte) []byte {
+	offset := int(o)
+	sign := byte('+')
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	b = append(b, sign)
+	b = appendInt2(b, offset/3600)
+	b = append(b, ':')
+	b = appendInt2(b, offset/60%60)
+	if offset%60 != 0 {
+		b = append(b, ':')
+		b = appendInt2(b, offset%60)
+	}
+	return b
+}
+
+// WithOffset returns the same instant as d in a fixed zone with the offset
+func (d DateTime) WithOffset(o Offset) DateTime {
+	return DateTime{t: d.t.In(o.Location())}
+}
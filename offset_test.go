@@ -0,0 +1,96 @@
+package chrono_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestOffset(t *testing.T) {
+	t.Parallel()
+
+	kolkata := time.FixedZone("IST", 5*60*60+30*60)
+	d := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, kolkata)
+
+	o := chrono.OffsetOf(d)
+	if o != chrono.NewOffset(5, 30) || o.Seconds() != 19800 || o.Minutes() != 330 || o.Duration() != 5*time.Hour+30*time.Minute {
+		t.Error("offset wrong:", int(o))
+	}
+
+	tests := []struct {
+		Offset chrono.Offset
+		Str    string
+	}{
+		{0, "+00:00"},
+		{chrono.NewOffset(5, 30), "+05:30"},
+		{chrono.NewOffset(-3, -30), "-03:30"},
+		{chrono.NewOffset(14, 0), "+14:00"},
+		{chrono.Offset(-17762), "-04:56:02"},
+	}
+	for _, test := range tests {
+		if got := test.Offset.String(); got != test.Str {
+			t.Errorf("%d: want: %s, got: %s", int(test.Offset), test.Str, got)
+		}
+	}
+
+	utc := d.WithOffset(0)
+	if !utc.Equal(d) || utc.Location() != time.UTC || utc.Hour() != 21 {
+		t.Error("utc wrong:", utc)
+	}
+	west := d.WithOffset(chrono.NewOffset(-3, -30))
+	if !west.Equal(d) || chrono.OffsetOf(west) != chrono.NewOffset(-3, -30) {
+		t.Error("fixed offset wrong:", west)
+	}
+}
+
+func TestParseOffset(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]chrono.Offset{
+		"Z":      0,
+		"+00:00": 0,
+		"+05:30": chrono.NewOffset(5, 30),
+		"+0530":  chrono.NewOffset(5, 30),
+		"-03":    chrono.NewOffset(-3, 0),
+		"-09:30": chrono.NewOffset(-9, -30),
+	}
+	for str, want := range tests {
+		if got, err := chrono.ParseOffset(str); err != nil || got != want {
+			t.Errorf("%s: want: %v, got: %v (%v)", str, want, got, err)
+		}
+	}
+
+	for _, str := range []string{"", "05:30", "+5", "+05:3", "+24:00", "+05:30x"} {
+		_, err := chrono.ParseOffset(str)
+		var perr *chrono.ParseError
+		if !errors.As(err, &perr) || perr.Kind != "offset" {
+			t.Errorf("%q: expected a parse error: %v", str, err)
+		}
+	}
+}
+
+func TestOffsetJSON(t *testing.T) {
+	t.Parallel()
+
+	b, err := json.Marshal(chrono.NewOffset(-3, -30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `"-03:30"` {
+		t.Error("json wrong:", string(b))
+	}
+
+	var o chrono.Offset
+	if err := json.Unmarshal(b, &o); err != nil {
+		t.Error(err)
+	}
+	if o != chrono.NewOffset(-3, -30) {
+		t.Error("value wrong:", o)
+	}
+	if err := json.Unmarshal([]byte(`"bad"`), &o); err == nil {
+		t.Error("expected an error")
+	}
+}
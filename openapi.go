@@ -0,0 +1,187 @@
+package chrono
+
+import (
+	"fmt"
+	"time"
+)
+
+// OpenAPIFormat is one of the string formats defined by OpenAPI (and JSON
+// Schema) for dates and times. Each is a strict subset of RFC3339.
+type OpenAPIFormat string
+
+// OpenAPI formats
+const (
+	// FormatDate is an RFC3339 full-date: 2006-01-02
+	FormatDate OpenAPIFormat = "date"
+	// FormatTime is an RFC3339 full-time: 15:04:05.999Z07:00
+	FormatTime OpenAPIFormat = "time"
+	// FormatDateTime is an RFC3339 date-time: 2006-01-02T15:04:05.999Z07:00
+	FormatDateTime OpenAPIFormat = "date-time"
+)
+
+// FormatError is returned when a string does not conform to an OpenAPIFormat.
+// Component is the part of the value that was wrong (year, month, day, hour,
+// minute, second, fraction or offset) and is empty when the value has the
+// wrong shape entirely.
+type FormatError struct {
+	Format    OpenAPIFormat
+	Value     string
+	Component string
+	Reason    string
+}
+
+// Error implements error
+func (f *FormatError) Error() string {
+	if len(f.Component) == 0 {
+		return fmt.Sprintf("invalid %s (%q): %s", f.Format, f.Value, f.Reason)
+	}
+	return fmt.Sprintf("invalid %s (%q): %s %s", f.Format, f.Value, f.Component, f.Reason)
+}
+
+// Validate checks that str strictly conforms to the format. Unlike the
+// constructors in this package nothing is normalized, eg. 2023-02-30 is
+// rejected rather than becoming March 2nd. The error is always a
+// *FormatError.
+func (f OpenAPIFormat) Validate(str string) error {
+	_, err := f.parse(str)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DateFromOpenAPI parses a string in the OpenAPI date format
+func DateFromOpenAPI(str string) (Date, error) {
+	t, err := FormatDate.parse(str)
+	if err != nil {
+		return Date{}, err
+	}
+	return Date{t: t}, nil
+}
+
+// TimeFromOpenAPI parses a string in the OpenAPI time format
+func TimeFromOpenAPI(str string) (Time, error) {
+	t, err := FormatTime.parse(str)
+	if err != nil {
+		return Time{}, err
+	}
+	return Time{t: t}, nil
+}
+
+// DateTimeFromOpenAPI parses a string in the OpenAPI date-time format
+func DateTimeFromOpenAPI(str string) (DateTime, error) {
+	t, err := FormatDateTime.parse(str)
+	if err != nil {
+		return DateTime{}, err
+	}
+	return DateTime{t: t}, nil
+}
+
+// parse validates str and returns its value. The error is a concrete type so
+// it must be checked against nil before being returned as an error.
+func (f OpenAPIFormat) parse(str string) (time.Time, *FormatError) {
+	fail := func(component, reason string) (time.Time, *FormatError) {
+		return time.Time{}, &FormatError{Format: f, Value: str, Component: component, Reason: reason}
+	}
+
+	year, month, day := 0, 1, 1
+	rest := str
+	switch f {
+	case FormatDate, FormatDateTime:
+		if len(rest) < 10 || rest[4] != '-' || rest[7] != '-' {
+			return fail("", "expected YYYY-MM-DD")
+		}
+		var ok bool
+		if year, ok = atoiN(rest, 4); !ok {
+			return fail("year", "must be 4 digits")
+		}
+		if month, ok = atoiN(rest[5:], 2); !ok || month < 1 || month > 12 {
+			return fail("month", "must be 01-12")
+		}
+		if day, ok = atoiN(rest[8:], 2); !ok || day < 1 {
+			return fail("day", "must be 01-31")
+		}
+		if last := daysIn(time.Month(month), year); day > last {
+			return fail("day", fmt.Sprintf("must be 01-%02d in %s %04d", last, time.Month(month), year))
+		}
+		rest = rest[10:]
+
+		if f == FormatDate {
+			if len(rest) != 0 {
+				return fail("", fmt.Sprintf("unexpected %q after date", rest))
+			}
+			return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC), nil
+		}
+
+		if len(rest) == 0 || (rest[0] != 'T' && rest[0] != 't') {
+			return fail("", "expected T between date and time")
+		}
+		rest = rest[1:]
+	case FormatTime:
+		// Times are on year 0 like everywhere else in the package
+	default:
+		return fail("", "unknown format")
+	}
+
+	if len(rest) < 8 || rest[2] != ':' || rest[5] != ':' {
+		return fail("", "expected HH:MM:SS")
+	}
+	hour, ok := atoiN(rest, 2)
+	if !ok || hour > 23 {
+		return fail("hour", "must be 00-23")
+	}
+	minute, ok := atoiN(rest[3:], 2)
+	if !ok || minute > 59 {
+		return fail("minute", "must be 00-59")
+	}
+	second, ok := atoiN(rest[6:], 2)
+	if !ok || second > 60 {
+		return fail("second", "must be 00-59")
+	}
+	if second == 60 {
+		return fail("second", "is a leap second which is not supported")
+	}
+	rest = rest[8:]
+
+	nsec := 0
+	if len(rest) > 0 && rest[0] == '.' {
+		n := countDigits(rest[1:])
+		if n == 0 {
+			return fail("fraction", "must have at least one digit")
+		}
+		if n > 9 {
+			return fail("fraction", "must have at most 9 digits")
+		}
+		nsec, _ = atoiN(rest[1:], n)
+		for i := n; i < 9; i++ {
+			nsec *= 10
+		}
+		rest = rest[1+n:]
+	}
+
+	var loc *time.Location
+	switch {
+	case rest == "Z" || rest == "z":
+		loc = time.UTC
+	case len(rest) == 6 && (rest[0] == '+' || rest[0] == '-') && rest[3] == ':':
+		offHour, ok := atoiN(rest[1:], 2)
+		if !ok || offHour > 23 {
+			return fail("offset", "hours must be 00-23")
+		}
+		offMinute, ok := atoiN(rest[4:], 2)
+		if !ok || offMinute > 59 {
+			return fail("offset", "minutes must be 00-59")
+		}
+		offset := offHour*60*60 + offMinute*60
+		if rest[0] == '-' {
+			offset = -offset
+		}
+		loc = time.FixedZone("", offset)
+	case len(rest) == 0:
+		return fail("offset", "is required")
+	default:
+		return fail("offset", "must be Z or ±HH:MM")
+	}
+
+	return time.Date(year, time.Month(month), day, hour, minute, second, nsec, loc), nil
+}
@@ -0,0 +1,95 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestOpenAPIValid(t *testing.T) {
+	t.Parallel()
+
+	d, err := chrono.DateFromOpenAPI("2024-02-29")
+	if err != nil {
+		t.Error(err)
+	}
+	if !d.Equal(chrono.NewDate(2024, 2, 29)) {
+		t.Error("value wrong", d)
+	}
+
+	tm, err := chrono.TimeFromOpenAPI("23:59:59.5+05:30")
+	if err != nil {
+		t.Error(err)
+	}
+	if h, m, s := tm.Clock(); h != 23 || m != 59 || s != 59 || tm.Nanosecond() != 500000000 {
+		t.Error("value wrong", tm)
+	}
+
+	dt, err := chrono.DateTimeFromOpenAPI("2000-01-02t03:04:05.123z")
+	if err != nil {
+		t.Error(err)
+	}
+	if !dt.Equal(chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 123000000, time.UTC)) {
+		t.Error("value wrong", dt)
+	}
+
+	dt, err = chrono.DateTimeFromOpenAPI("2000-01-02T03:04:05-01:30")
+	if err != nil {
+		t.Error(err)
+	}
+	if !dt.Equal(chrono.NewDateTime(2000, 1, 2, 4, 34, 5, 0, time.UTC)) {
+		t.Error("value wrong", dt)
+	}
+}
+
+func TestOpenAPIInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Format    chrono.OpenAPIFormat
+		In        string
+		Component string
+	}{
+		{chrono.FormatDate, "2023-02-30", "day"},
+		{chrono.FormatDate, "2023-13-01", "month"},
+		{chrono.FormatDate, "2023-00-01", "month"},
+		{chrono.FormatDate, "20x3-01-01", "year"},
+		{chrono.FormatDate, "2023-1-01", ""},
+		{chrono.FormatDate, "2023-01-01T00:00:00Z", ""},
+		{chrono.FormatTime, "24:00:00Z", "hour"},
+		{chrono.FormatTime, "12:60:00Z", "minute"},
+		{chrono.FormatTime, "23:59:60Z", "second"},
+		{chrono.FormatTime, "12:00:00", "offset"},
+		{chrono.FormatTime, "12:00:00+0100", "offset"},
+		{chrono.FormatTime, "12:00:00+01:60", "offset"},
+		{chrono.FormatTime, "12:00:00.Z", "fraction"},
+		{chrono.FormatTime, "12:00", ""},
+		{chrono.FormatDateTime, "2023-02-29T00:00:00Z", "day"},
+		{chrono.FormatDateTime, "2023-01-01 00:00:00Z", ""},
+		{chrono.FormatDateTime, "2023-01-01", ""},
+		{chrono.OpenAPIFormat("email"), "2023-01-01", ""},
+	}
+
+	for _, test := range tests {
+		err := test.Format.Validate(test.In)
+		var ferr *chrono.FormatError
+		if !errors.As(err, &ferr) {
+			t.Errorf("%s %q: expected a format error, got %v", test.Format, test.In, err)
+			continue
+		}
+		if ferr.Component != test.Component || ferr.Value != test.In || ferr.Format != test.Format {
+			t.Errorf("%s %q: error wrong: %#v", test.Format, test.In, ferr)
+		}
+	}
+
+	if err := chrono.FormatDateTime.Validate("2023-01-01T00:00:00Z"); err != nil {
+		t.Error(err)
+	}
+
+	_, err := chrono.DateFromOpenAPI("2023-02-30")
+	if err == nil || err.Error() != `invalid date ("2023-02-30"): day must be 01-28 in February 2023` {
+		t.Error("error message wrong", err)
+	}
+}
@@ -0,0 +1,241 @@
+package chrono
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// openingHoursWindow is how many days NextOpen and NextClose search, enough
+// to cover a full week plus ranges that spill over from the day before
+const openingHoursWindow = 8
+
+// OpeningHours describes when a place is open on each day of the week, eg.
+// the hours of a store. A range belongs to the weekday it starts on, an
+// overnight range such as Friday's 22:00-02:00 is open into Saturday. A range
+// whose start and end are equal is open for 24 hours.
+type OpeningHours struct {
+	// Days maps a weekday to its opening ranges, a missing day is closed
+	Days map[time.Weekday][]TimeRange
+	// Location the hours are in, nil means UTC
+	Location *time.Location
+}
+
+// OpeningHoursFromString parses a simplified form of the OpenStreetMap
+// opening_hours syntax: rules separated by ';' each of which is a list of
+// days or day ranges followed by a list of time ranges or "off", eg.
+//
+//	Mo-Fr 09:00-17:00; Sa 10:00-12:00,13:00-16:00; Su off
+//
+// Days may be written as in ParseWeekdays and a day range may wrap around the
+// end of the week (Fr-Mo). A later rule replaces the hours of an earlier one.
+func OpeningHoursFromString(str string) (OpeningHours, error) {
+	o := OpeningHours{Days: make(map[time.Weekday][]TimeRange)}
+	for _, rule := range strings.Split(str, ";") {
+		rule = strings.TrimSpace(rule)
+		if len(rule) == 0 {
+			continue
+		}
+
+		daySpec, hourSpec, ok := strings.Cut(rule, " ")
+		if !ok {
+			return OpeningHours{}, &ParseError{Op: "parse", Kind: "opening hours", Input: str, Err: fmt.Errorf("rule %q has no hours", rule)}
+		}
+		days, err := parseDaySpec(daySpec)
+		if err != nil {
+			return OpeningHours{}, &ParseError{Op: "parse", Kind: "opening hours", Input: str, Err: err}
+		}
+
+		var ranges []TimeRange
+		if hourSpec = strings.TrimSpace(hourSpec); hourSpec != "off" && hourSpec != "closed" {
+			for _, elem := range strings.Split(hourSpec, ",") {
+				r, err := TimeRangeFromString(strings.TrimSpace(elem))
+				if err != nil {
+					return OpeningHours{}, &ParseError{Op: "parse", Kind: "opening hours", Input: str, Err: err}
+				}
+				ranges = append(ranges, r)
+			}
+		}
+
+		for _, d := range days.Days() {
+			if ranges == nil {
+				delete(o.Days, d)
+			} else {
+				o.Days[d] = ranges
+			}
+		}
+	}
+	return o, nil
+}
+
+// parseDaySpec parses a comma separated list of days and day ranges
+func parseDaySpec(str string) (Weekdays, error) {
+	var w Weekdays
+	for _, elem := range strings.Split(str, ",") {
+		first, last, isRange := strings.Cut(elem, "-")
+		from, ok := parseWeekdayName(first)
+		if !ok {
+			return 0, fmt.Errorf("unknown weekday %q", first)
+		}
+		to := from
+		if isRange {
+			if to, ok = parseWeekdayName(last); !ok {
+				return 0, fmt.Errorf("unknown weekday %q", last)
+			}
+		}
+		for d := from; ; d = (d + 1) % 7 {
+			w = w.Add(d)
+			if d == to {
+				break
+			}
+		}
+	}
+	return w, nil
+}
+
+// IsOpenAt returns true if the place is open at dt
+func (o OpeningHours) IsOpenAt(dt DateTime) bool {
+	t := dt.t.In(o.location())
+	day := DateFromStdTime(t)
+	for _, p := range o.periods(day.AddDate(0, 0, -1), day) {
+		if !t.Before(p[0]) && t.Before(p[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextOpen returns the next time the place opens at or after dt, if it's
+// already open at dt then dt is returned. If it's never open false is
+// returned.
+func (o OpeningHours) NextOpen(dt DateTime) (DateTime, bool) {
+	t := dt.t.In(o.location())
+	day := DateFromStdTime(t)
+	for _, p := range o.periods(day.AddDate(0, 0, -1), day.AddDate(0, 0, openingHoursWindow)) {
+		if !t.Before(p[1]) {
+			continue
+		}
+		if p[0].After(t) {
+			return DateTime{t: p[0]}, true
+		}
+		return DateTime{t: t}, true
+	}
+	return DateTime{}, false
+}
+
+// NextClose returns the next time the place closes after dt, if it's closed
+// at dt this is the close after the next opening. If it's never open, or
+// never closes, false is returned.
+func (o OpeningHours) NextClose(dt DateTime) (DateTime, bool) {
+	t := dt.t.In(o.location())
+	day := DateFromStdTime(t)
+	periods := o.periods(day.AddDate(0, 0, -1), day.AddDate(0, 0, openingHoursWindow))
+	for _, p := range periods {
+		if !t.Before(p[1]) {
+			continue
+		}
+		// Without a break in a whole week it's open around the clock
+		if p[1].Sub(p[0]) >= 7*dayDuration {
+			return DateTime{}, false
+		}
+		return DateTime{t: p[1]}, true
+	}
+	return DateTime{}, false
+}
+
+// String returns the hours in the form parsed by OpeningHoursFromString
+// starting from Monday, consecutive days with the same hours are combined,
+// eg. "Mo-Fr 09:00-17:00; Sa 10:00-12:00,13:00-16:00". Closed days are
+// omitted.
+func (o OpeningHours) String() string {
+	var rules []string
+	for i := 1; i <= 7; {
+		d := time.Weekday(i % 7)
+		hours := formatTimeRanges(o.Days[d])
+		j := i + 1
+		for ; j <= 7 && formatTimeRanges(o.Days[time.Weekday(j%7)]) == hours; j++ {
+		}
+		if len(hours) != 0 {
+			days := openingDayName(d)
+			if last := time.Weekday((j - 1) % 7); last != d {
+				days += "-" + openingDayName(last)
+			}
+			rules = append(rules, days+" "+hours)
+		}
+		i = j
+	}
+	return strings.Join(rules, "; ")
+}
+
+// MarshalText implements encoding.TextMarshaler using String
+func (o OpeningHours) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using
+// OpeningHoursFromString, the Location is left unchanged
+func (o *OpeningHours) UnmarshalText(data []byte) error {
+	hours, err := OpeningHoursFromString(string(data))
+	if err != nil {
+		return err
+	}
+	o.Days = hours.Days
+	return nil
+}
+
+// periods returns the merged open periods of the ranges that start on the
+// days from first through last in order
+func (o OpeningHours) periods(first, last Date) [][2]time.Time {
+	loc := o.location()
+	var out [][2]time.Time
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		year, month, dom := day.Date()
+		for _, r := range o.Days[day.Weekday()] {
+			endDom := dom
+			if clockOffset(r.End) <= clockOffset(r.Start) {
+				endDom++
+			}
+			out = append(out, [2]time.Time{
+				time.Date(year, month, dom, r.Start.Hour(), r.Start.Minute(), r.Start.Second(), r.Start.Nanosecond(), loc),
+				time.Date(year, month, endDom, r.End.Hour(), r.End.Minute(), r.End.Second(), r.End.Nanosecond(), loc),
+			})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i][0].Before(out[j][0])
+	})
+
+	merged := out[:0]
+	for _, p := range out {
+		if n := len(merged); n > 0 && !p[0].After(merged[n-1][1]) {
+			if p[1].After(merged[n-1][1]) {
+				merged[n-1][1] = p[1]
+			}
+			continue
+		}
+		merged = append(merged, p)
+	}
+	return merged
+}
+
+func (o OpeningHours) location() *time.Location {
+	if o.Location == nil {
+		return time.UTC
+	}
+	return o.Location
+}
+
+// formatTimeRanges joins the ranges with commas
+func formatTimeRanges(ranges []TimeRange) string {
+	strs := make([]string, len(ranges))
+	for i, r := range ranges {
+		strs[i] = r.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+// openingDayName returns the OpenStreetMap name of the weekday, eg. Mo
+func openingDayName(d time.Weekday) string {
+	return d.String()[:2]
+}
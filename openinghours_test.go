@@ -0,0 +1,135 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestOpeningHoursFromString(t *testing.T) {
+	t.Parallel()
+
+	o, err := chrono.OpeningHoursFromString("Mo-Fr 09:00-17:00; Sa 10:00-12:00,13:00-16:00; Fr 09:00-22:00; Su off")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := o.String(); got != "Mo-Th 09:00-17:00; Fr 09:00-22:00; Sa 10:00-12:00,13:00-16:00" {
+		t.Error("wrong string:", got)
+	}
+	if _, ok := o.Days[time.Sunday]; ok {
+		t.Error("sunday should be closed")
+	}
+
+	o, err = chrono.OpeningHoursFromString("Fr-Mo 00:00-00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := o.String(); got != "Mo 00:00-00:00; Fr-Su 00:00-00:00" {
+		t.Error("wrong string:", got)
+	}
+
+	bad := []string{
+		"Mo",
+		"Xx 09:00-17:00",
+		"Mo-Xx 09:00-17:00",
+		"Mo 09:00",
+		"Mo 9-5",
+	}
+	for _, str := range bad {
+		if _, err := chrono.OpeningHoursFromString(str); err == nil {
+			t.Errorf("%q: expected an error", str)
+		}
+	}
+}
+
+func TestOpeningHours(t *testing.T) {
+	t.Parallel()
+
+	// 2024-01-01 is a Monday
+	o, err := chrono.OpeningHoursFromString("Mo-Th 09:00-17:00; Fr 18:00-02:00; Sa 12:00-14:00,14:00-16:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := func(d, h, m int) chrono.DateTime {
+		return chrono.NewDateTime(2024, 1, d, h, m, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		At        chrono.DateTime
+		Open      bool
+		NextOpen  chrono.DateTime
+		NextClose chrono.DateTime
+	}{
+		{at(1, 8, 0), false, at(1, 9, 0), at(1, 17, 0)},
+		{at(1, 9, 0), true, at(1, 9, 0), at(1, 17, 0)},
+		{at(1, 17, 0), false, at(2, 9, 0), at(2, 17, 0)},
+		{at(5, 23, 0), true, at(5, 23, 0), at(6, 2, 0)},
+		{at(6, 1, 0), true, at(6, 1, 0), at(6, 2, 0)},
+		{at(6, 3, 0), false, at(6, 12, 0), at(6, 16, 0)}, // adjacent ranges merge
+		{at(6, 16, 0), false, at(8, 9, 0), at(8, 17, 0)},
+	}
+	for _, test := range tests {
+		if got := o.IsOpenAt(test.At); got != test.Open {
+			t.Errorf("%s: open want %t, got %t", test.At, test.Open, got)
+		}
+		if got, ok := o.NextOpen(test.At); !ok || !got.Equal(test.NextOpen) {
+			t.Errorf("%s: next open want %s, got %s", test.At, test.NextOpen, got)
+		}
+		if got, ok := o.NextClose(test.At); !ok || !got.Equal(test.NextClose) {
+			t.Errorf("%s: next close want %s, got %s", test.At, test.NextClose, got)
+		}
+	}
+}
+
+func TestOpeningHoursLocation(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("", -5*60*60)
+	o := chrono.OpeningHours{
+		Days: map[time.Weekday][]chrono.TimeRange{
+			time.Monday: {chrono.NewTimeRange(chrono.NewTime(9, 0, 0, 0, time.UTC), chrono.NewTime(17, 0, 0, 0, time.UTC))},
+		},
+		Location: loc,
+	}
+
+	// 15:00 UTC is 10:00 in loc
+	if !o.IsOpenAt(chrono.NewDateTime(2024, 1, 1, 15, 0, 0, 0, time.UTC)) {
+		t.Error("should be open")
+	}
+	if got, _ := o.NextClose(chrono.NewDateTime(2024, 1, 1, 15, 0, 0, 0, time.UTC)); !got.Equal(chrono.NewDateTime(2024, 1, 1, 17, 0, 0, 0, loc)) {
+		t.Error("wrong close:", got)
+	}
+}
+
+func TestOpeningHoursNever(t *testing.T) {
+	t.Parallel()
+
+	dt := chrono.NewDateTime(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	var closed chrono.OpeningHours
+	if closed.IsOpenAt(dt) {
+		t.Error("should be closed")
+	}
+	if _, ok := closed.NextOpen(dt); ok {
+		t.Error("should never open")
+	}
+	if _, ok := closed.NextClose(dt); ok {
+		t.Error("should never close")
+	}
+
+	always, err := chrono.OpeningHoursFromString("Mo-Su 00:00-00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !always.IsOpenAt(dt) {
+		t.Error("should be open")
+	}
+	if got, ok := always.NextOpen(dt); !ok || !got.Equal(dt) {
+		t.Error("should be open now:", got)
+	}
+	if _, ok := always.NextClose(dt); ok {
+		t.Error("should never close")
+	}
+}
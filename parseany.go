@@ -0,0 +1,118 @@
+package chrono
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// ParsedKind is the type of value ParseAny found
+type ParsedKind int
+
+// Parsed kinds
+const (
+	ParsedDate ParsedKind = iota + 1
+	ParsedTime
+	ParsedDateTime
+)
+
+// String returns date, time or datetime
+func (p ParsedKind) String() string {
+	switch p {
+	case ParsedDate:
+		return "date"
+	case ParsedTime:
+		return "time"
+	case ParsedDateTime:
+		return "datetime"
+	default:
+		return "unknown"
+	}
+}
+
+// Parsed is the result of ParseAny, only the field named by Kind is set
+type Parsed struct {
+	Kind ParsedKind
+	// Layout is the layout that matched the input
+	Layout string
+
+	Date     Date
+	Time     Time
+	DateTime DateTime
+}
+
+// Value returns the Date, Time or DateTime that was parsed
+func (p Parsed) Value() any {
+	switch p.Kind {
+	case ParsedDate:
+		return p.Date
+	case ParsedTime:
+		return p.Time
+	case ParsedDateTime:
+		return p.DateTime
+	default:
+		return nil
+	}
+}
+
+// anyDateTimeLayouts, anyDateLayouts and anyTimeLayouts are tried by ParseAny
+// after the corresponding config layouts. Numeric dates are month first.
+var (
+	anyDateTimeLayouts = []string{
+		time.RFC1123Z,
+		time.RFC1123,
+		time.RFC850,
+		time.ANSIC,
+		"1/2/2006 15:04:05",
+		"1/2/2006 15:04",
+		"1/2/2006 3:04:05 PM",
+		"1/2/2006 3:04 PM",
+		"Jan 2, 2006 15:04:05",
+		"Jan 2, 2006 3:04 PM",
+	}
+	anyDateLayouts = []string{
+		"1/2/2006",
+		"02.01.2006",
+		"Jan 2, 2006",
+		"January 2, 2006",
+		"2 Jan 2006",
+		"2 January 2006",
+		"02-Jan-2006",
+	}
+	anyTimeLayouts = []string{
+		"3:04:05 PM",
+		"3:04:05PM",
+	}
+)
+
+// ParseAny parses a value of unknown type, eg. a spreadsheet cell, reporting
+// whether it was a Date, Time or DateTime and which layout matched. The
+// DateTimeConfigLayouts, DateConfigLayouts and TimeConfigLayouts are tried
+// first, then a set of common layouts including RFC1123 and month first
+// numeric dates like 1/2/2006. Values without a zone are UTC.
+func ParseAny(str string) (Parsed, error) {
+	str = strings.TrimSpace(str)
+
+	if t, layout, ok := parseAnyLayouts(str, DateTimeConfigLayouts, anyDateTimeLayouts); ok {
+		return Parsed{Kind: ParsedDateTime, Layout: layout, DateTime: DateTime{t: t}}, nil
+	}
+	if t, layout, ok := parseAnyLayouts(str, DateConfigLayouts, anyDateLayouts); ok {
+		return Parsed{Kind: ParsedDate, Layout: layout, Date: DateFromStdTime(t)}, nil
+	}
+	if t, layout, ok := parseAnyLayouts(str, TimeConfigLayouts, anyTimeLayouts); ok {
+		return Parsed{Kind: ParsedTime, Layout: layout, Time: TimeFromStdTime(t)}, nil
+	}
+
+	return Parsed{}, &ParseError{Op: "parse", Kind: "date, time or datetime", Input: str, Err: errors.New("no layout matched")}
+}
+
+func parseAnyLayouts(str string, layoutLists ...[]string) (time.Time, string, bool) {
+	for _, layouts := range layoutLists {
+		for _, layout := range layouts {
+			if t, err := time.Parse(layout, str); err == nil {
+				return t, layout, true
+			}
+		}
+	}
+	return time.Time{}, "", false
+}
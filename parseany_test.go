@@ -0,0 +1,69 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestParseAny(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In     string
+		Kind   chrono.ParsedKind
+		Layout string
+		Want   any
+	}{
+		{"2006-01-02", chrono.ParsedDate, "2006-01-02", chrono.NewDate(2006, 1, 2)},
+		{"1/2/2006", chrono.ParsedDate, "1/2/2006", chrono.NewDate(2006, 1, 2)},
+		{"12/31/2006", chrono.ParsedDate, "1/2/2006", chrono.NewDate(2006, 12, 31)},
+		{"31.12.2006", chrono.ParsedDate, "02.01.2006", chrono.NewDate(2006, 12, 31)},
+		{"January 2, 2006", chrono.ParsedDate, "January 2, 2006", chrono.NewDate(2006, 1, 2)},
+		{"15:04", chrono.ParsedTime, "15:04", chrono.NewTime(15, 4, 0, 0, time.UTC)},
+		{"15:04:05Z", chrono.ParsedTime, "15:04:05Z07:00", chrono.NewTime(15, 4, 5, 0, time.UTC)},
+		{"3:04:05 PM", chrono.ParsedTime, "3:04:05 PM", chrono.NewTime(15, 4, 5, 0, time.UTC)},
+		{"2006-01-02T15:04:05Z", chrono.ParsedDateTime, time.RFC3339Nano, chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{" 2006-01-02 15:04 ", chrono.ParsedDateTime, "2006-01-02 15:04", chrono.NewDateTime(2006, 1, 2, 15, 4, 0, 0, time.UTC)},
+		{"1/2/2006 3:04 PM", chrono.ParsedDateTime, "1/2/2006 3:04 PM", chrono.NewDateTime(2006, 1, 2, 15, 4, 0, 0, time.UTC)},
+		{"Mon, 02 Jan 2006 15:04:05 +0000", chrono.ParsedDateTime, time.RFC1123Z, chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		got, err := chrono.ParseAny(test.In)
+		if err != nil {
+			t.Errorf("%q: %v", test.In, err)
+			continue
+		}
+		if got.Kind != test.Kind || got.Layout != test.Layout {
+			t.Errorf("%q: want %s (%s), got %s (%s)", test.In, test.Kind, test.Layout, got.Kind, got.Layout)
+			continue
+		}
+
+		switch want := test.Want.(type) {
+		case chrono.Date:
+			if got.Value().(chrono.Date) != want {
+				t.Errorf("%q: want %v, got %v", test.In, want, got.Date)
+			}
+		case chrono.Time:
+			if !got.Value().(chrono.Time).Equal(want) {
+				t.Errorf("%q: want %v, got %v", test.In, want, got.Time)
+			}
+		case chrono.DateTime:
+			if !got.Value().(chrono.DateTime).Equal(want) {
+				t.Errorf("%q: want %v, got %v", test.In, want, got.DateTime)
+			}
+		}
+	}
+
+	_, err := chrono.ParseAny("not a date")
+	var perr *chrono.ParseError
+	if !errors.As(err, &perr) {
+		t.Error("expected a parse error, got:", err)
+	}
+	if v := (chrono.Parsed{}).Value(); v != nil {
+		t.Error("zero value should have no value:", v)
+	}
+}
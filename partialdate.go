@@ -0,0 +1,252 @@
+package chrono
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PartialDate is a date where some of the components are unknown, eg. a
+// birthday without a year or "March 2024". Unknown components are 0.
+type PartialDate struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// HasYear returns true if the year is known
+func (p PartialDate) HasYear() bool {
+	return p.Year != 0
+}
+
+// HasMonth returns true if the month is known
+func (p PartialDate) HasMonth() bool {
+	return p.Month != 0
+}
+
+// HasDay returns true if the day is known
+func (p PartialDate) HasDay() bool {
+	return p.Day != 0
+}
+
+// IsZero returns true if no components are known
+func (p PartialDate) IsZero() bool {
+	return p == PartialDate{}
+}
+
+// PartialDateFromString parses the ISO8601 reduced precision forms of a
+// date: 2024 (year), 2024-03 (year and month), --03-15 or --0315 (month and
+// day), ---15 (day) and full 2024-03-15 dates.
+func PartialDateFromString(str string) (PartialDate, error) {
+	p, err := parsePartialDate(str)
+	if err == nil {
+		err = p.validate()
+	}
+	if err != nil {
+		return PartialDate{}, &ParseError{Op: "parse", Kind: "partial date", Input: str, Err: err}
+	}
+	return p, nil
+}
+
+func parsePartialDate(str string) (PartialDate, error) {
+	ok := true
+	num := func(s string, n int) int {
+		v, good := atoiN(s, n)
+		ok = ok && good && len(s) == n
+		return v
+	}
+
+	// Components written as zero would be read back as unknown so they're
+	// rejected, hasYear etc. are the components present in str
+	var p PartialDate
+	var hasYear, hasMonth, hasDay bool
+	switch {
+	case strings.HasPrefix(str, "---"):
+		p.Day = num(str[3:], 2)
+		hasDay = true
+	case strings.HasPrefix(str, "--"):
+		rest := str[2:]
+		if len(rest) == 5 && rest[2] == '-' {
+			rest = rest[:2] + rest[3:]
+		}
+		if len(rest) != 4 {
+			ok = false
+			break
+		}
+		p.Month = time.Month(num(rest[:2], 2))
+		p.Day = num(rest[2:], 2)
+		hasMonth, hasDay = true, true
+	case len(str) == 4:
+		p.Year = num(str, 4)
+		hasYear = true
+	case len(str) == 7 && str[4] == '-':
+		p.Year = num(str[:4], 4)
+		p.Month = time.Month(num(str[5:], 2))
+		hasYear, hasMonth = true, true
+	case len(str) == 10 && str[4] == '-' && str[7] == '-':
+		p.Year = num(str[:4], 4)
+		p.Month = time.Month(num(str[5:7], 2))
+		p.Day = num(str[8:], 2)
+		hasYear, hasMonth, hasDay = true, true, true
+	default:
+		ok = false
+	}
+
+	if !ok {
+		return PartialDate{}, errors.New("not a partial date")
+	}
+	if err := checkPartialZeros(p, hasYear, hasMonth, hasDay); err != nil {
+		return PartialDate{}, err
+	}
+	return p, nil
+}
+
+// checkPartialZeros returns a *RangeError for a component of p that is
+// present in the input but zero, which PartialDate can't tell apart from
+// unknown
+func checkPartialZeros(p PartialDate, hasYear, hasMonth, hasDay bool) error {
+	switch {
+	case hasYear && p.Year == 0:
+		return &RangeError{Component: "year", Value: 0, Min: 1, Max: 9999}
+	case hasMonth && p.Month == 0:
+		return &RangeError{Component: "month", Value: 0, Min: 1, Max: 12}
+	case hasDay && p.Day == 0:
+		return &RangeError{Component: "day", Value: 0, Min: 1, Max: 31}
+	}
+	return nil
+}
+
+// String returns the ISO8601 reduced precision form of the date, eg. 2024-03
+// or --03-15. A year and day without a month can't be represented, the day is
+// left out. The zero value is an empty string.
+func (p PartialDate) String() string {
+	return string(p.appendString(make([]byte, 0, 10)))
+}
+
+func (p PartialDate) appendString(b []byte) []byte {
+	switch {
+	case p.HasYear():
+		b = appendInt4(b, p.Year)
+		if !p.HasMonth() {
+			return b
+		}
+		b = appendInt2(append(b, '-'), int(p.Month))
+		if p.HasDay() {
+			b = appendInt2(append(b, '-'), p.Day)
+		}
+	case p.HasMonth():
+		b = appendInt2(append(b, "--"...), int(p.Month))
+		if p.HasDay() {
+			b = appendInt2(append(b, '-'), p.Day)
+		}
+	case p.HasDay():
+		b = appendInt2(append(b, "---"...), p.Day)
+	}
+	return b
+}
+
+// Resolve fills in the unknown components from defaults to produce a full
+// date, eg. resolving a birthday of --03-15 with today gives this year's
+// birthday. If the day doesn't exist in the resulting month, like February
+// 29th in a year that isn't a leap year, it is clamped to the last day.
+func (p PartialDate) Resolve(defaults Date) Date {
+	year, month, day := defaults.Date()
+	if p.HasYear() {
+		year = p.Year
+	}
+	if p.HasMonth() {
+		month = p.Month
+	}
+	if p.HasDay() {
+		day = p.Day
+	}
+	if last := daysIn(month, year); day > last {
+		day = last
+	}
+	return NewDate(year, month, day)
+}
+
+// MarshalJSON implements json.Marshaler, it returns a *RangeError if a known
+// component is out of range and couldn't be parsed back, eg. year 12345
+func (p PartialDate) MarshalJSON() ([]byte, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	b := make([]byte, 0, 12)
+	b = append(b, '"')
+	b = p.appendString(b)
+	return append(b, '"'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler, it fails like MarshalJSON
+func (p PartialDate) MarshalText() ([]byte, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	return p.appendString(make([]byte, 0, 10)), nil
+}
+
+// UnmarshalJSON parses a quoted ISO8601 reduced precision date, see
+// PartialDateFromString. A null or "" is handled according to
+// EmptyJSONIsZero.
+func (p *PartialDate) UnmarshalJSON(data []byte) error {
+	if empty, err := emptyJSON(data, "partial date"); empty {
+		*p = PartialDate{}
+		return err
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return &ParseError{Op: "unmarshal", Kind: "partial date", Input: string(data), Err: errors.New("not a json string")}
+	}
+	return p.unmarshal(string(data[1:len(data)-1]), string(data))
+}
+
+// UnmarshalText parses an ISO8601 reduced precision date, see
+// PartialDateFromString
+func (p *PartialDate) UnmarshalText(data []byte) error {
+	return p.unmarshal(string(data), string(data))
+}
+
+func (p *PartialDate) unmarshal(str, input string) error {
+	v, err := parsePartialDate(str)
+	if err == nil {
+		err = v.validate()
+	}
+	if err != nil {
+		return &ParseError{Op: "unmarshal", Kind: "partial date", Input: input, Err: err}
+	}
+	*p = v
+	return nil
+}
+
+// validate checks the known components are in range, when the year is
+// unknown February 29th is allowed
+func (p PartialDate) validate() error {
+	if p.Year != 0 && (p.Year < 1 || p.Year > 9999) {
+		return &RangeError{Component: "year", Value: p.Year, Min: 1, Max: 9999}
+	}
+	if p.Month != 0 && (p.Month < time.January || p.Month > time.December) {
+		return &RangeError{Component: "month", Value: int(p.Month), Min: 1, Max: 12}
+	}
+	if p.Day == 0 {
+		return nil
+	}
+
+	last := 31
+	if p.Month != 0 {
+		year := p.Year
+		if year == 0 {
+			year = 2000
+		}
+		last = daysIn(p.Month, year)
+	}
+	if p.Day < 1 || p.Day > last {
+		return &RangeError{Component: "day", Value: p.Day, Min: 1, Max: last}
+	}
+	return nil
+}
+
+// GoString implements fmt.GoStringer
+func (p PartialDate) GoString() string {
+	return fmt.Sprintf("chrono.PartialDate{Year: %d, Month: %d, Day: %d}", p.Year, p.Month, p.Day)
+}
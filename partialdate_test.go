@@ -0,0 +1,131 @@
+package chrono_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestPartialDateFromString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In     string
+		Want   chrono.PartialDate
+		String string
+	}{
+		{"2024", chrono.PartialDate{Year: 2024}, "2024"},
+		{"2024-03", chrono.PartialDate{Year: 2024, Month: time.March}, "2024-03"},
+		{"2024-03-15", chrono.PartialDate{Year: 2024, Month: time.March, Day: 15}, "2024-03-15"},
+		{"--03-15", chrono.PartialDate{Month: time.March, Day: 15}, "--03-15"},
+		{"--0315", chrono.PartialDate{Month: time.March, Day: 15}, "--03-15"},
+		{"--02-29", chrono.PartialDate{Month: time.February, Day: 29}, "--02-29"},
+		{"---15", chrono.PartialDate{Day: 15}, "---15"},
+	}
+	for _, test := range tests {
+		got, err := chrono.PartialDateFromString(test.In)
+		if err != nil {
+			t.Errorf("%s: %v", test.In, err)
+			continue
+		}
+		if got != test.Want {
+			t.Errorf("%s: want %#v, got %#v", test.In, test.Want, got)
+		}
+		if s := got.String(); s != test.String {
+			t.Errorf("%s: want string %s, got %s", test.In, test.String, s)
+		}
+	}
+
+	bad := []string{"", "24", "2024-3", "2024-13", "2023-02-29", "--03", "--13-01", "---32", "2024/03/15",
+		"0000", "0000-03", "2024-00", "2024-00-15", "2024-03-00", "--00-15", "--0300", "---00"}
+	for _, in := range bad {
+		_, err := chrono.PartialDateFromString(in)
+		var perr *chrono.ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("%q: expected a parse error, got: %v", in, err)
+		}
+	}
+
+	if s := (chrono.PartialDate{}).String(); s != "" {
+		t.Error("zero value should be empty:", s)
+	}
+}
+
+func TestPartialDateResolve(t *testing.T) {
+	t.Parallel()
+
+	today := chrono.NewDate(2023, 6, 10)
+	tests := []struct {
+		In   chrono.PartialDate
+		Want chrono.Date
+	}{
+		{chrono.PartialDate{Month: time.March, Day: 15}, chrono.NewDate(2023, 3, 15)},
+		{chrono.PartialDate{Year: 2024, Month: time.March}, chrono.NewDate(2024, 3, 10)},
+		{chrono.PartialDate{Year: 2020}, chrono.NewDate(2020, 6, 10)},
+		{chrono.PartialDate{Month: time.February, Day: 29}, chrono.NewDate(2023, 2, 28)},
+		{chrono.PartialDate{Month: time.April, Day: 31}, chrono.NewDate(2023, 4, 30)},
+		{chrono.PartialDate{}, today},
+	}
+	for _, test := range tests {
+		if got := test.In.Resolve(today); got != test.Want {
+			t.Errorf("%s: want %v, got %v", test.In, test.Want, got)
+		}
+	}
+}
+
+func TestPartialDateJSON(t *testing.T) {
+	t.Parallel()
+
+	type contact struct {
+		Birthday chrono.PartialDate `json:"birthday"`
+	}
+
+	c := contact{Birthday: chrono.PartialDate{Month: time.March, Day: 15}}
+	b, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"birthday":"--03-15"}` {
+		t.Error("wrong json:", string(b))
+	}
+
+	var back contact
+	if err := json.Unmarshal(b, &back); err != nil || back != c {
+		t.Error("round trip failed:", back, err)
+	}
+	if err := json.Unmarshal([]byte(`{"birthday":null}`), &back); err != nil || !back.Birthday.IsZero() {
+		t.Error("null should be the zero value:", back, err)
+	}
+	if err := json.Unmarshal([]byte(`{"birthday":"--13-01"}`), &back); err == nil {
+		t.Error("expected an error")
+	}
+	if err := json.Unmarshal([]byte(`{"birthday":2024}`), &back); err == nil {
+		t.Error("expected an error for a number")
+	}
+
+	var txt chrono.PartialDate
+	if err := txt.UnmarshalText([]byte("2024-03")); err != nil || txt != (chrono.PartialDate{Year: 2024, Month: time.March}) {
+		t.Error("wrong text:", txt, err)
+	}
+	if b, _ := txt.MarshalText(); string(b) != "2024-03" {
+		t.Error("wrong text:", string(b))
+	}
+
+	// Out of range years can't be written with 4 digits and parsed back
+	var rerr *chrono.RangeError
+	for _, year := range []int{12345, -5} {
+		p := chrono.PartialDate{Year: year, Month: time.March}
+		if _, err := p.MarshalJSON(); !errors.As(err, &rerr) || rerr.Component != "year" {
+			t.Error("expected a year range error:", err)
+		}
+		if _, err := p.MarshalText(); !errors.As(err, &rerr) || rerr.Component != "year" {
+			t.Error("expected a year range error:", err)
+		}
+	}
+	if _, err := json.Marshal(contact{Birthday: chrono.PartialDate{Month: 13}}); !errors.As(err, &rerr) {
+		t.Error("expected a range error:", err)
+	}
+}
@@ -0,0 +1,355 @@
+package chrono
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Period is an amount of calendar time. Unlike a time.Duration its years,
+// months and days have no fixed length, they depend on the date they're
+// applied to (months have 28-31 days, and days are 23-25 hours when DST
+// changes). The Duration component is exact.
+type Period struct {
+	Years    int
+	Months   int
+	Days     int
+	Duration time.Duration
+}
+
+// NewPeriod creates a period from its date components
+func NewPeriod(years, months, days int) Period {
+	return Period{Years: years, Months: months, Days: days}
+}
+
+// PeriodFromString parses an ISO8601 duration such as "P1Y2M3DT4H5M6.5S" or
+// "P2W" into a period. Weeks are converted to days.
+func PeriodFromString(str string) (Period, error) {
+	p, err := parseISOPeriod(str)
+	if err != nil {
+		return Period{}, &ParseError{Op: "parse", Kind: "period", Input: str, Err: err}
+	}
+	return p, nil
+}
+
+// ParseDurationExtended parses a duration string like time.ParseDuration but
+// with support for calendar units as well as ISO8601 durations. Components
+// may optionally be separated by spaces and a leading '-' negates the whole
+// period.
+//
+//	y    years
+//	mo   months
+//	w    weeks (7 days)
+//	d    days
+//	h, m, s, ms, us (or µs), ns as in time.ParseDuration
+//
+// Examples: "2d", "1w", "3mo", "1y 2mo 3d", "1d12h", "P1Y2M3D"
+func ParseDurationExtended(str string) (Period, error) {
+	if isISODuration(str) {
+		return PeriodFromString(str)
+	}
+
+	orig := str
+	str = strings.TrimSpace(str)
+	negative := strings.HasPrefix(str, "-")
+	if negative || strings.HasPrefix(str, "+") {
+		str = str[1:]
+	}
+	if len(str) == 0 {
+		return Period{}, &ParseError{Op: "parse", Kind: "duration", Input: orig, Err: errors.New("empty")}
+	}
+
+	var p Period
+	for str = strings.TrimLeft(str, " "); len(str) > 0; str = strings.TrimLeft(str, " ") {
+		n := countDigits(str)
+		numEnd := n
+		if numEnd < len(str) && str[numEnd] == '.' {
+			numEnd += 1 + countDigits(str[numEnd+1:])
+		}
+		if numEnd == 0 {
+			return Period{}, &ParseError{Op: "parse", Kind: "duration", Input: orig, Err: fmt.Errorf("expected number at %q", str)}
+		}
+		number := str[:numEnd]
+		str = str[numEnd:]
+
+		unitEnd := 0
+		for unitEnd < len(str) && str[unitEnd] != ' ' && str[unitEnd] != '.' && (str[unitEnd] < '0' || str[unitEnd] > '9') {
+			unitEnd++
+		}
+		unit := str[:unitEnd]
+		str = str[unitEnd:]
+
+		switch unit {
+		case "y", "mo", "w", "d":
+			v, err := strconv.Atoi(number)
+			if err != nil {
+				return Period{}, &ParseError{Op: "parse", Kind: "duration", Input: orig, Err: fmt.Errorf("%q must be a whole number", number+unit)}
+			}
+			switch unit {
+			case "y":
+				p.Years += v
+			case "mo":
+				p.Months += v
+			case "w":
+				p.Days += v * 7
+			case "d":
+				p.Days += v
+			}
+		default:
+			d, err := time.ParseDuration(number + unit)
+			if err != nil {
+				return Period{}, &ParseError{Op: "parse", Kind: "duration", Input: orig, Err: err}
+			}
+			p.Duration += d
+		}
+	}
+
+	if negative {
+		p = p.Negate()
+	}
+	return p, nil
+}
+
+// ExactDuration converts the period to a Duration treating days as 24 hours.
+// It returns false if the period contains years or months since they have
+// no fixed length.
+func (p Period) ExactDuration() (Duration, bool) {
+	if p.Years != 0 || p.Months != 0 {
+		return 0, false
+	}
+	return Duration(time.Duration(p.Days)*24*time.Hour + p.Duration), true
+}
+
+// IsZero returns true if every component of the period is zero
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Negate returns the period with every component negated
+func (p Period) Negate() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Days: -p.Days, Duration: -p.Duration}
+}
+
+// Plus returns the sum of the two periods component by component, it is not
+// normalized (eg. 11 months plus 1 month is 12 months not 1 year).
+func (p Period) Plus(o Period) Period {
+	return Period{
+		Years:    p.Years + o.Years,
+		Months:   p.Months + o.Months,
+		Days:     p.Days + o.Days,
+		Duration: p.Duration + o.Duration,
+	}
+}
+
+// GoString implements fmt.GoStringer
+func (p Period) GoString() string {
+	return fmt.Sprintf("chrono.Period(%d, %d, %d, %s)", p.Years, p.Months, p.Days, p.Duration)
+}
+
+// String returns the period as an ISO8601 duration, eg. "P1Y2M3DT4H". Negative
+// components are prefixed with '-', eg. "P-1Y2M".
+func (p Period) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+
+	b := []byte{'P'}
+	appendComponent := func(v int64, designator byte) {
+		if v != 0 {
+			b = strconv.AppendInt(b, v, 10)
+			b = append(b, designator)
+		}
+	}
+	appendComponent(int64(p.Years), 'Y')
+	appendComponent(int64(p.Months), 'M')
+	appendComponent(int64(p.Days), 'D')
+
+	if p.Duration != 0 {
+		b = append(b, 'T')
+		u := uint64(p.Duration)
+		sign := int64(1)
+		if p.Duration < 0 {
+			u, sign = -u, -1
+		}
+		hours := u / uint64(time.Hour)
+		u -= hours * uint64(time.Hour)
+		mins := u / uint64(time.Minute)
+		u -= mins * uint64(time.Minute)
+		appendComponent(sign*int64(hours), 'H')
+		appendComponent(sign*int64(mins), 'M')
+		if u > 0 {
+			if sign < 0 {
+				b = append(b, '-')
+			}
+			b = appendSeconds(b, u)
+			b = append(b, 'S')
+		}
+	}
+	return string(b)
+}
+
+// MarshalJSON implements json.Marshaller
+func (p Period) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + p.String() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaller
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalJSON parses a quoted ISO8601 duration
+func (p *Period) UnmarshalJSON(data []byte) error {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return &ParseError{Op: "unmarshal", Kind: "period", Input: string(data), Err: errors.New("expected a string")}
+	}
+	return p.UnmarshalText(data[1 : len(data)-1])
+}
+
+// UnmarshalText parses an ISO8601 duration
+func (p *Period) UnmarshalText(data []byte) error {
+	period, err := PeriodFromString(string(data))
+	if err != nil {
+		return err
+	}
+	*p = period
+	return nil
+}
+
+// AddPeriod returns d plus the period. The years and months are added first,
+// normalizing like AddDate (Jan 31 plus 1 month is Mar 3 or Mar 2), then the
+// days on the wall clock and finally the exact Duration. Adding a day across
+// a DST change therefore keeps the wall clock time while adding 24 hours
+// does not.
+func (d DateTime) AddPeriod(p Period) DateTime {
+	return DateTime{t: addPeriod(d.t, p, 1)}
+}
+
+// SubPeriod returns d minus the period, the same as adding its negation. Like
+// AddDate it's not always the inverse of AddPeriod since months have
+// different lengths.
+func (d DateTime) SubPeriod(p Period) DateTime {
+	return d.AddPeriod(p.Negate())
+}
+
+// AddPeriod returns d plus the period in the same order as
+// DateTime.AddPeriod. The Duration is added as whole days of 24 hours, any
+// remainder is dropped.
+func (d Date) AddPeriod(p Period) Date {
+	days := p.Days + int(p.Duration/dayDuration)
+	return Date{t: d.t.AddDate(p.Years, p.Months, days)}
+}
+
+// SubPeriod returns d minus the period, see DateTime.SubPeriod
+func (d Date) SubPeriod(p Period) Date {
+	return d.AddPeriod(p.Negate())
+}
+
+// PeriodBetween returns the period from a to b in the location of a. It's
+// normalized, Months is less than 12 and Duration is less than a day, and
+// it's the period for which a.AddPeriod(PeriodBetween(a, b)) equals b. When b is
+// before a every component is negative or zero.
+func PeriodBetween(a, b DateTime) Period {
+	if b.t.Before(a.t) {
+		return PeriodBetween(b.In(a.t.Location()), a).Negate()
+	}
+
+	start, end := a.t, b.t.In(a.t.Location())
+	months := (end.Year()-start.Year())*12 + int(end.Month()-start.Month())
+	for months > 0 && start.AddDate(0, months, 0).After(end) {
+		months--
+	}
+	mid := start.AddDate(0, months, 0)
+
+	days := int(DateFromStdTime(end).t.Sub(DateFromStdTime(mid).t) / dayDuration)
+	for days > 0 && mid.AddDate(0, 0, days).After(end) {
+		days--
+	}
+	mid = mid.AddDate(0, 0, days)
+
+	return Period{Years: months / 12, Months: months % 12, Days: days, Duration: end.Sub(mid)}
+}
+
+// PeriodBetweenUnits is like PeriodBetween but only uses the units given,
+// larger units that aren't given are counted in the next smaller one that is
+// and anything smaller than the smallest is dropped. For example the period
+// from Jan 15 2020 to Mar 20 2021 10:00 in Months and Days is 14 months and 5
+// days. Weeks are counted as 7 Days and Hours, Minutes and Seconds are whole
+// amounts of the Duration. If no units are given it's the same as
+// PeriodBetween.
+func PeriodBetweenUnits(a, b DateTime, units ...CalendarUnit) Period {
+	if len(units) == 0 {
+		return PeriodBetween(a, b)
+	}
+	if b.t.Before(a.t) {
+		return PeriodBetweenUnits(b.In(a.t.Location()), a, units...).Negate()
+	}
+
+	use := make(map[CalendarUnit]bool, len(units))
+	for _, u := range units {
+		use[u] = true
+	}
+
+	start, end := a.t, b.t.In(a.t.Location())
+	var p Period
+	// largest returns the largest n (starting from guess) for which
+	// start.AddDate(add(n)) isn't after end
+	largest := func(guess int, add func(n int) (int, int, int)) int {
+		n := guess + 1
+		for n > 0 && start.AddDate(add(n)).After(end) {
+			n--
+		}
+		return n
+	}
+
+	cursor := start
+	if use[Year] {
+		p.Years = largest(end.Year()-cursor.Year(), func(n int) (int, int, int) { return n, 0, 0 })
+		cursor = start.AddDate(p.Years, 0, 0)
+	}
+	if use[Month] {
+		guess := (end.Year()-cursor.Year())*12 + int(end.Month()-cursor.Month())
+		p.Months = largest(guess, func(n int) (int, int, int) { return p.Years, n, 0 })
+		cursor = start.AddDate(p.Years, p.Months, 0)
+	}
+	if use[Week] || use[Day] {
+		days := int(DateFromStdTime(end).t.Sub(DateFromStdTime(cursor).t) / dayDuration)
+		if use[Week] {
+			weeks := largest(days/7, func(n int) (int, int, int) { return p.Years, p.Months, n * 7 })
+			p.Days = weeks * 7
+			days -= p.Days
+		}
+		if use[Day] {
+			p.Days += largest(days, func(n int) (int, int, int) { return p.Years, p.Months, p.Days + n })
+		}
+		cursor = start.AddDate(p.Years, p.Months, p.Days)
+	}
+
+	rest := end.Sub(cursor)
+	for _, u := range [...]struct {
+		unit CalendarUnit
+		dur  time.Duration
+	}{{Hour, time.Hour}, {Minute, time.Minute}, {Second, time.Second}} {
+		if use[u.unit] {
+			whole := rest.Truncate(u.dur)
+			p.Duration += whole
+			rest -= whole
+		}
+	}
+	return p
+}
+
+// PeriodBetweenDates returns the period from a to b in years, months and
+// days, see PeriodBetween
+func PeriodBetweenDates(a, b Date) Period {
+	return PeriodBetween(DateTime{t: a.t}, DateTime{t: b.t})
+}
+
+// addPeriod adds n multiples of p to t. Multiplying the period before adding
+// it avoids the drift of repeatedly adding it (Jan 31 + 1 month + 1 month is
+// Apr 3 but Jan 31 + 2 months is Mar 31).
+func addPeriod(t time.Time, p Period, n int) time.Time {
+	return t.AddDate(p.Years*n, p.Months*n, p.Days*n).Add(p.Duration * time.Duration(n))
+}
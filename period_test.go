@@ -0,0 +1,269 @@
+package chrono_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/chronotest"
+)
+
+func TestParseDurationExtended(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   string
+		Want chrono.Period
+	}{
+		{"2d", chrono.Period{Days: 2}},
+		{"1w", chrono.Period{Days: 7}},
+		{"3mo", chrono.Period{Months: 3}},
+		{"1y 2mo 3d", chrono.Period{Years: 1, Months: 2, Days: 3}},
+		{"1y2mo3d", chrono.Period{Years: 1, Months: 2, Days: 3}},
+		{"1d12h30m", chrono.Period{Days: 1, Duration: 12*time.Hour + 30*time.Minute}},
+		{"1.5h", chrono.Period{Duration: 90 * time.Minute}},
+		{"-1w 2d", chrono.Period{Days: -9}},
+		{"250ms", chrono.Period{Duration: 250 * time.Millisecond}},
+		{"P1Y2M3DT4H", chrono.Period{Years: 1, Months: 2, Days: 3, Duration: 4 * time.Hour}},
+		{"P2W", chrono.Period{Days: 14}},
+	}
+
+	for _, test := range tests {
+		p, err := chrono.ParseDurationExtended(test.In)
+		if err != nil {
+			t.Error(test.In, err)
+			continue
+		}
+		if p != test.Want {
+			t.Errorf("%s: want %#v, got %#v", test.In, test.Want, p)
+		}
+	}
+
+	for _, bad := range []string{"", "-", "1.5d", "2x", "d", "1y 2", "P1H"} {
+		if p, err := chrono.ParseDurationExtended(bad); err == nil {
+			t.Errorf("%s: expected an error, got %s", bad, p)
+		}
+	}
+}
+
+func TestPeriodString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   chrono.Period
+		Want string
+	}{
+		{chrono.Period{}, "P0D"},
+		{chrono.NewPeriod(1, 2, 3), "P1Y2M3D"},
+		{chrono.Period{Duration: 90*time.Minute + 500*time.Millisecond}, "PT1H30M0.5S"},
+		{chrono.Period{Days: 1, Duration: time.Second}, "P1DT1S"},
+		{chrono.Period{Years: -1, Months: 2}, "P-1Y2M"},
+		{chrono.Period{Duration: -(time.Hour + time.Second)}, "PT-1H-1S"},
+	}
+
+	for _, test := range tests {
+		if s := test.In.String(); s != test.Want {
+			t.Errorf("%#v: want %s, got %s", test.In, test.Want, s)
+			continue
+		}
+		back, err := chrono.PeriodFromString(test.Want)
+		if err != nil {
+			t.Error(err)
+		}
+		if back != test.In {
+			t.Errorf("%s: did not round trip, got %#v", test.Want, back)
+		}
+	}
+}
+
+func TestPeriodHelpers(t *testing.T) {
+	t.Parallel()
+
+	p := chrono.Period{Days: 1, Duration: time.Hour}
+	if d, ok := p.ExactDuration(); !ok || d.Std() != 25*time.Hour {
+		t.Error("value wrong", d, ok)
+	}
+	if _, ok := chrono.NewPeriod(0, 1, 0).ExactDuration(); ok {
+		t.Error("months are not exact")
+	}
+	if !p.Plus(p.Negate()).IsZero() {
+		t.Error("should be zero")
+	}
+
+	js, err := json.Marshal(chrono.NewPeriod(1, 0, 2))
+	if err != nil {
+		t.Error(err)
+	}
+	if string(js) != `"P1Y2D"` {
+		t.Error("json wrong", string(js))
+	}
+	var back chrono.Period
+	if err = json.Unmarshal(js, &back); err != nil {
+		t.Error(err)
+	}
+	if back != chrono.NewPeriod(1, 0, 2) {
+		t.Error("value wrong", back)
+	}
+}
+
+func TestAddPeriod(t *testing.T) {
+	t.Parallel()
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Start  chrono.DateTime
+		Period chrono.Period
+		Want   chrono.DateTime
+	}{
+		{
+			chrono.NewDateTime(2023, 1, 31, 12, 0, 0, 0, time.UTC),
+			chrono.Period{Months: 1, Days: 1},
+			chrono.NewDateTime(2023, 3, 4, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			chrono.NewDateTime(2024, 2, 29, 12, 0, 0, 0, time.UTC),
+			chrono.Period{Years: 1, Duration: 13 * time.Hour},
+			chrono.NewDateTime(2025, 3, 2, 1, 0, 0, 0, time.UTC),
+		},
+		// A day keeps the wall clock across DST, 24 hours does not
+		{
+			chrono.NewDateTime(2024, 3, 9, 12, 0, 0, 0, ny),
+			chrono.Period{Days: 1},
+			chrono.NewDateTime(2024, 3, 10, 12, 0, 0, 0, ny),
+		},
+		{
+			chrono.NewDateTime(2024, 3, 9, 12, 0, 0, 0, ny),
+			chrono.Period{Duration: 24 * time.Hour},
+			chrono.NewDateTime(2024, 3, 10, 13, 0, 0, 0, ny),
+		},
+	}
+	for _, test := range tests {
+		if got := test.Start.AddPeriod(test.Period); !got.Equal(test.Want) {
+			t.Errorf("%s + %s: want %s, got %s", test.Start, test.Period, test.Want, got)
+		}
+		if got := test.Want.SubPeriod(test.Period); test.Period.Months == 0 && test.Period.Years == 0 && !got.Equal(test.Start) {
+			t.Errorf("%s - %s: want %s, got %s", test.Want, test.Period, test.Start, got)
+		}
+	}
+
+	d := chrono.NewDate(2024, 1, 31)
+	if got := d.AddPeriod(chrono.Period{Months: 1, Duration: 50 * time.Hour}); got != chrono.NewDate(2024, 3, 4) {
+		t.Error("wrong date:", got)
+	}
+	if got := d.SubPeriod(chrono.NewPeriod(1, 1, 1)); got != chrono.NewDate(2022, 12, 30) {
+		t.Error("wrong date:", got)
+	}
+}
+
+func TestPeriodBetween(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		A, B chrono.DateTime
+		Want chrono.Period
+	}{
+		{
+			chrono.NewDateTime(2020, 1, 15, 10, 0, 0, 0, time.UTC),
+			chrono.NewDateTime(2021, 3, 20, 9, 30, 0, 0, time.UTC),
+			chrono.Period{Years: 1, Months: 2, Days: 4, Duration: 23*time.Hour + 30*time.Minute},
+		},
+		{
+			chrono.NewDateTime(2023, 1, 31, 0, 0, 0, 0, time.UTC),
+			chrono.NewDateTime(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+			chrono.Period{Days: 29},
+		},
+		{
+			chrono.NewDateTime(2021, 3, 20, 9, 30, 0, 0, time.UTC),
+			chrono.NewDateTime(2020, 1, 15, 10, 0, 0, 0, time.UTC),
+			chrono.Period{Years: -1, Months: -2, Days: -4, Duration: -(23*time.Hour + 30*time.Minute)},
+		},
+	}
+	for _, test := range tests {
+		if got := chrono.PeriodBetween(test.A, test.B); got != test.Want {
+			t.Errorf("%s to %s: want %s, got %s", test.A, test.B, test.Want, got)
+		}
+	}
+
+	if got := chrono.PeriodBetweenDates(chrono.NewDate(2000, 2, 29), chrono.NewDate(2004, 2, 28)); got != chrono.NewPeriod(3, 11, 30) {
+		t.Error("wrong period:", got)
+	}
+}
+
+func TestPeriodBetweenRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	start := chrono.NewDateTime(1990, 1, 1, 0, 0, 0, 0, ny)
+	end := chrono.NewDateTime(2030, 1, 1, 0, 0, 0, 0, ny)
+	for i := 0; i < 2000; i++ {
+		a := chronotest.RandomDateTimeBetween(r, start, end).In(ny)
+		b := chronotest.RandomDateTimeBetween(r, start, end)
+		if i%2 == 0 {
+			b = a.Add(time.Duration(r.Int63n(int64(100 * 24 * time.Hour))))
+		}
+
+		p := chrono.PeriodBetween(a, b)
+		if a.Before(b) && (p.Months < 0 || p.Months >= 12 || p.Days < 0 || p.Duration < 0 || p.Duration >= 25*time.Hour) {
+			t.Errorf("%s to %s: not normalized %s", a, b, p)
+		}
+		if a.Before(b) && !a.AddPeriod(p).Equal(b) {
+			t.Errorf("%s + %s: want %s, got %s", a, p, b, a.AddPeriod(p))
+		}
+	}
+}
+
+func TestPeriodBetweenUnits(t *testing.T) {
+	t.Parallel()
+
+	a := chrono.NewDateTime(2020, 1, 15, 0, 0, 0, 0, time.UTC)
+	b := chrono.NewDateTime(2021, 3, 20, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		Units []chrono.CalendarUnit
+		Want  chrono.Period
+	}{
+		{nil, chrono.Period{Years: 1, Months: 2, Days: 5, Duration: 10*time.Hour + 30*time.Minute}},
+		{[]chrono.CalendarUnit{chrono.Month, chrono.Day}, chrono.Period{Months: 14, Days: 5}},
+		{[]chrono.CalendarUnit{chrono.Year, chrono.Day}, chrono.Period{Years: 1, Days: 64}},
+		{[]chrono.CalendarUnit{chrono.Day}, chrono.Period{Days: 430}},
+		{[]chrono.CalendarUnit{chrono.Week, chrono.Day}, chrono.Period{Days: 430}},
+		{[]chrono.CalendarUnit{chrono.Week}, chrono.Period{Days: 427}},
+		{[]chrono.CalendarUnit{chrono.Month, chrono.Hour}, chrono.Period{Months: 14, Duration: 130 * time.Hour}},
+		{[]chrono.CalendarUnit{chrono.Hour, chrono.Minute}, chrono.Period{Duration: 430*24*time.Hour + 10*time.Hour + 30*time.Minute}},
+	}
+	for _, test := range tests {
+		if got := chrono.PeriodBetweenUnits(a, b, test.Units...); got != test.Want {
+			t.Errorf("%v: want %s, got %s", test.Units, test.Want, got)
+		}
+	}
+
+	if got := chrono.PeriodBetweenUnits(b, a, chrono.Month, chrono.Day); got != chrono.NewPeriod(0, -14, -5) {
+		t.Error("wrong negative period:", got)
+	}
+
+	// Month end anchors count a whole month only once it has passed
+	jan31 := chrono.NewDateTime(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+	if got := chrono.PeriodBetweenUnits(jan31, chrono.NewDateTime(2023, 2, 28, 0, 0, 0, 0, time.UTC), chrono.Month, chrono.Day); got != chrono.NewPeriod(0, 0, 28) {
+		t.Error("wrong month end period:", got)
+	}
+
+	// Leap days with years and months together are added the same way
+	// AddPeriod adds them
+	feb29 := chrono.NewDateTime(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+	end := chrono.NewDateTime(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	p := chrono.PeriodBetweenUnits(feb29, end, chrono.Year, chrono.Month, chrono.Day)
+	if got := feb29.AddPeriod(p); !got.Equal(end) {
+		t.Errorf("%s + %s: want %s, got %s", feb29, p, end, got)
+	}
+}
@@ -0,0 +1,376 @@
+// Package pgxchrono registers chrono's types with pgx v5 so that they are
+// sent and received using the Postgres binary wire formats instead of being
+// round-tripped through their text (database/sql) representations.
+//
+// Register the types on each connection, typically in AfterConnect:
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		pgxchrono.Register(conn.TypeMap())
+//		return nil
+//	}
+//
+// The mapping is:
+//
+//	chrono.Date          date
+//	chrono.Time          time
+//	chrono.DateTime      timestamptz
+//	chrono.Instant       timestamptz
+//	chrono.LocalDateTime timestamp
+//	chrono.Duration      interval
+//	chrono.Period        interval
+//
+// Arrays of each are supported as well. SQL NULL scans into the zero value
+// the same as it does with the types' sql.Scanner implementations.
+package pgxchrono
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Register wraps the codecs for date, time, timestamp, timestamptz and
+// interval (and their arrays) in m so that they handle chrono's types
+// directly. Values of any other type are passed through to the original
+// codecs unchanged.
+func Register(m *pgtype.Map) {
+	types := []struct {
+		name       string
+		oid        uint32
+		arrayOID   uint32
+		wrapValue  func(value any) (any, bool)
+		wrapTarget func(target any) (any, bool)
+	}{
+		{"date", pgtype.DateOID, pgtype.DateArrayOID, wrapDateValue, wrapDateTarget},
+		{"time", pgtype.TimeOID, pgtype.TimeArrayOID, wrapTimeValue, wrapTimeTarget},
+		{"timestamp", pgtype.TimestampOID, pgtype.TimestampArrayOID, wrapTimestampValue, wrapTimestampTarget},
+		{"timestamptz", pgtype.TimestamptzOID, pgtype.TimestamptzArrayOID, wrapTimestamptzValue, wrapTimestamptzTarget},
+		{"interval", pgtype.IntervalOID, pgtype.IntervalArrayOID, wrapIntervalValue, wrapIntervalTarget},
+	}
+
+	for _, typ := range types {
+		var codec pgtype.Codec
+		if t, ok := m.TypeForOID(typ.oid); ok {
+			codec = t.Codec
+		}
+		if c, ok := codec.(*wrapCodec); ok {
+			// Already registered
+			codec = c.Codec
+		}
+		if codec == nil {
+			continue
+		}
+
+		elem := &pgtype.Type{
+			Name:  typ.name,
+			OID:   typ.oid,
+			Codec: &wrapCodec{Codec: codec, wrapValue: typ.wrapValue, wrapTarget: typ.wrapTarget},
+		}
+		m.RegisterType(elem)
+		m.RegisterType(&pgtype.Type{
+			Name:  "_" + typ.name,
+			OID:   typ.arrayOID,
+			Codec: &pgtype.ArrayCodec{ElementType: elem},
+		})
+	}
+
+	m.RegisterDefaultPgType(chrono.Date{}, "date")
+	m.RegisterDefaultPgType(chrono.Time{}, "time")
+	m.RegisterDefaultPgType(chrono.LocalDateTime{}, "timestamp")
+	m.RegisterDefaultPgType(chrono.DateTime{}, "timestamptz")
+	m.RegisterDefaultPgType(chrono.Instant{}, "timestamptz")
+	m.RegisterDefaultPgType(chrono.Duration(0), "interval")
+	m.RegisterDefaultPgType(chrono.Period{}, "interval")
+}
+
+// wrapCodec converts chrono values into types that implement the pgtype
+// Valuer and Scanner interfaces that the codec it wraps understands
+type wrapCodec struct {
+	pgtype.Codec
+
+	wrapValue  func(value any) (any, bool)
+	wrapTarget func(target any) (any, bool)
+}
+
+// PlanEncode implements pgtype.Codec
+func (c *wrapCodec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	if wrapped, ok := c.wrapValue(value); ok {
+		if next := c.Codec.PlanEncode(m, oid, format, wrapped); next != nil {
+			return &encodePlan{wrap: c.wrapValue, next: next}
+		}
+	}
+	return c.Codec.PlanEncode(m, oid, format, value)
+}
+
+// PlanScan implements pgtype.Codec
+func (c *wrapCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	if wrapped, ok := c.wrapTarget(target); ok {
+		if next := c.Codec.PlanScan(m, oid, format, wrapped); next != nil {
+			return &scanPlan{wrap: c.wrapTarget, next: next}
+		}
+	}
+	return c.Codec.PlanScan(m, oid, format, target)
+}
+
+type encodePlan struct {
+	wrap func(value any) (any, bool)
+	next pgtype.EncodePlan
+}
+
+// Encode implements pgtype.EncodePlan
+func (p *encodePlan) Encode(value any, buf []byte) ([]byte, error) {
+	wrapped, ok := p.wrap(value)
+	if !ok {
+		return nil, fmt.Errorf("pgxchrono: cannot encode %T", value)
+	}
+	return p.next.Encode(wrapped, buf)
+}
+
+type scanPlan struct {
+	wrap func(target any) (any, bool)
+	next pgtype.ScanPlan
+}
+
+// Scan implements pgtype.ScanPlan
+func (p *scanPlan) Scan(src []byte, target any) error {
+	wrapped, ok := p.wrap(target)
+	if !ok {
+		return fmt.Errorf("pgxchrono: cannot scan into %T", target)
+	}
+	return p.next.Scan(src, wrapped)
+}
+
+func wrapDateValue(value any) (any, bool) {
+	v, ok := value.(chrono.Date)
+	return date(v), ok
+}
+
+func wrapDateTarget(target any) (any, bool) {
+	v, ok := target.(*chrono.Date)
+	return (*date)(v), ok
+}
+
+func wrapTimeValue(value any) (any, bool) {
+	v, ok := value.(chrono.Time)
+	return clock(v), ok
+}
+
+func wrapTimeTarget(target any) (any, bool) {
+	v, ok := target.(*chrono.Time)
+	return (*clock)(v), ok
+}
+
+func wrapTimestampValue(value any) (any, bool) {
+	v, ok := value.(chrono.LocalDateTime)
+	return localDateTime(v), ok
+}
+
+func wrapTimestampTarget(target any) (any, bool) {
+	v, ok := target.(*chrono.LocalDateTime)
+	return (*localDateTime)(v), ok
+}
+
+func wrapTimestamptzValue(value any) (any, bool) {
+	switch v := value.(type) {
+	case chrono.DateTime:
+		return dateTime(v), true
+	case chrono.Instant:
+		return instant(v), true
+	}
+	return nil, false
+}
+
+func wrapTimestamptzTarget(target any) (any, bool) {
+	switch v := target.(type) {
+	case *chrono.DateTime:
+		return (*dateTime)(v), true
+	case *chrono.Instant:
+		return (*instant)(v), true
+	}
+	return nil, false
+}
+
+func wrapIntervalValue(value any) (any, bool) {
+	switch v := value.(type) {
+	case chrono.Duration:
+		return duration(v), true
+	case chrono.Period:
+		return period(v), true
+	}
+	return nil, false
+}
+
+func wrapIntervalTarget(target any) (any, bool) {
+	switch v := target.(type) {
+	case *chrono.Duration:
+		return (*duration)(v), true
+	case *chrono.Period:
+		return (*period)(v), true
+	}
+	return nil, false
+}
+
+// infinityError is returned when scanning infinity or -infinity into a type
+// that has no sentinel for them
+func infinityError(kind string, mod pgtype.InfinityModifier) error {
+	return fmt.Errorf("pgxchrono: cannot scan %s into chrono.%s", mod, kind)
+}
+
+type date chrono.Date
+
+func (d date) DateValue() (pgtype.Date, error) {
+	switch cd := chrono.Date(d); {
+	case cd.Equal(chrono.PosInfinityDate()):
+		return pgtype.Date{InfinityModifier: pgtype.Infinity, Valid: true}, nil
+	case cd.Equal(chrono.NegInfinityDate()):
+		return pgtype.Date{InfinityModifier: pgtype.NegativeInfinity, Valid: true}, nil
+	}
+	return pgtype.Date{Time: chrono.Date(d).ToStdTime(), Valid: true}, nil
+}
+
+func (d *date) ScanDate(v pgtype.Date) error {
+	switch {
+	case !v.Valid:
+		*d = date{}
+	case v.InfinityModifier == pgtype.Infinity:
+		*d = date(chrono.PosInfinityDate())
+	case v.InfinityModifier == pgtype.NegativeInfinity:
+		*d = date(chrono.NegInfinityDate())
+	default:
+		*d = date(chrono.DateFromStdTime(v.Time))
+	}
+	return nil
+}
+
+type clock chrono.Time
+
+func (c clock) TimeValue() (pgtype.Time, error) {
+	t := chrono.Time(c)
+	hour, min, sec := t.Clock()
+	usec := int64(hour)*int64(time.Hour/time.Microsecond) +
+		int64(min)*int64(time.Minute/time.Microsecond) +
+		int64(sec)*int64(time.Second/time.Microsecond) +
+		int64(t.Nanosecond())/int64(time.Microsecond)
+	return pgtype.Time{Microseconds: usec, Valid: true}, nil
+}
+
+func (c *clock) ScanTime(v pgtype.Time) error {
+	if !v.Valid {
+		*c = clock{}
+		return nil
+	}
+	// 24:00:00 is valid in postgres and becomes midnight
+	midnight := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)
+	*c = clock(chrono.TimeFromStdTime(midnight.Add(time.Duration(v.Microseconds) * time.Microsecond)))
+	return nil
+}
+
+type localDateTime chrono.LocalDateTime
+
+func (l localDateTime) TimestampValue() (pgtype.Timestamp, error) {
+	t := chrono.LocalDateTime(l).AtZone(time.UTC).ToStdTime()
+	return pgtype.Timestamp{Time: t, Valid: true}, nil
+}
+
+func (l *localDateTime) ScanTimestamp(v pgtype.Timestamp) error {
+	switch {
+	case !v.Valid:
+		*l = localDateTime{}
+	case v.InfinityModifier != pgtype.Finite:
+		return infinityError("LocalDateTime", v.InfinityModifier)
+	default:
+		*l = localDateTime(chrono.LocalDateTimeFromStdTime(v.Time))
+	}
+	return nil
+}
+
+type dateTime chrono.DateTime
+
+func (d dateTime) TimestamptzValue() (pgtype.Timestamptz, error) {
+	switch cd := chrono.DateTime(d); {
+	case cd.Equal(chrono.PosInfinity()):
+		return pgtype.Timestamptz{InfinityModifier: pgtype.Infinity, Valid: true}, nil
+	case cd.Equal(chrono.NegInfinity()):
+		return pgtype.Timestamptz{InfinityModifier: pgtype.NegativeInfinity, Valid: true}, nil
+	}
+	return pgtype.Timestamptz{Time: chrono.DateTime(d).ToStdTime(), Valid: true}, nil
+}
+
+func (d *dateTime) ScanTimestamptz(v pgtype.Timestamptz) error {
+	switch {
+	case !v.Valid:
+		*d = dateTime{}
+	case v.InfinityModifier == pgtype.Infinity:
+		*d = dateTime(chrono.PosInfinity())
+	case v.InfinityModifier == pgtype.NegativeInfinity:
+		*d = dateTime(chrono.NegInfinity())
+	default:
+		*d = dateTime(chrono.DateTimeFromStdTime(v.Time))
+	}
+	return nil
+}
+
+type instant chrono.Instant
+
+func (i instant) TimestamptzValue() (pgtype.Timestamptz, error) {
+	return pgtype.Timestamptz{Time: chrono.Instant(i).ToStdTime(), Valid: true}, nil
+}
+
+func (i *instant) ScanTimestamptz(v pgtype.Timestamptz) error {
+	switch {
+	case !v.Valid:
+		*i = instant{}
+	case v.InfinityModifier != pgtype.Finite:
+		return infinityError("Instant", v.InfinityModifier)
+	default:
+		*i = instant(chrono.InstantFromStdTime(v.Time))
+	}
+	return nil
+}
+
+type duration chrono.Duration
+
+func (d duration) IntervalValue() (pgtype.Interval, error) {
+	return pgtype.Interval{Microseconds: int64(d) / int64(time.Microsecond), Valid: true}, nil
+}
+
+// ScanInterval treats days as 24 hours like chrono.Duration's own Scan, but
+// fails on intervals with months in them since those have no fixed length
+func (d *duration) ScanInterval(v pgtype.Interval) error {
+	if !v.Valid {
+		*d = 0
+		return nil
+	}
+	if v.Months != 0 {
+		return fmt.Errorf("pgxchrono: cannot scan interval with months into chrono.Duration")
+	}
+	*d = duration(time.Duration(v.Days)*24*time.Hour + time.Duration(v.Microseconds)*time.Microsecond)
+	return nil
+}
+
+type period chrono.Period
+
+func (p period) IntervalValue() (pgtype.Interval, error) {
+	return pgtype.Interval{
+		Microseconds: int64(p.Duration) / int64(time.Microsecond),
+		Days:         int32(p.Days),
+		Months:       int32(p.Years*12 + p.Months),
+		Valid:        true,
+	}, nil
+}
+
+func (p *period) ScanInterval(v pgtype.Interval) error {
+	if !v.Valid {
+		*p = period{}
+		return nil
+	}
+	*p = period{
+		Years:    int(v.Months / 12),
+		Months:   int(v.Months % 12),
+		Days:     int(v.Days),
+		Duration: time.Duration(v.Microseconds) * time.Microsecond,
+	}
+	return nil
+}
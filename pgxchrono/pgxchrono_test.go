@@ -0,0 +1,237 @@
+package pgxchrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/pgxchrono"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func newMap() *pgtype.Map {
+	m := pgtype.NewMap()
+	pgxchrono.Register(m)
+	return m
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("", 5*60*60+30*60)
+	tests := []struct {
+		Name  string
+		OID   uint32
+		Value any
+		Dest  func() any
+	}{
+		{"date", pgtype.DateOID, chrono.NewDate(2023, 2, 28), func() any { return new(chrono.Date) }},
+		{"time", pgtype.TimeOID, chrono.NewTime(13, 14, 15, 123456000, time.UTC), func() any { return new(chrono.Time) }},
+		{"timestamp", pgtype.TimestampOID, chrono.NewLocalDateTime(2023, 2, 28, 13, 14, 15, 123456000), func() any { return new(chrono.LocalDateTime) }},
+		{"timestamptz", pgtype.TimestamptzOID, chrono.NewDateTime(2023, 2, 28, 13, 14, 15, 123456000, loc), func() any { return new(chrono.DateTime) }},
+		{"instant", pgtype.TimestamptzOID, chrono.InstantFromUnixMicro(1677590055123456), func() any { return new(chrono.Instant) }},
+		{"duration", pgtype.IntervalOID, chrono.Duration(49*time.Hour + time.Microsecond), func() any { return new(chrono.Duration) }},
+		{"period", pgtype.IntervalOID, chrono.Period{Years: 1, Months: 2, Days: 3, Duration: 4 * time.Hour}, func() any { return new(chrono.Period) }},
+	}
+
+	m := newMap()
+	for _, test := range tests {
+		for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+			buf, err := m.Encode(test.OID, format, test.Value, nil)
+			if err != nil {
+				t.Errorf("%s(%d): failed to encode: %v", test.Name, format, err)
+				continue
+			}
+
+			dest := test.Dest()
+			if err := m.Scan(test.OID, format, buf, dest); err != nil {
+				t.Errorf("%s(%d): failed to scan: %v", test.Name, format, err)
+				continue
+			}
+
+			got := deref(dest)
+			if eq, ok := got.(interface{ Equal(chrono.DateTime) bool }); ok {
+				if !eq.Equal(test.Value.(chrono.DateTime)) {
+					t.Errorf("%s(%d): want: %v, got: %v", test.Name, format, test.Value, got)
+				}
+			} else if got != test.Value {
+				t.Errorf("%s(%d): want: %#v, got: %#v", test.Name, format, test.Value, got)
+			}
+		}
+	}
+}
+
+func deref(v any) any {
+	switch v := v.(type) {
+	case *chrono.Date:
+		return *v
+	case *chrono.Time:
+		return *v
+	case *chrono.LocalDateTime:
+		return *v
+	case *chrono.DateTime:
+		return *v
+	case *chrono.Instant:
+		return *v
+	case *chrono.Duration:
+		return *v
+	case *chrono.Period:
+		return *v
+	}
+	return nil
+}
+
+func TestBinaryWireFormat(t *testing.T) {
+	t.Parallel()
+
+	// Ensure we produce the same bytes as pgx does for the equivalent types
+	std := pgtype.NewMap()
+	m := newMap()
+
+	stdTime := time.Date(2023, 2, 28, 13, 14, 15, 123456000, time.UTC)
+	tests := []struct {
+		Name  string
+		OID   uint32
+		Value any
+		Std   any
+	}{
+		{"date", pgtype.DateOID, chrono.DateFromStdTime(stdTime), pgtype.Date{Time: time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC), Valid: true}},
+		{"time", pgtype.TimeOID, chrono.TimeFromStdTime(stdTime), pgtype.Time{Microseconds: 47655123456, Valid: true}},
+		{"timestamptz", pgtype.TimestamptzOID, chrono.DateTimeFromStdTime(stdTime), stdTime},
+		{"interval", pgtype.IntervalOID, chrono.Period{Months: 14, Days: 3}, pgtype.Interval{Months: 14, Days: 3, Valid: true}},
+	}
+
+	for _, test := range tests {
+		want, err := std.Encode(test.OID, pgtype.BinaryFormatCode, test.Std, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := m.Encode(test.OID, pgtype.BinaryFormatCode, test.Value, nil)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if string(got) != string(want) {
+			t.Errorf("%s: want: %x, got: %x", test.Name, want, got)
+		}
+	}
+}
+
+func TestScanNull(t *testing.T) {
+	t.Parallel()
+
+	m := newMap()
+	d := chrono.NewDate(2023, 2, 28)
+	if err := m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, nil, &d); err != nil {
+		t.Fatal(err)
+	}
+	if !d.IsZero() {
+		t.Error("expected null to scan into the zero value:", d)
+	}
+}
+
+func TestScanInfinity(t *testing.T) {
+	t.Parallel()
+
+	std := pgtype.NewMap()
+	m := newMap()
+
+	tests := []struct {
+		Mod  pgtype.InfinityModifier
+		Date chrono.Date
+		DT   chrono.DateTime
+	}{
+		{pgtype.Infinity, chrono.PosInfinityDate(), chrono.PosInfinity()},
+		{pgtype.NegativeInfinity, chrono.NegInfinityDate(), chrono.NegInfinity()},
+	}
+	for _, test := range tests {
+		buf, err := std.Encode(pgtype.DateOID, pgtype.BinaryFormatCode, pgtype.Date{InfinityModifier: test.Mod, Valid: true}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var d chrono.Date
+		if err := m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, buf, &d); err != nil || !d.Equal(test.Date) {
+			t.Errorf("%s: wrong date: %v %v", test.Mod, d, err)
+		}
+		enc, err := m.Encode(pgtype.DateOID, pgtype.BinaryFormatCode, d, nil)
+		if err != nil || string(enc) != string(buf) {
+			t.Errorf("%s: date did not round trip: %v", test.Mod, err)
+		}
+
+		buf, err = std.Encode(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, pgtype.Timestamptz{InfinityModifier: test.Mod, Valid: true}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var dt chrono.DateTime
+		if err := m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, buf, &dt); err != nil || !dt.Equal(test.DT) {
+			t.Errorf("%s: wrong datetime: %v %v", test.Mod, dt, err)
+		}
+		enc, err = m.Encode(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, dt, nil)
+		if err != nil || string(enc) != string(buf) {
+			t.Errorf("%s: datetime did not round trip: %v", test.Mod, err)
+		}
+	}
+
+	// LocalDateTime has no sentinel for infinity
+	buf, err := std.Encode(pgtype.TimestampOID, pgtype.BinaryFormatCode, pgtype.Timestamp{InfinityModifier: pgtype.Infinity, Valid: true}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var l chrono.LocalDateTime
+	if err := m.Scan(pgtype.TimestampOID, pgtype.BinaryFormatCode, buf, &l); err == nil {
+		t.Error("expected an error scanning infinity")
+	}
+}
+
+func TestScanDurationMonths(t *testing.T) {
+	t.Parallel()
+
+	m := newMap()
+	buf, err := m.Encode(pgtype.IntervalOID, pgtype.BinaryFormatCode, chrono.NewPeriod(0, 1, 0), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var d chrono.Duration
+	if err := m.Scan(pgtype.IntervalOID, pgtype.BinaryFormatCode, buf, &d); err == nil {
+		t.Error("expected an error scanning months into a duration")
+	}
+}
+
+func TestArrays(t *testing.T) {
+	t.Parallel()
+
+	m := newMap()
+	want := []chrono.Date{chrono.NewDate(2023, 1, 1), chrono.NewDate(2024, 2, 29)}
+	buf, err := m.Encode(pgtype.DateArrayOID, pgtype.BinaryFormatCode, want, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []chrono.Date
+	if err := m.Scan(pgtype.DateArrayOID, pgtype.BinaryFormatCode, buf, &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+}
+
+func TestRegisterTwice(t *testing.T) {
+	t.Parallel()
+
+	m := newMap()
+	pgxchrono.Register(m)
+
+	buf, err := m.Encode(pgtype.DateOID, pgtype.BinaryFormatCode, chrono.NewDate(2023, 2, 28), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var d chrono.Date
+	if err := m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, buf, &d); err != nil {
+		t.Fatal(err)
+	}
+	if d != chrono.NewDate(2023, 2, 28) {
+		t.Error("wrong date:", d)
+	}
+}
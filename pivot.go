@@ -0,0 +1,54 @@
+package chrono
+
+import (
+	"strings"
+	"time"
+)
+
+// DateFromLayoutPivot parses a Date from a layout with a two-digit year
+// ("06"), placing the year in the 100 years starting at pivot instead of
+// time.Parse's fixed 1969-2068. For example with a pivot of 1950 "49" is 2049
+// and "50" is 1950. Layouts without a two-digit year parse as DateFromLayout.
+func DateFromLayoutPivot(layout, str string, pivot int) (Date, error) {
+	t, err := parsePivot(layout, str, pivot, time.UTC)
+	if err != nil {
+		return Date{}, &ParseError{Op: "parse", Kind: "date", Input: str, Layout: layout, Err: err}
+	}
+	return DateFromStdTime(t), nil
+}
+
+// DateTimeFromLayoutPivot is like DateFromLayoutPivot but parses a DateTime
+// in loc, as DateTimeFromLayoutLocation does.
+func DateTimeFromLayoutPivot(layout, str string, pivot int, loc *time.Location) (DateTime, error) {
+	t, err := parsePivot(layout, str, pivot, loc)
+	if err != nil {
+		return DateTime{}, &ParseError{Op: "parse", Kind: "datetime", Input: str, Layout: layout, Err: err}
+	}
+	return DateTime{t: t}, nil
+}
+
+func parsePivot(layout, str string, pivot int, loc *time.Location) (time.Time, error) {
+	t, err := time.ParseInLocation(layout, str, loc)
+	if err != nil || !hasTwoDigitYear(layout) {
+		return t, err
+	}
+
+	year := pivot - pivot%100 + t.Year()%100
+	if year < pivot {
+		year += 100
+	}
+	if year == t.Year() {
+		return t, nil
+	}
+
+	// Feb 29th may not exist once the century changes
+	if err := checkDate(year, t.Month(), t.Day()); err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location()), nil
+}
+
+// hasTwoDigitYear reports whether layout uses "06" as opposed to "2006"
+func hasTwoDigitYear(layout string) bool {
+	return strings.Contains(strings.ReplaceAll(layout, "2006", ""), "06")
+}
@@ -0,0 +1,67 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestDateFromLayoutPivot(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Layout string
+		In     string
+		Pivot  int
+		Want   chrono.Date
+	}{
+		{"06-01-02", "69-01-02", 1969, chrono.NewDate(1969, 1, 2)},
+		{"06-01-02", "68-01-02", 1969, chrono.NewDate(2068, 1, 2)},
+		{"06-01-02", "69-01-02", 1970, chrono.NewDate(2069, 1, 2)},
+		{"06-01-02", "49-01-02", 1950, chrono.NewDate(2049, 1, 2)},
+		{"06-01-02", "50-01-02", 1950, chrono.NewDate(1950, 1, 2)},
+		{"060102", "991231", 2000, chrono.NewDate(2099, 12, 31)},
+		{"02/01/06", "02/01/85", 1900, chrono.NewDate(1985, 1, 2)},
+		// Four digit years are left alone
+		{"2006-01-02", "1985-01-02", 2000, chrono.NewDate(1985, 1, 2)},
+		{"20060102", "19850102", 2000, chrono.NewDate(1985, 1, 2)},
+	}
+
+	for _, test := range tests {
+		got, err := chrono.DateFromLayoutPivot(test.Layout, test.In, test.Pivot)
+		if err != nil {
+			t.Errorf("%s: %v", test.In, err)
+			continue
+		}
+		if got != test.Want {
+			t.Errorf("%s (pivot %d): want %v, got %v", test.In, test.Pivot, test.Want, got)
+		}
+	}
+
+	// 2000 is a leap year but 1900 is not
+	_, err := chrono.DateFromLayoutPivot("06-01-02", "00-02-29", 1900)
+	var rerr *chrono.RangeError
+	if !errors.As(err, &rerr) {
+		t.Error("expected a range error, got:", err)
+	}
+	var perr *chrono.ParseError
+	if _, err = chrono.DateFromLayoutPivot("06-01-02", "xx-02-29", 1900); !errors.As(err, &perr) {
+		t.Error("expected a parse error, got:", err)
+	}
+}
+
+func TestDateTimeFromLayoutPivot(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("", -5*60*60)
+	got, err := chrono.DateTimeFromLayoutPivot("060102150405", "700102030405", 2000, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := chrono.NewDateTime(2070, 1, 2, 3, 4, 5, 0, loc)
+	if !got.Equal(want) || got.Location() != loc {
+		t.Error("wrong datetime:", got)
+	}
+}
@@ -0,0 +1,111 @@
+package chrono
+
+import "time"
+
+// The predicates that compare against the current time take a Clock so that
+// they can be controlled in tests, a nil Clock is DefaultClock.
+
+// IsWeekend returns true if d is a Saturday or Sunday
+func (d Date) IsWeekend() bool {
+	return isWeekend(d.t.Weekday())
+}
+
+// IsWeekday returns true if d is Monday through Friday
+func (d Date) IsWeekday() bool {
+	return !d.IsWeekend()
+}
+
+// IsToday returns true if d is the current date in NowLocation, the same
+// date DateFromNow returns
+func (d Date) IsToday(clock Clock) bool {
+	return d == DateFromStdTime(nowFrom(clock))
+}
+
+// IsPast returns true if d is before the current date in NowLocation
+func (d Date) IsPast(clock Clock) bool {
+	return d.Before(DateFromStdTime(nowFrom(clock)))
+}
+
+// IsFuture returns true if d is after the current date in NowLocation
+func (d Date) IsFuture(clock Clock) bool {
+	return d.After(DateFromStdTime(nowFrom(clock)))
+}
+
+// IsSameDay returns true if d and rhs are the same date, the same as ==
+func (d Date) IsSameDay(rhs Date) bool {
+	return d == rhs
+}
+
+// IsSameMonth returns true if d and rhs are in the same month of the same
+// year
+func (d Date) IsSameMonth(rhs Date) bool {
+	return sameMonth(d.t, rhs.t)
+}
+
+// IsSameYear returns true if d and rhs are in the same year
+func (d Date) IsSameYear(rhs Date) bool {
+	return d.t.Year() == rhs.t.Year()
+}
+
+// IsWeekend returns true if d is on a Saturday or Sunday in its location
+func (d DateTime) IsWeekend() bool {
+	return isWeekend(d.t.Weekday())
+}
+
+// IsWeekday returns true if d is on Monday through Friday in its location
+func (d DateTime) IsWeekday() bool {
+	return !d.IsWeekend()
+}
+
+// IsToday returns true if d is on the current date in d's location
+func (d DateTime) IsToday(clock Clock) bool {
+	return sameDay(d.t, nowFrom(clock).In(d.t.Location()))
+}
+
+// IsPast returns true if d is before the current moment
+func (d DateTime) IsPast(clock Clock) bool {
+	return d.t.Before(nowFrom(clock))
+}
+
+// IsFuture returns true if d is after the current moment
+func (d DateTime) IsFuture(clock Clock) bool {
+	return d.t.After(nowFrom(clock))
+}
+
+// IsSameDay returns true if d and rhs are on the same date in d's location
+func (d DateTime) IsSameDay(rhs DateTime) bool {
+	return sameDay(d.t, rhs.t.In(d.t.Location()))
+}
+
+// IsSameMonth returns true if d and rhs are in the same month of the same
+// year in d's location
+func (d DateTime) IsSameMonth(rhs DateTime) bool {
+	return sameMonth(d.t, rhs.t.In(d.t.Location()))
+}
+
+// IsSameYear returns true if d and rhs are in the same year in d's location
+func (d DateTime) IsSameYear(rhs DateTime) bool {
+	return d.t.Year() == rhs.t.In(d.t.Location()).Year()
+}
+
+func isWeekend(wd time.Weekday) bool {
+	return wd == time.Saturday || wd == time.Sunday
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+func equalClock(a, b time.Time) bool {
+	ah, am, as := a.Clock()
+	bh, bm, bs := b.Clock()
+	return ah == bh && am == bm && as == bs && a.Nanosecond() == b.Nanosecond()
+}
+
+func sameMonth(a, b time.Time) bool {
+	ay, am, _ := a.Date()
+	by, bm, _ := b.Date()
+	return ay == by && am == bm
+}
@@ -0,0 +1,88 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestDatePredicates(t *testing.T) {
+	t.Parallel()
+
+	// Noon UTC is the same date in nearly every NowLocation
+	clock := chrono.ClockFunc(func() chrono.DateTime {
+		return chrono.NewDateTime(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+	})
+
+	today := chrono.NewDate(2024, 3, 15)
+	sat := chrono.NewDate(2024, 3, 16)
+
+	if today.IsWeekend() || !today.IsWeekday() {
+		t.Error("friday is a weekday")
+	}
+	if !sat.IsWeekend() || sat.IsWeekday() {
+		t.Error("saturday is a weekend")
+	}
+
+	if !today.IsToday(clock) || today.IsPast(clock) || today.IsFuture(clock) {
+		t.Error("wrong predicates for today")
+	}
+	if sat.IsToday(clock) || sat.IsPast(clock) || !sat.IsFuture(clock) {
+		t.Error("wrong predicates for tomorrow")
+	}
+	if yesterday := today.AddDate(0, 0, -1); !yesterday.IsPast(clock) || yesterday.IsFuture(clock) {
+		t.Error("wrong predicates for yesterday")
+	}
+
+	if !today.IsSameDay(chrono.NewDate(2024, 3, 15)) || today.IsSameDay(sat) {
+		t.Error("wrong same day")
+	}
+	if !today.IsSameMonth(chrono.NewDate(2024, 3, 1)) || today.IsSameMonth(chrono.NewDate(2023, 3, 15)) {
+		t.Error("wrong same month")
+	}
+	if !today.IsSameYear(chrono.NewDate(2024, 12, 31)) || today.IsSameYear(chrono.NewDate(2025, 1, 1)) {
+		t.Error("wrong same year")
+	}
+}
+
+func TestDateTimePredicates(t *testing.T) {
+	t.Parallel()
+
+	now := chrono.NewDateTime(2024, 3, 15, 23, 0, 0, 0, time.UTC)
+	clock := chrono.ClockFunc(func() chrono.DateTime { return now })
+	east := time.FixedZone("", 2*60*60)
+
+	// 23:00 UTC friday is 01:00 saturday two hours east
+	if now.IsWeekend() || !now.In(east).IsWeekend() || now.In(east).IsWeekday() {
+		t.Error("wrong weekend")
+	}
+
+	if !now.IsToday(clock) || !now.In(east).IsToday(clock) {
+		t.Error("now is always today")
+	}
+	if morning := chrono.NewDateTime(2024, 3, 15, 1, 0, 0, 0, east); morning.IsToday(clock) {
+		t.Error("friday morning is not today two hours east")
+	}
+
+	if now.IsPast(clock) || now.IsFuture(clock) {
+		t.Error("now is neither past nor future")
+	}
+	if !now.Add(-time.Nanosecond).IsPast(clock) || !now.Add(time.Nanosecond).IsFuture(clock) {
+		t.Error("wrong past or future")
+	}
+
+	if !now.IsSameDay(chrono.NewDateTime(2024, 3, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Error("should be the same day")
+	}
+	if now.In(east).IsSameDay(chrono.NewDateTime(2024, 3, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Error("should be compared in the location of d")
+	}
+	if !now.IsSameMonth(chrono.NewDateTime(2024, 3, 31, 23, 0, 0, 0, time.UTC)) || now.IsSameMonth(chrono.NewDateTime(2024, 4, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("wrong same month")
+	}
+	newYear := chrono.NewDateTime(2024, 12, 31, 23, 0, 0, 0, time.UTC)
+	if !now.IsSameYear(newYear) || newYear.In(east).IsSameYear(now) {
+		t.Error("wrong same year")
+	}
+}
@@ -0,0 +1,82 @@
+package chrono
+
+// The proration helpers measure intervals in calendar days of the location
+// of the interval's Start. Every day counts as one whole day no matter how
+// long it is, so a day that DST makes 23 or 25 hours long is billed the same
+// as any other, and part of a day counts in proportion to the day's actual
+// length.
+
+// CalendarDays returns the number of calendar days the interval covers, eg.
+// noon on the 1st to noon on the 3rd is 2 days. An empty interval is 0 days.
+func (i Interval) CalendarDays() float64 {
+	var days float64
+	i.eachDay(func(_ Date, frac float64) {
+		days += frac
+	})
+	return days
+}
+
+// FractionOf returns the fraction of period that the interval covers in
+// calendar days, eg. how much of a billing period a subscription was active.
+// It's between 0 and 1, or 0 if period is empty.
+func (i Interval) FractionOf(period Interval) float64 {
+	total := period.CalendarDays()
+	if total == 0 {
+		return 0
+	}
+	overlap, ok := i.Intersect(period)
+	if !ok {
+		return 0
+	}
+	overlap.Start = overlap.Start.In(period.Start.t.Location())
+	return overlap.CalendarDays() / total
+}
+
+// MonthFraction returns how many calendar months the interval covers where
+// each day is worth 1/n of a month with n days, eg. all of February and half
+// of March is 1.5.
+func (i Interval) MonthFraction() float64 {
+	return i.ProrateDaily(1)
+}
+
+// ProrateDaily returns the part of a monthly amount that is owed for the
+// interval, prorated by the day. Each day is worth amount/n where n is the
+// number of days in its month, so an interval covering all of February costs
+// the same as one covering all of March.
+func (i Interval) ProrateDaily(amount float64) float64 {
+	var total float64
+	i.eachDay(func(d Date, frac float64) {
+		total += amount * frac / float64(daysIn(d.Month(), d.Year()))
+	})
+	return total
+}
+
+// eachDay calls fn with each date the interval covers in the location of
+// Start and the fraction of that date that it covers
+func (i Interval) eachDay(fn func(d Date, frac float64)) {
+	if i.IsEmpty() {
+		return
+	}
+
+	loc := i.Start.t.Location()
+	start, end := i.Start.t, i.End.t
+	for day := DateFromStdTime(start); ; day = day.AddDate(0, 0, 1) {
+		year, month, dom := day.Date()
+		dayStart := startOfDay(year, month, dom, loc)
+		dayEnd := startOfDay(year, month, dom+1, loc)
+		if !dayStart.Before(end) {
+			return
+		}
+
+		from, to := dayStart, dayEnd
+		if start.After(from) {
+			from = start
+		}
+		if end.Before(to) {
+			to = end
+		}
+		if to.After(from) {
+			fn(day, float64(to.Sub(from))/float64(dayEnd.Sub(dayStart)))
+		}
+	}
+}
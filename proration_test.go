@@ -0,0 +1,72 @@
+package chrono_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func floatEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestIntervalCalendarDays(t *testing.T) {
+	t.Parallel()
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Interval chrono.Interval
+		Want     float64
+	}{
+		{chrono.NewInterval(chrono.NewDateTime(2024, 1, 1, 12, 0, 0, 0, time.UTC), chrono.NewDateTime(2024, 1, 3, 12, 0, 0, 0, time.UTC)), 2},
+		{chrono.NewInterval(chrono.NewDateTime(2024, 1, 1, 0, 0, 0, 0, time.UTC), chrono.NewDateTime(2024, 1, 1, 6, 0, 0, 0, time.UTC)), 0.25},
+		{chrono.NewInterval(chrono.NewDateTime(2024, 1, 3, 0, 0, 0, 0, time.UTC), chrono.NewDateTime(2024, 1, 1, 0, 0, 0, 0, time.UTC)), 0},
+		// The 23 hour day of spring forward is still one day
+		{chrono.NewInterval(chrono.NewDateTime(2024, 3, 10, 0, 0, 0, 0, ny), chrono.NewDateTime(2024, 3, 11, 0, 0, 0, 0, ny)), 1},
+		// 11.5 of its 23 hours is half a day
+		{chrono.NewInterval(chrono.NewDateTime(2024, 3, 10, 0, 0, 0, 0, ny), chrono.NewDateTime(2024, 3, 10, 0, 0, 0, 0, ny).Add(11*time.Hour+30*time.Minute)), 0.5},
+		// End in another location is measured in Start's
+		{chrono.NewInterval(chrono.NewDateTime(2024, 3, 9, 0, 0, 0, 0, ny), chrono.NewDateTime(2024, 3, 11, 4, 0, 0, 0, time.UTC)), 2},
+	}
+	for _, test := range tests {
+		if got := test.Interval.CalendarDays(); !floatEqual(got, test.Want) {
+			t.Errorf("%s: want %v, got %v", test.Interval, test.Want, got)
+		}
+	}
+}
+
+func TestIntervalProrate(t *testing.T) {
+	t.Parallel()
+
+	feb := chrono.NewInterval(chrono.NewDateTime(2024, 2, 1, 0, 0, 0, 0, time.UTC), chrono.NewDateTime(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	if got := feb.ProrateDaily(29); !floatEqual(got, 29) {
+		t.Error("all of february should be the full amount:", got)
+	}
+
+	// All of February and the first half of April
+	i := chrono.NewInterval(chrono.NewDateTime(2024, 2, 1, 0, 0, 0, 0, time.UTC), chrono.NewDateTime(2024, 4, 16, 0, 0, 0, 0, time.UTC))
+	if got := i.MonthFraction(); !floatEqual(got, 2.5) {
+		t.Error("wrong month fraction:", got)
+	}
+	if got := i.ProrateDaily(10); !floatEqual(got, 25) {
+		t.Error("wrong prorated amount:", got)
+	}
+
+	period := chrono.NewInterval(chrono.NewDateTime(2024, 1, 1, 0, 0, 0, 0, time.UTC), chrono.NewDateTime(2024, 1, 11, 0, 0, 0, 0, time.UTC))
+	active := chrono.NewInterval(chrono.NewDateTime(2024, 1, 8, 0, 0, 0, 0, time.UTC), chrono.NewDateTime(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	if got := active.FractionOf(period); !floatEqual(got, 0.3) {
+		t.Error("wrong fraction:", got)
+	}
+	if got := feb.FractionOf(period); got != 0 {
+		t.Error("no overlap should be 0:", got)
+	}
+	if got := feb.FractionOf(chrono.Interval{}); got != 0 {
+		t.Error("empty period should be 0:", got)
+	}
+}
@@ -0,0 +1,49 @@
+package chrono
+
+import (
+	"math/rand"
+	"reflect"
+	"time"
+)
+
+const (
+	// quickMinUnix and quickMaxUnix bound the values generated for
+	// testing/quick to the years 0001-9999 which every encoding in this
+	// package supports
+	quickMinUnix = -62135596800
+	quickMaxUnix = 253402300799
+	// quickMaxOffset is the largest zone offset in use, UTC+14
+	quickMaxOffset = 14 * 60 * 60
+)
+
+// Generate implements quick.Generator. Dates are chosen uniformly from the
+// years 0001-9999.
+func (Date) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(DateFromUnix(quickUnix(r), 0))
+}
+
+// Generate implements quick.Generator. Times are chosen uniformly from the
+// whole day with nanosecond precision.
+func (Time) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(TimeFromStdTime(time.Unix(0, r.Int63n(int64(dayDuration))).UTC()))
+}
+
+// Generate implements quick.Generator. DateTimes are chosen uniformly from the
+// years 0001-9999 with nanosecond precision, half of them in UTC and the rest
+// in a fixed zone with an offset that is a multiple of 15 minutes.
+func (DateTime) Generate(r *rand.Rand, _ int) reflect.Value {
+	t := time.Unix(quickUnix(r), r.Int63n(int64(time.Second))).UTC()
+	if r.Intn(2) == 1 {
+		offset := (r.Intn(2*quickMaxOffset/900+1) - quickMaxOffset/900) * 900
+		t = t.In(time.FixedZone("", offset))
+		// Keep the wall clock inside the supported years as well
+		if t.Year() < 1 || t.Year() > 9999 {
+			t = t.UTC()
+		}
+	}
+	return reflect.ValueOf(DateTime{t: t})
+}
+
+func quickUnix(r *rand.Rand) int64 {
+	return quickMinUnix + r.Int63n(quickMaxUnix-quickMinUnix+1)
+}
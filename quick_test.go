@@ -0,0 +1,61 @@
+package chrono_test
+
+import (
+	"testing"
+	"testing/quick"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestQuickDate(t *testing.T) {
+	t.Parallel()
+
+	roundTrip := func(d chrono.Date) bool {
+		var text, binary chrono.Date
+		b, _ := d.MarshalBinary()
+		if err := binary.UnmarshalBinary(b); err != nil {
+			return false
+		}
+		if err := text.UnmarshalText([]byte(d.String())); err != nil {
+			return false
+		}
+		return d.Equal(text) && d.Equal(binary)
+	}
+
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestQuickTime(t *testing.T) {
+	t.Parallel()
+
+	roundTrip := func(tm chrono.Time) bool {
+		got, err := chrono.TimeFromString(tm.String())
+		if err != nil {
+			return false
+		}
+		return got.Hour() == tm.Hour() && got.Minute() == tm.Minute() && got.Second() == tm.Second()
+	}
+
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestQuickDateTime(t *testing.T) {
+	t.Parallel()
+
+	roundTrip := func(d chrono.DateTime) bool {
+		var got chrono.DateTime
+		b, _ := d.MarshalText()
+		if err := got.UnmarshalText(b); err != nil {
+			return false
+		}
+		return d.Equal(got) && d.ToDate().Year() >= 1 && d.ToDate().Year() <= 9999
+	}
+
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,186 @@
+package chrono
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// rfc2822Layout is used for formatting and for reporting parse errors
+const rfc2822Layout = time.RFC1123Z
+
+// rfc2822Zones are the obsolete zone names allowed by RFC 2822 section 4.3
+var rfc2822Zones = map[string]int{
+	"UT": 0, "GMT": 0,
+	"EST": -5 * 60 * 60, "EDT": -4 * 60 * 60,
+	"CST": -6 * 60 * 60, "CDT": -5 * 60 * 60,
+	"MST": -7 * 60 * 60, "MDT": -6 * 60 * 60,
+	"PST": -8 * 60 * 60, "PDT": -7 * 60 * 60,
+}
+
+// DateTimeFromRFC2822 parses a date as found in email Date headers and some
+// RSS feeds, eg. "Mon, 2 Jan 2006 15:04:05 -0700". The weekday, seconds and
+// comments such as "(PST)" are optional, and the obsolete forms are accepted:
+// two and three digit years, zone names like GMT and EST, and military zones
+// (which are treated as UTC as the RFC recommends). A zone of -0000 is UTC.
+func DateTimeFromRFC2822(str string) (DateTime, error) {
+	t, err := parseRFC2822(str)
+	if err != nil {
+		return DateTime{}, &ParseError{Op: "parse", Kind: "datetime", Input: str, Layout: rfc2822Layout, Err: err}
+	}
+	return DateTime{t: t}, nil
+}
+
+// FormatRFC2822 formats the datetime for an email Date header, eg.
+// "Mon, 02 Jan 2006 15:04:05 -0700"
+func (d DateTime) FormatRFC2822() string {
+	return d.t.Format(rfc2822Layout)
+}
+
+func parseRFC2822(str string) (time.Time, error) {
+	str, err := stripRFC2822Comments(str)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if comma := strings.IndexByte(str, ','); comma >= 0 {
+		day := strings.TrimSpace(str[:comma])
+		if !isWeekdayAbbr(day) {
+			return time.Time{}, fmt.Errorf("invalid weekday %q", day)
+		}
+		str = str[comma+1:]
+	}
+
+	fields := strings.Fields(str)
+	if len(fields) != 5 {
+		return time.Time{}, errors.New("expected day month year time zone")
+	}
+
+	day, ok := atoiN(fields[0], len(fields[0]))
+	if !ok || len(fields[0]) > 2 {
+		return time.Time{}, fmt.Errorf("invalid day %q", fields[0])
+	}
+	month, ok := monthAbbr(fields[1])
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid month %q", fields[1])
+	}
+	year, ok := atoiN(fields[2], len(fields[2]))
+	switch {
+	case !ok || len(fields[2]) < 2:
+		return time.Time{}, fmt.Errorf("invalid year %q", fields[2])
+	case len(fields[2]) == 2 && year < 50:
+		year += 2000
+	case len(fields[2]) <= 3:
+		year += 1900
+	}
+
+	hour, min, sec, ok := parseRFC2822Clock(fields[3])
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid time %q", fields[3])
+	}
+	offset, utc, ok := parseRFC2822Zone(fields[4])
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid zone %q", fields[4])
+	}
+
+	if err := checkDate(year, month, day); err != nil {
+		return time.Time{}, err
+	}
+	if err := checkClock(hour, min, sec, 0); err != nil {
+		return time.Time{}, err
+	}
+	return sqlTime(year, int(month), day, hour, min, sec, 0, offset, utc), nil
+}
+
+// stripRFC2822Comments removes (possibly nested) comments
+func stripRFC2822Comments(str string) (string, error) {
+	if strings.IndexByte(str, '(') < 0 {
+		return str, nil
+	}
+
+	var b strings.Builder
+	depth := 0
+	for i := 0; i < len(str); i++ {
+		switch c := str[i]; {
+		case c == '(':
+			depth++
+		case c == ')':
+			if depth == 0 {
+				return "", errors.New("unbalanced comment")
+			}
+			depth--
+			b.WriteByte(' ')
+		case depth == 0:
+			b.WriteByte(c)
+		}
+	}
+	if depth != 0 {
+		return "", errors.New("unbalanced comment")
+	}
+	return b.String(), nil
+}
+
+// parseRFC2822Clock parses hh:mm or hh:mm:ss
+func parseRFC2822Clock(str string) (hour, min, sec int, ok bool) {
+	if len(str) != 5 && len(str) != 8 {
+		return 0, 0, 0, false
+	}
+	hour, ok1 := digits2(str, 0)
+	min, ok2 := digits2(str, 3)
+	if !ok1 || !ok2 || str[2] != ':' {
+		return 0, 0, 0, false
+	}
+	if len(str) == 8 {
+		if sec, ok = digits2(str, 6); !ok || str[5] != ':' {
+			return 0, 0, 0, false
+		}
+	}
+	return hour, min, sec, true
+}
+
+// parseRFC2822Zone parses +hhmm, -hhmm and the obsolete zone names
+func parseRFC2822Zone(str string) (offset int, utc bool, ok bool) {
+	if len(str) == 5 && (str[0] == '+' || str[0] == '-') {
+		hour, ok1 := digits2(str, 1)
+		min, ok2 := digits2(str, 3)
+		if !ok1 || !ok2 || min > 59 {
+			return 0, false, false
+		}
+		offset = hour*60*60 + min*60
+		if str[0] == '-' {
+			offset = -offset
+		}
+		// -0000 means the local zone is unknown, UTC is the best we can do
+		return offset, offset == 0, true
+	}
+
+	str = strings.ToUpper(str)
+	if offset, ok := rfc2822Zones[str]; ok {
+		return offset, offset == 0, true
+	}
+	if len(str) == 1 && str[0] >= 'A' && str[0] <= 'Z' && str[0] != 'J' {
+		return 0, true, true
+	}
+	return 0, false, false
+}
+
+// isWeekdayAbbr reports whether str is a three letter weekday name
+func isWeekdayAbbr(str string) bool {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(str, d.String()[:3]) {
+			return true
+		}
+	}
+	return false
+}
+
+// monthAbbr returns the month for a three letter month name
+func monthAbbr(str string) (time.Month, bool) {
+	for m := time.January; m <= time.December; m++ {
+		if strings.EqualFold(str, m.String()[:3]) {
+			return m, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,84 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestDateTimeFromRFC2822(t *testing.T) {
+	t.Parallel()
+
+	minus7 := time.FixedZone("", -7*60*60)
+	tests := []struct {
+		In   string
+		Want chrono.DateTime
+	}{
+		{"Mon, 02 Jan 2006 15:04:05 -0700", chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, minus7)},
+		{"2 Jan 2006 15:04:05 -0700", chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, minus7)},
+		{"Mon,2 Jan 2006 15:04 -0700", chrono.NewDateTime(2006, 1, 2, 15, 4, 0, 0, minus7)},
+		{"mon, 02 JAN 2006 15:04:05 +0000", chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"Mon, 02 Jan 2006 15:04:05 -0000", chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"Mon, 02 Jan 2006 15:04:05 GMT", chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"Mon, 02 Jan 2006 15:04:05 MST", chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, minus7)},
+		{"Mon, 02 Jan 2006 15:04:05 PDT", chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, minus7)},
+		{"Mon, 02 Jan 2006 15:04:05 Z", chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"Mon, 02 Jan 2006 15:04:05 -0700 (MST)", chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, minus7)},
+		{"Mon,  02\tJan 2006\r\n 15:04:05 -0700", chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, minus7)},
+		{"02 Jan 06 15:04:05 GMT", chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"02 Jan 99 15:04:05 GMT", chrono.NewDateTime(1999, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"02 Jan 106 15:04:05 GMT", chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		got, err := chrono.DateTimeFromRFC2822(test.In)
+		if err != nil {
+			t.Errorf("%q: %v", test.In, err)
+			continue
+		}
+		if !got.Equal(test.Want) {
+			t.Errorf("%q: want %v, got %v", test.In, test.Want, got)
+		}
+		_, gotOff := got.Zone()
+		_, wantOff := test.Want.Zone()
+		if gotOff != wantOff {
+			t.Errorf("%q: want offset %d, got %d", test.In, wantOff, gotOff)
+		}
+	}
+
+	bad := []string{
+		"",
+		"Mon, 02 Jan 2006 15:04:05",
+		"Xyz, 02 Jan 2006 15:04:05 -0700",
+		"Mon, 02 Foo 2006 15:04:05 -0700",
+		"Mon, 30 Feb 2006 15:04:05 -0700",
+		"Mon, 02 Jan 2006 25:04:05 -0700",
+		"Mon, 02 Jan 2006 15:4:05 -0700",
+		"Mon, 02 Jan 2006 15:04:05 -07",
+		"Mon, 02 Jan 2006 15:04:05 J",
+		"Mon, 02 Jan 2006 15:04:05 -0700 (MST",
+		"Mon, 02 Jan 2006 15:04:05 -0700 extra",
+	}
+	for _, in := range bad {
+		_, err := chrono.DateTimeFromRFC2822(in)
+		var perr *chrono.ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("%q: expected a parse error, got: %v", in, err)
+		}
+	}
+}
+
+func TestDateTimeFormatRFC2822(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewDateTime(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", -7*60*60))
+	if got := ref.FormatRFC2822(); got != "Mon, 02 Jan 2006 15:04:05 -0700" {
+		t.Error("wrong format:", got)
+	}
+	back, err := chrono.DateTimeFromRFC2822(ref.FormatRFC2822())
+	if err != nil || !back.Equal(ref) {
+		t.Error("round trip failed:", back, err)
+	}
+}
@@ -0,0 +1,134 @@
+package chrono
+
+import "sync"
+
+// Scheduler runs callbacks at specific times, on a fixed Period, or on a
+// CronRule. Each job runs in its own goroutine and its callbacks never
+// overlap, if a callback runs past the next scheduled time that run happens
+// as soon as it returns.
+//
+// Every scheduling method returns a cancel function that stops the job. It is
+// safe to call more than once and from inside the job's own callback, but it
+// does not wait for a callback that is already running.
+type Scheduler struct {
+	clock TimerClock
+
+	mut    sync.Mutex
+	nextID int
+	jobs   map[int]chan struct{}
+}
+
+// NewScheduler creates a scheduler. If clock is nil DefaultClock is used,
+// waiting with time.After if it isn't a TimerClock.
+func NewScheduler(clock TimerClock) *Scheduler {
+	if clock == nil {
+		clock = defaultTimerClock()
+	}
+	return &Scheduler{clock: clock, jobs: make(map[int]chan struct{})}
+}
+
+// At runs fn once at d. If d is in the past fn is run immediately.
+func (s *Scheduler) At(d DateTime, fn func()) (cancel func()) {
+	done := false
+	return s.schedule(fn, func() (DateTime, bool) {
+		if done {
+			return DateTime{}, false
+		}
+		done = true
+		return d, true
+	})
+}
+
+// Every runs fn each time the period p elapses, starting one period from now.
+// Each run is scheduled from the start time rather than the previous run so
+// that calendar periods don't drift, eg. every month from the 30th runs on
+// the 30th of every month, except in February where time.Time normalization
+// makes it the 2nd of March. It panics if p does not move time forward.
+func (s *Scheduler) Every(p Period, fn func()) (cancel func()) {
+	start := s.clock.Now()
+	if !addPeriod(start.t, p, 1).After(start.t) {
+		panic("chrono: non-positive period for Scheduler.Every")
+	}
+
+	n := 0
+	return s.schedule(fn, func() (DateTime, bool) {
+		n++
+		return DateTime{t: addPeriod(start.t, p, n)}, true
+	})
+}
+
+// Cron runs fn each time the rule matches. Times are matched in the location
+// of the scheduler's clock.
+func (s *Scheduler) Cron(rule CronRule, fn func()) (cancel func()) {
+	var last DateTime
+	return s.schedule(fn, func() (DateTime, bool) {
+		after := s.clock.Now()
+		if after.Before(last) {
+			after = last
+		}
+		last = rule.Next(after)
+		return last, !last.IsZero()
+	})
+}
+
+// Stop cancels every job in the scheduler
+func (s *Scheduler) Stop() {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	for id, stop := range s.jobs {
+		close(stop)
+		delete(s.jobs, id)
+	}
+}
+
+// schedule starts a job that runs fn at each time returned by next until it
+// returns false or the job is cancelled
+func (s *Scheduler) schedule(fn func(), next func() (DateTime, bool)) func() {
+	s.mut.Lock()
+	id := s.nextID
+	s.nextID++
+	stop := make(chan struct{})
+	s.jobs[id] = stop
+	s.mut.Unlock()
+
+	cancel := func() {
+		s.mut.Lock()
+		defer s.mut.Unlock()
+
+		if _, ok := s.jobs[id]; ok {
+			close(stop)
+			delete(s.jobs, id)
+		}
+	}
+
+	go func() {
+		defer cancel()
+
+		for {
+			at, ok := next()
+			if !ok {
+				return
+			}
+
+			if wait := at.Sub(s.clock.Now()); wait > 0 {
+				select {
+				case <-stop:
+					return
+				case <-s.clock.After(wait):
+				}
+			}
+
+			// Cancellation wins if it happened while we were waiting
+			select {
+			case <-stop:
+				return
+			default:
+			}
+
+			fn()
+		}
+	}()
+
+	return cancel
+}
@@ -0,0 +1,100 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestSchedulerAt(t *testing.T) {
+	t.Parallel()
+
+	clock := &jumpClock{now: chrono.NewDateTime(2022, 6, 15, 12, 0, 0, 0, time.UTC)}
+	s := chrono.NewScheduler(clock)
+	defer s.Stop()
+
+	at := chrono.NewDateTime(2022, 6, 15, 18, 0, 0, 0, time.UTC)
+	ran := make(chan chrono.DateTime)
+	s.At(at, func() { ran <- clock.Now() })
+
+	if got := <-ran; !got.Equal(at) {
+		t.Error("ran at the wrong time", got)
+	}
+}
+
+func TestSchedulerEvery(t *testing.T) {
+	t.Parallel()
+
+	clock := &jumpClock{now: chrono.NewDateTime(2022, 1, 31, 12, 0, 0, 0, time.UTC)}
+	s := chrono.NewScheduler(clock)
+
+	ran := make(chan chrono.DateTime, 10)
+	cancel := make(chan func(), 1)
+	runs := 0
+	cancel <- s.Every(chrono.NewPeriod(0, 1, 0), func() {
+		ran <- clock.Now()
+		runs++
+		if runs == 3 {
+			(<-cancel)()
+		}
+	})
+
+	// Runs are always relative to the start so the short month doesn't
+	// shift later runs
+	want := []chrono.DateTime{
+		chrono.NewDateTime(2022, 3, 3, 12, 0, 0, 0, time.UTC),
+		chrono.NewDateTime(2022, 3, 31, 12, 0, 0, 0, time.UTC),
+		chrono.NewDateTime(2022, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+	for _, w := range want {
+		if got := <-ran; !got.Equal(w) {
+			t.Errorf("want %s, got %s", w, got)
+		}
+	}
+
+	// Give a cancelled job the chance to misbehave
+	time.Sleep(10 * time.Millisecond)
+	if len(ran) != 0 {
+		t.Error("job ran after being cancelled")
+	}
+}
+
+func TestSchedulerEveryPanics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+
+	chrono.NewScheduler(nil).Every(chrono.Period{}, func() {})
+}
+
+func TestSchedulerCron(t *testing.T) {
+	t.Parallel()
+
+	clock := &jumpClock{now: chrono.NewDateTime(2022, 6, 15, 12, 10, 0, 0, time.UTC)}
+	s := chrono.NewScheduler(clock)
+	defer s.Stop()
+
+	rule, err := chrono.ParseCronRule("0,30 * * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ran := make(chan chrono.DateTime)
+	s.Cron(rule, func() { ran <- clock.Now() })
+
+	want := []chrono.DateTime{
+		chrono.NewDateTime(2022, 6, 15, 12, 30, 0, 0, time.UTC),
+		chrono.NewDateTime(2022, 6, 15, 13, 0, 0, 0, time.UTC),
+		chrono.NewDateTime(2022, 6, 15, 13, 30, 0, 0, time.UTC),
+	}
+	for _, w := range want {
+		if got := <-ran; !got.Equal(w) {
+			t.Errorf("want %s, got %s", w, got)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package chrono
+
+import (
+	"math"
+	"time"
+)
+
+// SQLStorageClass selects how Date and DateTime are written by Value. SQLite
+// has no date types and stores them in whichever class the application
+// chooses. Scan accepts all three regardless of this setting.
+type SQLStorageClass int
+
+// SQL storage classes
+const (
+	// SQLStorageText is an ISO8601 string, eg. "2006-01-02 15:04:05-07"
+	SQLStorageText SQLStorageClass = iota
+	// SQLStorageReal is a floating point Julian Day, what SQLite's
+	// julianday() returns. It has millisecond precision.
+	SQLStorageReal
+	// SQLStorageInteger is a unix timestamp in seconds, what SQLite's
+	// unixepoch() returns
+	SQLStorageInteger
+	// SQLStorageTime is a time.Time, for drivers such as pgx or mysql with
+	// parseTime that prefer to do their own formatting. A Date is midnight
+	// UTC.
+	SQLStorageTime
+)
+
+// SQLValueStorage is the storage class Date.Value and DateTime.Value use.
+// Despite the name it is not specific to SQLite.
+var SQLValueStorage = SQLStorageText
+
+const (
+	// minScanJulianDay and maxScanJulianDay are the Julian Days of
+	// 0000-01-01 and 9999-12-31. A float64 scanned inside of this range is
+	// taken to be a Julian Day rather than a unix timestamp, which only
+	// confuses the first two months of 1970.
+	minScanJulianDay = 1721059.5
+	maxScanJulianDay = 5373484.5
+)
+
+// isScanJulianDay reports whether a scanned float is a Julian Day
+func isScanJulianDay(f float64) bool {
+	return f >= minScanJulianDay && f <= maxScanJulianDay
+}
+
+// julianDayToTime converts a Julian Day to a time in UTC rounded to the
+// millisecond. A float64 Julian Day is only precise to tens of microseconds
+// and SQLite itself only works in milliseconds.
+func julianDayToTime(jd float64) time.Time {
+	msec := math.Round((jd - unixEpochJulianDay) * secondsPerDay * 1e3)
+	return time.UnixMilli(int64(msec)).UTC()
+}
+
+// timeToJulianDay converts a time to a fractional Julian Day
+func timeToJulianDay(t time.Time) float64 {
+	return float64(t.UnixMicro())/(secondsPerDay*1e6) + unixEpochJulianDay
+}
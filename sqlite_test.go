@@ -0,0 +1,108 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestSQLiteScan(t *testing.T) {
+	t.Parallel()
+
+	var date chrono.Date
+	// SELECT julianday('2000-01-02')
+	if err := date.Scan(2451545.5); err != nil {
+		t.Error(err)
+	}
+	if !date.Equal(chrono.NewDate(2000, 1, 2)) {
+		t.Error("julian day date wrong:", date)
+	}
+	// SELECT unixepoch('2000-01-02')
+	if err := date.Scan(int64(946771200)); err != nil {
+		t.Error(err)
+	}
+	if !date.Equal(chrono.NewDate(2000, 1, 2)) {
+		t.Error("epoch date wrong:", date)
+	}
+
+	var datetime chrono.DateTime
+	// SELECT julianday('2000-01-02 03:04:05.123')
+	if err := datetime.Scan(2451545.627837072); err != nil {
+		t.Error(err)
+	}
+	want := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 123000000, time.UTC)
+	if got := datetime.Sub(want); got < -time.Millisecond || got > time.Millisecond {
+		t.Error("julian day datetime wrong:", datetime)
+	}
+	if err := datetime.Scan(int64(946782245)); err != nil {
+		t.Error(err)
+	}
+	if !datetime.Equal(chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Error("epoch datetime wrong:", datetime)
+	}
+	// Floats outside of the Julian Day range are still unix timestamps
+	if err := datetime.Scan(float64(946782245)); err != nil {
+		t.Error(err)
+	}
+	if !datetime.Equal(chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Error("float epoch datetime wrong:", datetime)
+	}
+}
+
+// TestSQLValueStorage cannot be parallel since it changes a package variable
+func TestSQLValueStorage(t *testing.T) {
+	defer func() { chrono.SQLValueStorage = chrono.SQLStorageText }()
+
+	date := chrono.NewDate(2000, 1, 2)
+	datetime := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 500000000, time.FixedZone("", 60*60))
+
+	chrono.SQLValueStorage = chrono.SQLStorageReal
+	if v, err := date.Value(); err != nil {
+		t.Error(err)
+	} else if v != 2451545.5 {
+		t.Error("date real wrong:", v)
+	}
+	v, err := datetime.Value()
+	if err != nil {
+		t.Error(err)
+	}
+	var got chrono.DateTime
+	if err := got.Scan(v); err != nil {
+		t.Error(err)
+	}
+	if !got.Equal(datetime) {
+		t.Errorf("datetime real did not round trip: %v (%v)", got, v)
+	}
+
+	chrono.SQLValueStorage = chrono.SQLStorageInteger
+	if v, err := date.Value(); err != nil {
+		t.Error(err)
+	} else if v != int64(946771200) {
+		t.Error("date integer wrong:", v)
+	}
+	if v, err := datetime.Value(); err != nil {
+		t.Error(err)
+	} else if v != int64(946778645) {
+		t.Error("datetime integer wrong:", v)
+	}
+
+	chrono.SQLValueStorage = chrono.SQLStorageTime
+	if v, err := date.Value(); err != nil {
+		t.Error(err)
+	} else if v != time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC) {
+		t.Error("date time wrong:", v)
+	}
+	if v, err := datetime.Value(); err != nil {
+		t.Error(err)
+	} else if tm, ok := v.(time.Time); !ok || !tm.Equal(datetime.ToStdTime()) {
+		t.Error("datetime time wrong:", v)
+	}
+
+	chrono.SQLValueStorage = chrono.SQLStorageText
+	if v, err := date.Value(); err != nil {
+		t.Error(err)
+	} else if v != "2000-01-02" {
+		t.Error("date text wrong:", v)
+	}
+}
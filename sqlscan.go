@@ -0,0 +1,320 @@
+package chrono
+
+import (
+	"sync"
+	"time"
+)
+
+// The functions in this file are fast paths for scanning the canonical SQL
+// layouts (DateTimeSQLLayout etc.) straight out of a driver's []byte without
+// allocating. They return false for anything they don't understand and the
+// caller falls back to time.Parse, so they only need to be correct for what
+// they accept.
+
+// byteString is anything that can be scanned by the sql fast paths
+type byteString interface {
+	~string | ~[]byte
+}
+
+// scanLayouts are the extra layouts added with RegisterScanLayout
+var scanLayouts struct {
+	sync.RWMutex
+	layouts []string
+}
+
+// RegisterScanLayout adds a layout that DateTime.Scan will try when a driver
+// returns a string that is neither in DateTimeSQLLayout nor RFC3339. Layouts
+// are tried in the order they were registered. It is safe for concurrent use
+// but is intended to be called during program initialization.
+func RegisterScanLayout(layout string) {
+	scanLayouts.Lock()
+	defer scanLayouts.Unlock()
+	scanLayouts.layouts = append(scanLayouts.layouts, layout)
+}
+
+// scanDateTime parses a datetime string returned by a driver. The error
+// returned is the one from DateTimeSQLLayout since that's the layout that
+// was expected.
+func scanDateTime(str string) (time.Time, error) {
+	if t, ok := parseSQLDateTime(str); ok {
+		return t, nil
+	}
+
+	t, sqlErr := time.Parse(DateTimeSQLLayout, str)
+	if sqlErr == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, str); err == nil {
+		return t, nil
+	}
+	if t, ok := parseLayouts(str, currentDialect().DateTimeScanLayouts); ok {
+		return t, nil
+	}
+
+	scanLayouts.RLock()
+	defer scanLayouts.RUnlock()
+	if t, ok := parseLayouts(str, scanLayouts.layouts); ok {
+		return t, nil
+	}
+	if t, ok := parseEpochString(str); ok {
+		return t, nil
+	}
+
+	return time.Time{}, sqlErr
+}
+
+// parseEpochString parses unix seconds with an optional sign and fraction,
+// eg. 1700000000 or -1700000000.123, into a UTC time
+func parseEpochString(str string) (time.Time, bool) {
+	neg := len(str) > 0 && str[0] == '-'
+	if neg {
+		str = str[1:]
+	}
+	n := countDigits(str)
+	// 18 digits can't overflow an int64, checkUnix rejects the rest
+	if n == 0 || n > 18 {
+		return time.Time{}, false
+	}
+	var sec, nsec int64
+	for i := 0; i < n; i++ {
+		sec = sec*10 + int64(str[i]-'0')
+	}
+
+	if rest := str[n:]; len(rest) > 0 {
+		frac := countDigits(rest[1:])
+		if rest[0] != '.' || frac == 0 || frac > 9 || frac+1 != len(rest) {
+			return time.Time{}, false
+		}
+		v, _ := atoiN(rest[1:], frac)
+		nsec = int64(v)
+		for i := frac; i < 9; i++ {
+			nsec *= 10
+		}
+	}
+	if neg {
+		sec, nsec = -sec, -nsec
+	}
+	if !checkUnix(sec, nsec) {
+		return time.Time{}, false
+	}
+	return time.Unix(sec, nsec).UTC(), true
+}
+
+// sqlZones caches the fixed zones created for scanned offsets so that
+// scanning many rows with the same offset does not allocate
+var sqlZones sync.Map
+
+// parseSQLDate parses exactly 2006-01-02
+func parseSQLDate[T byteString](b T) (time.Time, bool) {
+	if len(b) != 10 {
+		return time.Time{}, false
+	}
+	year, month, day, ok := scanSQLDate(b)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC), true
+}
+
+// parseSQLTime parses 15:04:05.999999999-07:00:00 where the fraction and the
+// minutes and seconds of the offset are optional
+func parseSQLTime[T byteString](b T) (time.Time, bool) {
+	hour, min, sec, nsec, i, ok := scanSQLClock(b, 0)
+	if !ok {
+		return time.Time{}, false
+	}
+	offset, utc, ok := scanSQLOffset(b, i)
+	if !ok {
+		return time.Time{}, false
+	}
+	return sqlTime(0, 1, 1, hour, min, sec, nsec, offset, utc), true
+}
+
+// parseJSONTime parses 15:04:05.999999999Z07:00 where the seconds, fraction
+// and offset are optional, a missing offset is UTC
+func parseJSONTime[T byteString](b T) (time.Time, bool) {
+	if len(b) < 5 || b[2] != ':' {
+		return time.Time{}, false
+	}
+	hour, ok1 := digits2(b, 0)
+	min, ok2 := digits2(b, 3)
+	if !ok1 || !ok2 || hour > 23 || min > 59 {
+		return time.Time{}, false
+	}
+	if len(b) == 5 {
+		return time.Date(0, 1, 1, hour, min, 0, 0, time.UTC), true
+	}
+
+	var sec, nsec, i int
+	if b[5] == ':' {
+		var ok bool
+		if hour, min, sec, nsec, i, ok = scanSQLClock(b, 0); !ok {
+			return time.Time{}, false
+		}
+	} else {
+		i = 5
+	}
+	if i == len(b) {
+		return time.Date(0, 1, 1, hour, min, sec, nsec, time.UTC), true
+	}
+
+	offset, utc, ok := scanSQLOffset(b, i)
+	if !ok {
+		return time.Time{}, false
+	}
+	return sqlTime(0, 1, 1, hour, min, sec, nsec, offset, utc), true
+}
+
+// parseSQLDateTime parses 2006-01-02 15:04:05.999999999-07:00:00 where the
+// fraction and the minutes and seconds of the offset are optional
+func parseSQLDateTime[T byteString](b T) (time.Time, bool) {
+	if len(b) < 11 || b[10] != ' ' {
+		return time.Time{}, false
+	}
+	year, month, day, ok := scanSQLDate(b)
+	if !ok {
+		return time.Time{}, false
+	}
+	hour, min, sec, nsec, i, ok := scanSQLClock(b, 11)
+	if !ok {
+		return time.Time{}, false
+	}
+	offset, utc, ok := scanSQLOffset(b, i)
+	if !ok {
+		return time.Time{}, false
+	}
+	return sqlTime(year, month, day, hour, min, sec, nsec, offset, utc), true
+}
+
+// parseSQLLocalDateTime parses 2006-01-02 15:04:05.999999999 in UTC
+func parseSQLLocalDateTime[T byteString](b T) (time.Time, bool) {
+	if len(b) < 11 || b[10] != ' ' {
+		return time.Time{}, false
+	}
+	year, month, day, ok := scanSQLDate(b)
+	if !ok {
+		return time.Time{}, false
+	}
+	hour, min, sec, nsec, i, ok := scanSQLClock(b, 11)
+	if !ok || i != len(b) {
+		return time.Time{}, false
+	}
+	return time.Date(year, time.Month(month), day, hour, min, sec, nsec, time.UTC), true
+}
+
+// scanSQLDate reads and range checks 2006-01-02 from the start of b
+func scanSQLDate[T byteString](b T) (year, month, day int, ok bool) {
+	if len(b) < 10 || b[4] != '-' || b[7] != '-' {
+		return 0, 0, 0, false
+	}
+	hi, ok1 := digits2(b, 0)
+	lo, ok2 := digits2(b, 2)
+	month, ok3 := digits2(b, 5)
+	day, ok4 := digits2(b, 8)
+	if !ok1 || !ok2 || !ok3 || !ok4 {
+		return 0, 0, 0, false
+	}
+	year = hi*100 + lo
+	if month < 1 || month > 12 || day < 1 || day > daysIn(time.Month(month), year) {
+		return 0, 0, 0, false
+	}
+	return year, month, day, true
+}
+
+// scanSQLClock reads and range checks 15:04:05.999999999 from b at i,
+// returning the index after it
+func scanSQLClock[T byteString](b T, i int) (hour, min, sec, nsec, next int, ok bool) {
+	if len(b) < i+8 || b[i+2] != ':' || b[i+5] != ':' {
+		return 0, 0, 0, 0, 0, false
+	}
+	hour, ok1 := digits2(b, i)
+	min, ok2 := digits2(b, i+3)
+	sec, ok3 := digits2(b, i+6)
+	if !ok1 || !ok2 || !ok3 || hour > 23 || min > 59 || sec > 59 {
+		return 0, 0, 0, 0, 0, false
+	}
+	i += 8
+
+	if i < len(b) && b[i] == '.' {
+		i++
+		n := 0
+		for ; i < len(b) && b[i] >= '0' && b[i] <= '9'; i++ {
+			if n == 9 {
+				return 0, 0, 0, 0, 0, false
+			}
+			nsec = nsec*10 + int(b[i]-'0')
+			n++
+		}
+		if n == 0 {
+			return 0, 0, 0, 0, 0, false
+		}
+		for ; n < 9; n++ {
+			nsec *= 10
+		}
+	}
+
+	return hour, min, sec, nsec, i, true
+}
+
+// scanSQLOffset reads and range checks an offset of the form Z, -07,
+// -07:00 or -07:00:00 which must run to the end of b. utc is true for Z.
+func scanSQLOffset[T byteString](b T, i int) (offset int, utc bool, ok bool) {
+	rest := len(b) - i
+	if rest == 1 && b[i] == 'Z' {
+		return 0, true, true
+	}
+	if (rest != 3 && rest != 6 && rest != 9) || (b[i] != '+' && b[i] != '-') {
+		return 0, false, false
+	}
+
+	hour, ok := digits2(b, i+1)
+	if !ok || hour > 23 {
+		return 0, false, false
+	}
+	offset = hour * 60 * 60
+	for j := i + 3; j < len(b); j += 3 {
+		v, ok := digits2(b, j+1)
+		if b[j] != ':' || !ok || v > 59 {
+			return 0, false, false
+		}
+		if j == i+3 {
+			offset += v * 60
+		} else {
+			offset += v
+		}
+	}
+
+	if b[i] == '-' {
+		offset = -offset
+	}
+	return offset, false, true
+}
+
+// sqlTime builds the time the same way time.Parse would: UTC for Z, Local if
+// the offset matches it, and a fixed zone otherwise
+func sqlTime(year, month, day, hour, min, sec, nsec, offset int, utc bool) time.Time {
+	t := time.Date(year, time.Month(month), day, hour, min, sec, nsec, time.UTC)
+	if utc {
+		return t
+	}
+	t = t.Add(-time.Duration(offset) * time.Second)
+
+	if _, localOffset := t.In(time.Local).Zone(); localOffset == offset {
+		return t.In(time.Local)
+	}
+
+	loc, ok := sqlZones.Load(offset)
+	if !ok {
+		loc, _ = sqlZones.LoadOrStore(offset, time.FixedZone("", offset))
+	}
+	return t.In(loc.(*time.Location))
+}
+
+// digits2 reads two ascii digits from b at i
+func digits2[T byteString](b T, i int) (int, bool) {
+	c1, c2 := b[i], b[i+1]
+	if c1 < '0' || c1 > '9' || c2 < '0' || c2 > '9' {
+		return 0, false
+	}
+	return int(c1-'0')*10 + int(c2-'0'), true
+}
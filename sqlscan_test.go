@@ -0,0 +1,161 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestScanFastPath(t *testing.T) {
+	t.Parallel()
+
+	dateTimes := []string{
+		"2000-01-02 03:04:05-07",
+		"2000-01-02 03:04:05+00",
+		"2000-01-02 03:04:05.123456+01",
+		"2000-01-02 03:04:05.1-12",
+		"2024-02-29 23:59:59.999999+14",
+	}
+	for _, in := range dateTimes {
+		want, err := time.Parse(chrono.DateTimeSQLLayout, in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var fromString, fromBytes chrono.DateTime
+		if err := fromString.Scan(in); err != nil {
+			t.Error(in, err)
+		}
+		if err := fromBytes.Scan([]byte(in)); err != nil {
+			t.Error(in, err)
+		}
+		if !fromString.ToStdTime().Equal(want) || !fromBytes.ToStdTime().Equal(want) {
+			t.Errorf("%s: want %s, got %s and %s", in, want, fromString, fromBytes)
+		}
+		if _, off := fromBytes.Zone(); off != func() int { _, o := want.Zone(); return o }() {
+			t.Errorf("%s: offset wrong", in)
+		}
+	}
+
+	var d chrono.DateTime
+	if err := d.Scan([]byte("2000-01-02 03:04:05+05:30")); err != nil {
+		t.Error(err)
+	}
+	if !d.Equal(chrono.NewDateTime(2000, 1, 1, 21, 34, 5, 0, time.UTC)) {
+		t.Error("value wrong", d)
+	}
+
+	var tm chrono.Time
+	if err := tm.Scan([]byte("03:04:05.5-07")); err != nil {
+		t.Error(err)
+	}
+	if h, m, s := tm.Clock(); h != 3 || m != 4 || s != 5 || tm.Nanosecond() != 500000000 {
+		t.Error("value wrong", tm)
+	}
+
+	var date chrono.Date
+	if err := date.Scan([]byte("2024-02-29")); err != nil {
+		t.Error(err)
+	}
+	if !date.Equal(chrono.NewDate(2024, 2, 29)) {
+		t.Error("value wrong", date)
+	}
+
+	var local chrono.LocalDateTime
+	if err := local.Scan([]byte("2000-01-02 03:04:05.000006")); err != nil {
+		t.Error(err)
+	}
+	if !local.Equal(chrono.NewLocalDateTime(2000, 1, 2, 3, 4, 5, 6000)) {
+		t.Error("value wrong", local)
+	}
+}
+
+func TestScanFastPathRejects(t *testing.T) {
+	t.Parallel()
+
+	badDates := []string{"2023-02-29", "2023-13-01", "2023-1-01", "2023-01-01 "}
+	for _, in := range badDates {
+		var d chrono.Date
+		if err := d.Scan([]byte(in)); err == nil {
+			t.Errorf("%q: expected an error", in)
+		}
+	}
+
+	badDateTimes := []string{
+		"2023-02-29 00:00:00+00",
+		"2023-01-01 24:00:00+00",
+		"2023-01-01 00:60:00+00",
+		"2023-01-01 00:00:00.+00",
+		"2023-01-01 00:00:00+0",
+		"2023-01-01T00:00:00+00",
+	}
+	for _, in := range badDateTimes {
+		var d chrono.DateTime
+		if err := d.Scan([]byte(in)); err == nil {
+			t.Errorf("%q: expected an error", in)
+		}
+	}
+}
+
+func TestScanFastPathAllocs(t *testing.T) {
+	// Box the values up front like database/sql does, otherwise the
+	// conversion to any is the allocation being measured
+	var (
+		date     any = []byte("2000-01-02")
+		dateTime any = []byte("2000-01-02 03:04:05.123456+01")
+		tm       any = []byte("03:04:05.123456-07")
+	)
+
+	var (
+		d  chrono.Date
+		dt chrono.DateTime
+		t2 chrono.Time
+	)
+	// Warm up the zone cache
+	_ = dt.Scan(dateTime)
+	_ = t2.Scan(tm)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = d.Scan(date)
+		_ = dt.Scan(dateTime)
+		_ = t2.Scan(tm)
+	})
+	if allocs != 0 {
+		t.Error("expected no allocations, got", allocs)
+	}
+}
+
+func BenchmarkScanDateBytes(b *testing.B) {
+	var in any = []byte("2000-01-02")
+	var d chrono.Date
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = d.Scan(in)
+	}
+}
+
+func BenchmarkScanDateTimeBytes(b *testing.B) {
+	var in any = []byte("2000-01-02 03:04:05.123456+01")
+	var d chrono.DateTime
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = d.Scan(in)
+	}
+}
+
+func BenchmarkScanDateTimeBytesTimeParse(b *testing.B) {
+	in := []byte("2000-01-02 03:04:05.123456+01")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = time.Parse(chrono.DateTimeSQLLayout, string(in))
+	}
+}
+
+func BenchmarkScanTimeBytes(b *testing.B) {
+	var in any = []byte("03:04:05.123456-07")
+	var t chrono.Time
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = t.Scan(in)
+	}
+}
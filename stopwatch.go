@@ -0,0 +1,103 @@
+package chrono
+
+import "time"
+
+// Stopwatch measures elapsed time using a Clock. The time spent paused is
+// not counted. It is not safe for concurrent use.
+type Stopwatch struct {
+	clock Clock
+
+	running bool
+	started DateTime
+	// elapsed is the time accumulated before the current run began
+	elapsed time.Duration
+	// lastLap is the elapsed time at which the previous lap ended
+	lastLap time.Duration
+	laps    []time.Duration
+}
+
+// NewStopwatch creates a stopped stopwatch. If clock is nil DefaultClock is
+// used.
+func NewStopwatch(clock Clock) *Stopwatch {
+	if clock == nil {
+		clock = DefaultClock
+	}
+	return &Stopwatch{clock: clock}
+}
+
+// StartStopwatch creates a stopwatch using DefaultClock and starts it
+func StartStopwatch() *Stopwatch {
+	s := NewStopwatch(nil)
+	s.Start()
+	return s
+}
+
+// Start resets the stopwatch and starts it
+func (s *Stopwatch) Start() {
+	s.Reset()
+	s.running = true
+	s.started = s.clock.Now()
+}
+
+// Stop stops the stopwatch and returns the total elapsed time. It can be
+// continued with Resume.
+func (s *Stopwatch) Stop() time.Duration {
+	s.Pause()
+	return s.elapsed
+}
+
+// Pause stops counting time until Resume is called
+func (s *Stopwatch) Pause() {
+	if !s.running {
+		return
+	}
+	s.elapsed += s.clock.Now().Sub(s.started)
+	s.running = false
+}
+
+// Resume continues counting time after Pause or Stop
+func (s *Stopwatch) Resume() {
+	if s.running {
+		return
+	}
+	s.running = true
+	s.started = s.clock.Now()
+}
+
+// Reset stops the stopwatch and clears the elapsed time and laps
+func (s *Stopwatch) Reset() {
+	s.running = false
+	s.elapsed = 0
+	s.lastLap = 0
+	s.laps = nil
+}
+
+// Running returns true if the stopwatch is counting time
+func (s *Stopwatch) Running() bool {
+	return s.running
+}
+
+// Elapsed returns the total time counted so far
+func (s *Stopwatch) Elapsed() time.Duration {
+	if !s.running {
+		return s.elapsed
+	}
+	return s.elapsed + s.clock.Now().Sub(s.started)
+}
+
+// Lap records and returns the time counted since the previous lap (or since
+// the start for the first lap).
+func (s *Stopwatch) Lap() time.Duration {
+	elapsed := s.Elapsed()
+	lap := elapsed - s.lastLap
+	s.lastLap = elapsed
+	s.laps = append(s.laps, lap)
+	return lap
+}
+
+// Laps returns a copy of the recorded laps
+func (s *Stopwatch) Laps() []time.Duration {
+	out := make([]time.Duration, len(s.laps))
+	copy(out, s.laps)
+	return out
+}
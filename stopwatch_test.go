@@ -0,0 +1,71 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestStopwatch(t *testing.T) {
+	t.Parallel()
+
+	now := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := chrono.ClockFunc(func() chrono.DateTime { return now })
+	advance := func(d time.Duration) { now = now.Add(d) }
+
+	s := chrono.NewStopwatch(clock)
+	if s.Running() || s.Elapsed() != 0 {
+		t.Error("should start stopped")
+	}
+
+	s.Start()
+	advance(time.Second)
+	if e := s.Elapsed(); e != time.Second {
+		t.Error("elapsed wrong", e)
+	}
+	if l := s.Lap(); l != time.Second {
+		t.Error("lap wrong", l)
+	}
+
+	s.Pause()
+	advance(time.Hour)
+	if e := s.Elapsed(); e != time.Second {
+		t.Error("paused time should not count", e)
+	}
+	s.Resume()
+	advance(2 * time.Second)
+	if l := s.Lap(); l != 2*time.Second {
+		t.Error("lap wrong", l)
+	}
+
+	advance(time.Second)
+	if e := s.Stop(); e != 4*time.Second {
+		t.Error("elapsed wrong", e)
+	}
+	if s.Running() {
+		t.Error("should be stopped")
+	}
+
+	laps := s.Laps()
+	if len(laps) != 2 || laps[0] != time.Second || laps[1] != 2*time.Second {
+		t.Error("laps wrong", laps)
+	}
+
+	s.Start()
+	if s.Elapsed() != 0 || len(s.Laps()) != 0 {
+		t.Error("start should reset")
+	}
+}
+
+func TestStopwatchSystemClock(t *testing.T) {
+	t.Parallel()
+
+	s := chrono.StartStopwatch()
+	if !s.Running() {
+		t.Error("should be running")
+	}
+	if s.Stop() < 0 {
+		t.Error("elapsed should not be negative")
+	}
+}
@@ -0,0 +1,87 @@
+package chrono
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNonexistentTime is returned (wrapped) by strict constructors when the
+// wall clock time is skipped in the location, eg. 02:30 on the day clocks
+// spring forward an hour.
+var ErrNonexistentTime = errors.New("time does not exist in location")
+
+// RangeError is returned by strict constructors when a component is outside
+// of its valid range rather than normalizing it.
+type RangeError struct {
+	// Component is one of year, month, day, hour, minute, second or
+	// nanosecond
+	Component string
+	Value     int
+	Min       int
+	Max       int
+}
+
+// Error implements error
+func (r *RangeError) Error() string {
+	return fmt.Sprintf("%s %d out of range [%d, %d]", r.Component, r.Value, r.Min, r.Max)
+}
+
+// NewDateStrict is like NewDate but returns a *RangeError instead of
+// normalizing out of range components, eg. February 30th.
+func NewDateStrict(year int, month time.Month, day int) (Date, error) {
+	if err := checkDate(year, month, day); err != nil {
+		return Date{}, err
+	}
+	return NewDate(year, month, day), nil
+}
+
+// DateFromStringStrict is like DateFromString but rejects anything that is not
+// exactly an RFC3339 full-date. The error is a *FormatError.
+func DateFromStringStrict(str string) (Date, error) {
+	return DateFromOpenAPI(str)
+}
+
+// NewDateTimeStrict is like NewDateTime but returns a *RangeError instead of
+// normalizing out of range components. It also returns ErrNonexistentTime if
+// the wall clock time is skipped over by a DST transition in loc.
+func NewDateTimeStrict(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location) (DateTime, error) {
+	if err := checkDate(year, month, day); err != nil {
+		return DateTime{}, err
+	}
+	if err := checkClock(hour, min, sec, nsec); err != nil {
+		return DateTime{}, err
+	}
+
+	d := NewDateTime(year, month, day, hour, min, sec, nsec, loc)
+	if h, m, _ := d.Clock(); h != hour || m != min || d.Day() != day {
+		return DateTime{}, fmt.Errorf("%04d-%02d-%02d %02d:%02d in %s: %w", year, month, day, hour, min, loc, ErrNonexistentTime)
+	}
+	return d, nil
+}
+
+// checkDate returns a *RangeError if the month or day are out of range
+func checkDate(year int, month time.Month, day int) error {
+	if month < time.January || month > time.December {
+		return &RangeError{Component: "month", Value: int(month), Min: 1, Max: 12}
+	}
+	if last := daysIn(month, year); day < 1 || day > last {
+		return &RangeError{Component: "day", Value: day, Min: 1, Max: last}
+	}
+	return nil
+}
+
+// checkClock returns a *RangeError if any of the components are out of range
+func checkClock(hour, min, sec, nsec int) error {
+	switch {
+	case hour < 0 || hour > 23:
+		return &RangeError{Component: "hour", Value: hour, Min: 0, Max: 23}
+	case min < 0 || min > 59:
+		return &RangeError{Component: "minute", Value: min, Min: 0, Max: 59}
+	case sec < 0 || sec > 59:
+		return &RangeError{Component: "second", Value: sec, Min: 0, Max: 59}
+	case nsec < 0 || nsec > 999999999:
+		return &RangeError{Component: "nanosecond", Value: nsec, Min: 0, Max: 999999999}
+	}
+	return nil
+}
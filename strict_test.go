@@ -0,0 +1,93 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestNewDateStrict(t *testing.T) {
+	t.Parallel()
+
+	d, err := chrono.NewDateStrict(2024, time.February, 29)
+	if err != nil {
+		t.Error(err)
+	}
+	if !d.Equal(chrono.NewDate(2024, 2, 29)) {
+		t.Error("value wrong", d)
+	}
+
+	tests := []struct {
+		Year      int
+		Month     time.Month
+		Day       int
+		Component string
+		Max       int
+	}{
+		{2023, time.February, 29, "day", 28},
+		{2023, time.April, 31, "day", 30},
+		{2023, time.April, 0, "day", 30},
+		{2023, 13, 1, "month", 12},
+		{2023, 0, 1, "month", 12},
+	}
+
+	for _, test := range tests {
+		_, err := chrono.NewDateStrict(test.Year, test.Month, test.Day)
+		var rerr *chrono.RangeError
+		if !errors.As(err, &rerr) {
+			t.Errorf("%d-%d-%d: expected a range error, got %v", test.Year, test.Month, test.Day, err)
+			continue
+		}
+		if rerr.Component != test.Component || rerr.Max != test.Max {
+			t.Errorf("%d-%d-%d: error wrong: %v", test.Year, test.Month, test.Day, rerr)
+		}
+	}
+}
+
+func TestDateFromStringStrict(t *testing.T) {
+	t.Parallel()
+
+	if _, err := chrono.DateFromStringStrict("2023-02-28"); err != nil {
+		t.Error(err)
+	}
+	for _, in := range []string{"2023-02-30", "2023-2-28", "2023-02-28T00:00:00Z", " 2023-02-28"} {
+		if _, err := chrono.DateFromStringStrict(in); err == nil {
+			t.Errorf("%q: expected an error", in)
+		}
+	}
+}
+
+func TestNewDateTimeStrict(t *testing.T) {
+	t.Parallel()
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dt, err := chrono.NewDateTimeStrict(2022, time.March, 13, 3, 30, 0, 0, ny)
+	if err != nil {
+		t.Error(err)
+	}
+	if !dt.Equal(chrono.NewDateTime(2022, 3, 13, 3, 30, 0, 0, ny)) {
+		t.Error("value wrong", dt)
+	}
+
+	// 02:30 is skipped when clocks spring forward
+	if _, err = chrono.NewDateTimeStrict(2022, time.March, 13, 2, 30, 0, 0, ny); !errors.Is(err, chrono.ErrNonexistentTime) {
+		t.Error("expected nonexistent time, got", err)
+	}
+
+	var rerr *chrono.RangeError
+	if _, err = chrono.NewDateTimeStrict(2022, time.March, 13, 24, 0, 0, 0, time.UTC); !errors.As(err, &rerr) || rerr.Component != "hour" {
+		t.Error("expected hour range error, got", err)
+	}
+	if _, err = chrono.NewDateTimeStrict(2022, time.March, 13, 0, 0, 0, 1e9, time.UTC); !errors.As(err, &rerr) || rerr.Component != "nanosecond" {
+		t.Error("expected nanosecond range error, got", err)
+	}
+	if _, err = chrono.NewDateTimeStrict(2022, time.February, 29, 0, 0, 0, 0, time.UTC); !errors.As(err, &rerr) || rerr.Error() != "day 29 out of range [1, 28]" {
+		t.Error("expected day range error, got", err)
+	}
+}
@@ -0,0 +1,121 @@
+// Package sun computes the times of sunrise, sunset and solar noon for a
+// place on earth using the sunrise equation. The results are accurate to
+// about a minute between the polar circles, closer to the poles refraction
+// makes them less reliable.
+//
+// Latitudes are positive north of the equator and longitudes are positive
+// east of Greenwich, both in degrees:
+//
+//	rise, ok := sun.Sunrise(chrono.NewDate(2024, 6, 21), 51.5072, -0.1276, london)
+package sun
+
+import (
+	"math"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+const (
+	// j2000 is the Julian Day of 2000-01-01T12:00:00Z
+	j2000 = 2451545.0
+	// unixEpochJulianDay is the Julian Day of 1970-01-01T00:00:00Z
+	unixEpochJulianDay = 2440587.5
+	// obliquity is the tilt of the earth's axis in degrees
+	obliquity = 23.4397
+	// horizon is the altitude of the sun's center at sunrise and sunset, it
+	// accounts for refraction and the radius of the sun's disc
+	horizon = -0.833
+)
+
+// SolarNoon returns the moment the sun is highest in the sky on date at the
+// given longitude, in loc.
+func SolarNoon(date chrono.Date, lon float64, loc *time.Location) chrono.DateTime {
+	transit, _ := solar(date, lon)
+	return fromJulianDay(transit, loc)
+}
+
+// Sunrise returns the moment the top of the sun appears over the horizon on
+// date at the given place, in loc. It returns false when the sun doesn't rise
+// or doesn't set that day, as happens near the poles.
+func Sunrise(date chrono.Date, lat, lon float64, loc *time.Location) (chrono.DateTime, bool) {
+	transit, halfDay, ok := daylight(date, lat, lon)
+	if !ok {
+		return chrono.DateTime{}, false
+	}
+	return fromJulianDay(transit-halfDay, loc), true
+}
+
+// Sunset returns the moment the top of the sun disappears below the horizon
+// on date at the given place, in loc. It returns false when the sun doesn't
+// rise or doesn't set that day, as happens near the poles.
+func Sunset(date chrono.Date, lat, lon float64, loc *time.Location) (chrono.DateTime, bool) {
+	transit, halfDay, ok := daylight(date, lat, lon)
+	if !ok {
+		return chrono.DateTime{}, false
+	}
+	return fromJulianDay(transit+halfDay, loc), true
+}
+
+// DayLength returns how long the sun is above the horizon on date at the
+// given place. It's 0 during polar night and 24h during polar day.
+func DayLength(date chrono.Date, lat, lon float64) time.Duration {
+	cosHourAngle := cosHourAngle(date, lat, lon)
+	switch {
+	case cosHourAngle > 1:
+		return 0
+	case cosHourAngle < -1:
+		return 24 * time.Hour
+	}
+	hours := 2 * degrees(math.Acos(cosHourAngle)) / 15
+	return time.Duration(hours * float64(time.Hour)).Round(time.Second)
+}
+
+// solar returns the Julian Day of the solar transit on date at lon along
+// with the sun's declination in radians at that moment
+func solar(date chrono.Date, lon float64) (transit, declination float64) {
+	// Days since J2000 at the mean solar noon of lon
+	n := date.JulianDay() + 0.5 - j2000 - lon/360
+
+	anomaly := math.Mod(357.5291+0.98560028*n, 360)
+	m := radians(anomaly)
+	center := 1.9148*math.Sin(m) + 0.0200*math.Sin(2*m) + 0.0003*math.Sin(3*m)
+	ecliptic := radians(math.Mod(anomaly+center+180+102.9372, 360))
+
+	transit = j2000 + n + 0.0053*math.Sin(m) - 0.0069*math.Sin(2*ecliptic)
+	declination = math.Asin(math.Sin(ecliptic) * math.Sin(radians(obliquity)))
+	return transit, declination
+}
+
+// cosHourAngle returns the cosine of the hour angle at sunrise and sunset,
+// outside of [-1, 1] the sun doesn't cross the horizon
+func cosHourAngle(date chrono.Date, lat, lon float64) float64 {
+	_, declination := solar(date, lon)
+	phi := radians(lat)
+	return (math.Sin(radians(horizon)) - math.Sin(phi)*math.Sin(declination)) /
+		(math.Cos(phi) * math.Cos(declination))
+}
+
+// daylight returns the Julian Day of the solar transit and the fraction of a
+// day between sunrise and the transit
+func daylight(date chrono.Date, lat, lon float64) (transit, halfDay float64, ok bool) {
+	cos := cosHourAngle(date, lat, lon)
+	if cos < -1 || cos > 1 {
+		return 0, 0, false
+	}
+	transit, _ = solar(date, lon)
+	return transit, degrees(math.Acos(cos)) / 360, true
+}
+
+func fromJulianDay(jd float64, loc *time.Location) chrono.DateTime {
+	sec := math.Round((jd - unixEpochJulianDay) * 24 * 60 * 60)
+	return chrono.DateTimeFromUnix(int64(sec), 0).In(loc)
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
@@ -0,0 +1,100 @@
+package sun_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+	"github.com/aarondl/chrono/chronotest"
+	"github.com/aarondl/chrono/sun"
+)
+
+func TestSun(t *testing.T) {
+	t.Parallel()
+
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sydney, err := time.LoadLocation("Australia/Sydney")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Name     string
+		Date     chrono.Date
+		Lat, Lon float64
+		Loc      *time.Location
+		Rise     chrono.DateTime
+		Noon     chrono.DateTime
+		Set      chrono.DateTime
+	}{
+		{
+			Name: "london summer", Date: chrono.NewDate(2024, 6, 21), Lat: 51.5072, Lon: -0.1276, Loc: london,
+			Rise: chrono.NewDateTime(2024, 6, 21, 4, 43, 0, 0, london),
+			Noon: chrono.NewDateTime(2024, 6, 21, 13, 2, 0, 0, london),
+			Set:  chrono.NewDateTime(2024, 6, 21, 21, 21, 0, 0, london),
+		},
+		{
+			Name: "london winter", Date: chrono.NewDate(2024, 12, 21), Lat: 51.5072, Lon: -0.1276, Loc: london,
+			Rise: chrono.NewDateTime(2024, 12, 21, 8, 4, 0, 0, london),
+			Noon: chrono.NewDateTime(2024, 12, 21, 11, 58, 0, 0, london),
+			Set:  chrono.NewDateTime(2024, 12, 21, 15, 53, 0, 0, london),
+		},
+		{
+			Name: "sydney winter", Date: chrono.NewDate(2024, 6, 21), Lat: -33.8688, Lon: 151.2093, Loc: sydney,
+			Rise: chrono.NewDateTime(2024, 6, 21, 7, 0, 0, 0, sydney),
+			Noon: chrono.NewDateTime(2024, 6, 21, 11, 57, 0, 0, sydney),
+			Set:  chrono.NewDateTime(2024, 6, 21, 16, 54, 0, 0, sydney),
+		},
+	}
+
+	for _, test := range tests {
+		rise, ok := sun.Sunrise(test.Date, test.Lat, test.Lon, test.Loc)
+		if !ok {
+			t.Errorf("%s: expected a sunrise", test.Name)
+		}
+		chronotest.AssertEqualDateTime(t, test.Rise, rise, 2*time.Minute)
+		if rise.Location() != test.Loc {
+			t.Errorf("%s: location wrong: %s", test.Name, rise.Location())
+		}
+
+		chronotest.AssertEqualDateTime(t, test.Noon, sun.SolarNoon(test.Date, test.Lon, test.Loc), 2*time.Minute)
+
+		set, ok := sun.Sunset(test.Date, test.Lat, test.Lon, test.Loc)
+		if !ok {
+			t.Errorf("%s: expected a sunset", test.Name)
+		}
+		chronotest.AssertEqualDateTime(t, test.Set, set, 2*time.Minute)
+
+		if got, want := sun.DayLength(test.Date, test.Lat, test.Lon), set.Sub(rise); got-want > time.Second || want-got > time.Second {
+			t.Errorf("%s: day length want: %s, got: %s", test.Name, want, got)
+		}
+	}
+}
+
+func TestSunPolar(t *testing.T) {
+	t.Parallel()
+
+	const lat, lon = 69.6492, 18.9553 // Tromsø
+
+	summer := chrono.NewDate(2024, 6, 21)
+	if _, ok := sun.Sunrise(summer, lat, lon, time.UTC); ok {
+		t.Error("the sun should not rise during polar day")
+	}
+	if _, ok := sun.Sunset(summer, lat, lon, time.UTC); ok {
+		t.Error("the sun should not set during polar day")
+	}
+	if got := sun.DayLength(summer, lat, lon); got != 24*time.Hour {
+		t.Error("polar day length wrong:", got)
+	}
+
+	winter := chrono.NewDate(2024, 12, 21)
+	if _, ok := sun.Sunrise(winter, lat, lon, time.UTC); ok {
+		t.Error("the sun should not rise during polar night")
+	}
+	if got := sun.DayLength(winter, lat, lon); got != 0 {
+		t.Error("polar night length wrong:", got)
+	}
+}
@@ -0,0 +1,152 @@
+package chrono
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// LeapSecond is an entry in the leap second table, from the start of Date
+// (UTC) onward TAI is ahead of UTC by Offset.
+type LeapSecond struct {
+	Date   Date
+	Offset time.Duration
+}
+
+// gpsTAIOffset is how far GPS time is behind TAI, it's the value of
+// TAI - UTC when the GPS epoch began
+const gpsTAIOffset = 19 * time.Second
+
+// gpsEpoch is 1980-01-06T00:00:00Z, the start of GPS week 0
+var gpsEpoch = time.Date(1980, 1, 6, 0, 0, 0, 0, time.UTC)
+
+// defaultLeapSeconds is the table published by the IERS in Bulletin C, it's
+// current up to the leap second at the end of 2016.
+var defaultLeapSeconds = []LeapSecond{
+	{NewDate(1972, 1, 1), 10 * time.Second},
+	{NewDate(1972, 7, 1), 11 * time.Second},
+	{NewDate(1973, 1, 1), 12 * time.Second},
+	{NewDate(1974, 1, 1), 13 * time.Second},
+	{NewDate(1975, 1, 1), 14 * time.Second},
+	{NewDate(1976, 1, 1), 15 * time.Second},
+	{NewDate(1977, 1, 1), 16 * time.Second},
+	{NewDate(1978, 1, 1), 17 * time.Second},
+	{NewDate(1979, 1, 1), 18 * time.Second},
+	{NewDate(1980, 1, 1), 19 * time.Second},
+	{NewDate(1981, 7, 1), 20 * time.Second},
+	{NewDate(1982, 7, 1), 21 * time.Second},
+	{NewDate(1983, 7, 1), 22 * time.Second},
+	{NewDate(1985, 7, 1), 23 * time.Second},
+	{NewDate(1988, 1, 1), 24 * time.Second},
+	{NewDate(1990, 1, 1), 25 * time.Second},
+	{NewDate(1991, 1, 1), 26 * time.Second},
+	{NewDate(1992, 7, 1), 27 * time.Second},
+	{NewDate(1993, 7, 1), 28 * time.Second},
+	{NewDate(1994, 7, 1), 29 * time.Second},
+	{NewDate(1996, 1, 1), 30 * time.Second},
+	{NewDate(1997, 7, 1), 31 * time.Second},
+	{NewDate(1999, 1, 1), 32 * time.Second},
+	{NewDate(2006, 1, 1), 33 * time.Second},
+	{NewDate(2009, 1, 1), 34 * time.Second},
+	{NewDate(2012, 7, 1), 35 * time.Second},
+	{NewDate(2015, 7, 1), 36 * time.Second},
+	{NewDate(2017, 1, 1), 37 * time.Second},
+}
+
+var leapSecondTable atomic.Value
+
+func init() {
+	leapSecondTable.Store(defaultLeapSeconds)
+}
+
+// SetLeapSeconds replaces the leap second table used by the TAI and GPS
+// conversions, eg. when the IERS announces a new leap second. The table must
+// be sorted by date. It is safe for concurrent use but is intended to be
+// called during program initialization.
+func SetLeapSeconds(table []LeapSecond) error {
+	if len(table) == 0 {
+		return errors.New("leap second table is empty")
+	}
+	for i := 1; i < len(table); i++ {
+		if !table[i].Date.After(table[i-1].Date) {
+			return fmt.Errorf("leap second table is not sorted at %s", table[i].Date)
+		}
+	}
+
+	leapSecondTable.Store(append([]LeapSecond(nil), table...))
+	return nil
+}
+
+// LeapSeconds returns a copy of the leap second table
+func LeapSeconds() []LeapSecond {
+	return append([]LeapSecond(nil), leapSeconds()...)
+}
+
+func leapSeconds() []LeapSecond {
+	return leapSecondTable.Load().([]LeapSecond)
+}
+
+// TAIOffset returns TAI - UTC at d. Before 1972 UTC didn't use leap seconds
+// so the first offset in the table is returned for those datetimes.
+func TAIOffset(d DateTime) time.Duration {
+	return taiOffset(d.t)
+}
+
+func taiOffset(t time.Time) time.Duration {
+	table := leapSeconds()
+	for i := len(table) - 1; i > 0; i-- {
+		if !t.Before(table[i].Date.t) {
+			return table[i].Offset
+		}
+	}
+	return table[0].Offset
+}
+
+// ToTAI converts d to International Atomic Time. TAI has no zone so the
+// result is in UTC with a wall clock that reads the TAI time, eg. the TAI of
+// 2017-01-01T00:00:00Z is 2017-01-01T00:00:37Z.
+func (d DateTime) ToTAI() DateTime {
+	t := d.t.UTC()
+	return DateTime{t: t.Add(taiOffset(t))}
+}
+
+// DateTimeFromTAI converts a TAI wall clock reading (in the form returned by
+// ToTAI) to a UTC datetime. A TAI time that falls inside a leap second has
+// no UTC representation, it's returned as the start of the following day.
+func DateTimeFromTAI(tai DateTime) DateTime {
+	t := tai.t.UTC()
+	table := leapSeconds()
+	for i := len(table) - 1; i > 0; i-- {
+		if utc := t.Add(-table[i].Offset); !utc.Before(table[i].Date.t) {
+			return DateTime{t: utc}
+		}
+	}
+	return DateTime{t: t.Add(-table[0].Offset)}
+}
+
+// ToGPS returns the GPS time of d, the duration since the GPS epoch
+// (1980-01-06T00:00:00Z) counting the leap seconds UTC has had since then.
+func (d DateTime) ToGPS() time.Duration {
+	return d.ToTAI().t.Sub(gpsEpoch) - gpsTAIOffset
+}
+
+// DateTimeFromGPS converts a GPS time, the duration since the GPS epoch
+// counting leap seconds, to a UTC datetime
+func DateTimeFromGPS(gps time.Duration) DateTime {
+	return DateTimeFromTAI(DateTime{t: gpsEpoch.Add(gps + gpsTAIOffset)})
+}
+
+// GPSWeek returns the GPS week number and the time of week of d as broadcast
+// by GPS satellites. The week is not wrapped at 1024.
+func (d DateTime) GPSWeek() (week int, timeOfWeek time.Duration) {
+	const weekLen = 7 * 24 * time.Hour
+	gps := d.ToGPS()
+	week = int(gps / weekLen)
+	timeOfWeek = gps % weekLen
+	if timeOfWeek < 0 {
+		week--
+		timeOfWeek += weekLen
+	}
+	return week, timeOfWeek
+}
@@ -0,0 +1,97 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestTAI(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		UTC    chrono.DateTime
+		Offset time.Duration
+	}{
+		{chrono.NewDateTime(1960, 1, 1, 0, 0, 0, 0, time.UTC), 10 * time.Second},
+		{chrono.NewDateTime(1972, 1, 1, 0, 0, 0, 0, time.UTC), 10 * time.Second},
+		{chrono.NewDateTime(1980, 1, 6, 0, 0, 0, 0, time.UTC), 19 * time.Second},
+		{chrono.NewDateTime(2016, 12, 31, 23, 59, 59, 0, time.UTC), 36 * time.Second},
+		{chrono.NewDateTime(2017, 1, 1, 0, 0, 0, 0, time.UTC), 37 * time.Second},
+		{chrono.NewDateTime(2016, 12, 31, 19, 0, 0, 0, time.FixedZone("", -5*60*60)), 37 * time.Second},
+	}
+
+	for _, test := range tests {
+		if got := chrono.TAIOffset(test.UTC); got != test.Offset {
+			t.Errorf("%v: offset want: %v, got: %v", test.UTC, test.Offset, got)
+		}
+		tai := test.UTC.ToTAI()
+		if got := tai.Sub(test.UTC); got != test.Offset {
+			t.Errorf("%v: tai wrong: %v", test.UTC, tai)
+		}
+		if got := chrono.DateTimeFromTAI(tai); !got.Equal(test.UTC) {
+			t.Errorf("%v: round trip wrong: %v", test.UTC, got)
+		}
+	}
+
+	// 2016-12-31T23:59:60Z only exists in TAI
+	leap := chrono.NewDateTime(2017, 1, 1, 0, 0, 36, 0, time.UTC)
+	if got := chrono.DateTimeFromTAI(leap); !got.Equal(chrono.NewDateTime(2017, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("leap second wrong:", got)
+	}
+}
+
+func TestGPS(t *testing.T) {
+	t.Parallel()
+
+	epoch := chrono.NewDateTime(1980, 1, 6, 0, 0, 0, 0, time.UTC)
+	if got := epoch.ToGPS(); got != 0 {
+		t.Error("epoch should be 0:", got)
+	}
+
+	ref := chrono.NewDateTime(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	week, tow := ref.GPSWeek()
+	if week != 1930 || tow != 18*time.Second {
+		t.Error("week wrong:", week, tow)
+	}
+	if got := chrono.DateTimeFromGPS(ref.ToGPS()); !got.Equal(ref) {
+		t.Error("round trip wrong:", got)
+	}
+
+	week, tow = epoch.Add(-time.Second).GPSWeek()
+	if week != -1 || tow != 7*24*time.Hour-time.Second {
+		t.Error("negative week wrong:", week, tow)
+	}
+}
+
+func TestSetLeapSeconds(t *testing.T) {
+	// Not parallel, changes package level configuration
+	old := chrono.LeapSeconds()
+	defer func() {
+		if err := chrono.SetLeapSeconds(old); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	future := chrono.NewDateTime(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got := chrono.TAIOffset(future); got != 37*time.Second {
+		t.Error("offset wrong:", got)
+	}
+
+	table := append(chrono.LeapSeconds(), chrono.LeapSecond{Date: chrono.NewDate(2029, 7, 1), Offset: 38 * time.Second})
+	if err := chrono.SetLeapSeconds(table); err != nil {
+		t.Fatal(err)
+	}
+	if got := chrono.TAIOffset(future); got != 38*time.Second {
+		t.Error("new offset wrong:", got)
+	}
+
+	if err := chrono.SetLeapSeconds(nil); err == nil {
+		t.Error("expected an error for an empty table")
+	}
+	unsorted := []chrono.LeapSecond{table[1], table[0]}
+	if err := chrono.SetLeapSeconds(unsorted); err == nil {
+		t.Error("expected an error for an unsorted table")
+	}
+}
@@ -0,0 +1,93 @@
+package chrono
+
+import (
+	"context"
+	"time"
+)
+
+// TimerClock is a Clock that can also wait for time to pass
+type TimerClock interface {
+	Clock
+	// After waits for the duration to elapse and then sends the current
+	// time on the returned channel, like time.After.
+	After(d time.Duration) <-chan time.Time
+}
+
+// After calls time.After
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// defaultTimerClock returns DefaultClock, if it can't wait then time.After
+// is used to wait for it
+func defaultTimerClock() TimerClock {
+	if clock, ok := DefaultClock.(TimerClock); ok {
+		return clock
+	}
+	return realTimerClock{Clock: DefaultClock}
+}
+
+// realTimerClock waits with time.After
+type realTimerClock struct {
+	Clock
+}
+
+// After calls time.After
+func (realTimerClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// NextAlignedTick returns the next boundary of unit in loc after the current
+// time according to DefaultClock, eg. the top of the next minute or midnight
+// tomorrow.
+func NextAlignedTick(unit CalendarUnit, loc *time.Location) DateTime {
+	return NextAlignedTickAfter(DefaultClock.Now(), unit, loc)
+}
+
+// NextAlignedTickAfter returns the next boundary of unit in loc strictly
+// after d. Boundaries of a day or longer are the start of the day, which
+// isn't midnight when DST skips it.
+func NextAlignedTickAfter(d DateTime, unit CalendarUnit, loc *time.Location) DateTime {
+	return DateTime{t: nextBucket(startOf(d.t.In(loc), unit), unit)}
+}
+
+// TickAt sends the boundary DateTime on the returned channel each time the
+// clock passes a boundary of unit in loc, eg. at the top of every hour in
+// America/New_York. Like time.Ticker ticks are dropped if the receiver falls
+// behind. The channel is closed once ctx is done. If clock is nil
+// DefaultClock is used, waiting with time.After if it isn't a TimerClock.
+func TickAt(ctx context.Context, clock TimerClock, unit CalendarUnit, loc *time.Location) <-chan DateTime {
+	if clock == nil {
+		clock = defaultTimerClock()
+	}
+
+	ch := make(chan DateTime, 1)
+	go func() {
+		defer close(ch)
+
+		var last DateTime
+		for {
+			now := clock.Now()
+			// Never send the same boundary twice even if the clock woke us
+			// up a little early
+			if now.Before(last) {
+				now = last
+			}
+			next := NextAlignedTickAfter(now, unit, loc)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-clock.After(next.Sub(now)):
+			}
+
+			last = next
+			select {
+			case ch <- next:
+			default:
+			}
+		}
+	}()
+
+	return ch
+}
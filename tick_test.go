@@ -0,0 +1,109 @@
+package chrono_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+// jumpClock is a TimerClock whose timers fire immediately, moving the clock
+// forward by the duration that was waited for
+type jumpClock struct {
+	mut sync.Mutex
+	now chrono.DateTime
+}
+
+func (j *jumpClock) Now() chrono.DateTime {
+	j.mut.Lock()
+	defer j.mut.Unlock()
+	return j.now
+}
+
+func (j *jumpClock) After(d time.Duration) <-chan time.Time {
+	j.mut.Lock()
+	defer j.mut.Unlock()
+	j.now = j.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- j.now.ToStdTime()
+	return ch
+}
+
+func TestNextAlignedTick(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewDateTime(2022, 6, 15, 13, 14, 15, 0, time.UTC)
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+
+	tests := []struct {
+		Unit chrono.CalendarUnit
+		Loc  *time.Location
+		Want chrono.DateTime
+	}{
+		{chrono.Minute, time.UTC, chrono.NewDateTime(2022, 6, 15, 13, 15, 0, 0, time.UTC)},
+		{chrono.Hour, time.UTC, chrono.NewDateTime(2022, 6, 15, 14, 0, 0, 0, time.UTC)},
+		{chrono.Hour, ist, chrono.NewDateTime(2022, 6, 15, 13, 30, 0, 0, time.UTC)},
+		{chrono.Day, time.UTC, chrono.NewDateTime(2022, 6, 16, 0, 0, 0, 0, time.UTC)},
+		{chrono.Day, ist, chrono.NewDateTime(2022, 6, 16, 0, 0, 0, 0, ist)},
+	}
+
+	for _, test := range tests {
+		if got := chrono.NextAlignedTickAfter(ref, test.Unit, test.Loc); !got.Equal(test.Want) {
+			t.Errorf("%s in %s: want %s, got %s", test.Unit, test.Loc, test.Want, got)
+		}
+	}
+
+	santiago, err := time.LoadLocation("America/Santiago")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2024-09-08 starts at 1am in Santiago but the day after starts at
+	// midnight again, 2024-09-09 is a Monday
+	dstDay := chrono.NewDateTime(2024, 9, 8, 12, 0, 0, 0, santiago)
+	for _, unit := range []chrono.CalendarUnit{chrono.Day, chrono.Week} {
+		if got, want := chrono.NextAlignedTickAfter(dstDay, unit, santiago), chrono.NewDateTime(2024, 9, 9, 0, 0, 0, 0, santiago); !got.Equal(want) {
+			t.Errorf("%s: want %s, got %s", unit, want, got)
+		}
+	}
+	if got, want := chrono.NextAlignedTickAfter(chrono.NewDateTime(2024, 9, 7, 12, 0, 0, 0, santiago), chrono.Day, santiago), chrono.NewDateTime(2024, 9, 8, 1, 0, 0, 0, santiago); !got.Equal(want) {
+		t.Errorf("want %s, got %s", want, got)
+	}
+	if got, want := chrono.NextAlignedTickAfter(dstDay, chrono.Month, santiago), chrono.NewDateTime(2024, 10, 1, 0, 0, 0, 0, santiago); !got.Equal(want) {
+		t.Errorf("want %s, got %s", want, got)
+	}
+
+	// Exactly on a boundary moves to the next one
+	onBoundary := chrono.NewDateTime(2022, 6, 15, 13, 0, 0, 0, time.UTC)
+	if got := chrono.NextAlignedTickAfter(onBoundary, chrono.Hour, time.UTC); !got.Equal(onBoundary.Add(time.Hour)) {
+		t.Error("value wrong", got)
+	}
+
+	if got := chrono.NextAlignedTick(chrono.Minute, time.UTC); !got.After(chrono.DateTimeFromNow()) || got.Second() != 0 {
+		t.Error("value wrong", got)
+	}
+}
+
+func TestTickAt(t *testing.T) {
+	t.Parallel()
+
+	clock := &jumpClock{now: chrono.NewDateTime(2022, 6, 15, 13, 14, 15, 0, time.UTC)}
+	ctx, cancel := context.WithCancel(context.Background())
+	ticks := chrono.TickAt(ctx, clock, chrono.Hour, time.UTC)
+
+	want := chrono.NewDateTime(2022, 6, 15, 14, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		got := <-ticks
+		// Ticks can be dropped when we're slow to receive, but they must
+		// always be aligned and increasing
+		if got.Before(want) || got.Minute() != 0 || got.Second() != 0 {
+			t.Error("tick wrong", got)
+		}
+		want = got.Add(time.Hour)
+	}
+
+	cancel()
+	for range ticks {
+	}
+}
@@ -15,6 +15,11 @@ const (
 	TimeSQLLayout = "15:04:05.999999-07"
 )
 
+// TimeJSONStrict makes Time.UnmarshalJSON accept only the exact
+// "15:04:05Z07:00" form (with an optional fraction) instead of also accepting
+// times without seconds or an offset.
+var TimeJSONStrict = false
+
 // Time is mostly a pass-through wrapper for time.Time. This allows
 // nicer interoperability with the Time and Date types as well as a couple
 // additional utility methods.
@@ -24,13 +29,13 @@ type Time struct {
 
 // NewTime from all components
 func NewTime(hour, min, sec, nsec int, loc *time.Location) Time {
-	return Time{t: time.Date(0, 1, 1, hour, min, sec, nsec, time.UTC)}
+	return Time{t: time.Date(0, 1, 1, hour, min, sec, nsec, loc)}
 }
 
-// TimeFromNow creates a new date time from the current moment in time
-// (local).
+// TimeFromNow creates a new time from the current moment in time (in
+// NowLocation, local by default).
 func TimeFromNow() Time {
-	return Time{t: time.Now()}
+	return Time{t: now()}
 }
 
 // TimeFromString parses a date time (ISO8601/RFC3339 date-time) in the
@@ -38,7 +43,7 @@ func TimeFromNow() Time {
 func TimeFromString(str string) (Time, error) {
 	t, err := time.Parse(timeLayout, str)
 	if err != nil {
-		return Time{}, fmt.Errorf("failed to parse time (%s): %w", str, err)
+		return Time{}, &ParseError{Op: "parse", Kind: "time", Input: str, Layout: timeLayout, Err: err}
 	}
 
 	return Time{t: t}, nil
@@ -49,7 +54,7 @@ func TimeFromString(str string) (Time, error) {
 func TimeFromStringLocation(str string, loc *time.Location) (Time, error) {
 	t, err := time.ParseInLocation(timeLayout, str, loc)
 	if err != nil {
-		return Time{}, fmt.Errorf("failed to parse time (%s): %w", str, err)
+		return Time{}, &ParseError{Op: "parse", Kind: "time", Input: str, Layout: timeLayout, Err: err}
 	}
 
 	return Time{t: t}, nil
@@ -59,7 +64,7 @@ func TimeFromStringLocation(str string, loc *time.Location) (Time, error) {
 func TimeFromLayout(layout, str string) (Time, error) {
 	t, err := time.Parse(layout, str)
 	if err != nil {
-		return Time{}, fmt.Errorf("failed to parse time (%s): %w", str, err)
+		return Time{}, &ParseError{Op: "parse", Kind: "time", Input: str, Layout: layout, Err: err}
 	}
 
 	return Time{t: t}, nil
@@ -67,9 +72,9 @@ func TimeFromLayout(layout, str string) (Time, error) {
 
 // TimeFromStringLocation parses a time from a layout in the specified location.
 func TimeFromLayoutLocation(layout, str string, loc *time.Location) (Time, error) {
-	t, err := time.ParseInLocation(timeLayout, str, loc)
+	t, err := time.ParseInLocation(layout, str, loc)
 	if err != nil {
-		return Time{}, fmt.Errorf("failed to parse time (%s): %w", str, err)
+		return Time{}, &ParseError{Op: "parse", Kind: "time", Input: str, Layout: layout, Err: err}
 	}
 
 	return Time{t: t}, nil
@@ -109,12 +114,12 @@ func (t Time) Add(dur time.Duration) Time {
 	return TimeFromStdTime(t.t.Add(dur))
 }
 
-// After returns true if rhs is after d
+// After returns true if t is after rhs
 func (t Time) After(rhs Time) bool {
 	return t.t.After(rhs.t)
 }
 
-// AfterOrEqual returns true if rhs is equal to or after d
+// AfterOrEqual returns true if t is equal to or after rhs
 func (t Time) AfterOrEqual(rhs Time) bool {
 	return t.t.After(rhs.t) || t.t.Equal(rhs.t)
 }
@@ -124,15 +129,18 @@ func (t Time) AfterOrEqual(rhs Time) bool {
 // string is not checked for date-like parts that could be leaked out but will
 // be zero.
 func (t Time) AppendFormat(b []byte, layout string) []byte {
+	if layout == timeLayout {
+		return appendTime(b, t.t)
+	}
 	return t.t.AppendFormat(b, layout)
 }
 
-// Before returns true if rhs is before d
+// Before returns true if t is before rhs
 func (t Time) Before(rhs Time) bool {
 	return t.t.Before(rhs.t)
 }
 
-// BeforeOrEqual returns true if rhs is before d
+// BeforeOrEqual returns true if t is equal to or before rhs
 func (t Time) BeforeOrEqual(rhs Time) bool {
 	return t.t.Before(rhs.t) || t.t.Equal(rhs.t)
 }
@@ -142,6 +150,13 @@ func (t Time) Between(start, end Time) bool {
 	return t.t.After(start.t) && t.t.Before(end.t)
 }
 
+// BetweenBounds returns true if t is in the time range from start to end
+// including or excluding each of them according to bounds, eg.
+// BoundsClosedOpen for the half-open range [start, end).
+func (t Time) BetweenBounds(start, end Time, bounds BoundType) bool {
+	return inBounds(t.t, start.t, end.t, bounds)
+}
+
 // BetweenOrEqual returns true if t is in the inclusive time range [start, end]
 func (t Time) BetweenOrEqual(start, end Time) bool {
 	return t.AfterOrEqual(start) && t.BeforeOrEqual(end)
@@ -152,6 +167,12 @@ func (t Time) Equal(rhs Time) bool {
 	return t.t.Equal(rhs.t)
 }
 
+// EqualClock returns true if t and rhs have the same wall clock time
+// regardless of their offsets, unlike Equal which compares the instants.
+func (t Time) EqualClock(rhs Time) bool {
+	return equalClock(t.t, rhs.t)
+}
+
 // GoString implements fmt.GoStringer
 func (t Time) GoString() string {
 	hr, min, sec := t.t.Clock()
@@ -169,46 +190,66 @@ func (t Time) MarshalBinary() ([]byte, error) {
 
 // MarshalJSON implements json.Marshaller
 func (t Time) MarshalJSON() ([]byte, error) {
-	return []byte(t.t.Format(quotedTimeLayout)), nil
+	b := make([]byte, 0, len(quotedTimeLayout))
+	b = append(b, '"')
+	b = appendTime(b, t.t)
+	return append(b, '"'), nil
 }
 
 // MarshalText implements encoding.TextMarshaller
 func (t Time) MarshalText() ([]byte, error) {
-	return []byte(t.String()), nil
+	return appendTime(make([]byte, 0, len(timeLayout)), t.t), nil
 }
 
 // String returns an ISO8601 Time, also an RFC3339 date-time
 func (t Time) String() string {
-	return t.t.Format(timeLayout)
+	var buf [len(timeLayout)]byte
+	return string(appendTime(buf[:0], t.t))
 }
 
 // UnmarshalBinary
 func (d *Time) UnmarshalBinary(data []byte) error {
 	var t time.Time
 	if err := t.UnmarshalBinary(data); err != nil {
-		return fmt.Errorf("failed to unmarshal Time (%q): %w", data, err)
+		return &ParseError{Op: "unmarshal", Kind: "time", Input: string(data), Err: err}
 	}
 	d.t = t
 	return nil
 }
 
-// UnmarshalJSON parses a quoted ISO8601 Time / RFC3339 full-time
+// UnmarshalJSON parses a quoted ISO8601 Time / RFC3339 full-time. Unless
+// TimeJSONStrict is set the seconds, fraction and offset are optional, eg.
+// "15:04", "15:04:05.123" and "15:04:05+05:30" are all accepted. Times without
+// an offset are UTC. A null or "" is handled according to EmptyJSONIsZero.
 func (d *Time) UnmarshalJSON(data []byte) error {
+	if empty, err := emptyJSON(data, "time"); empty {
+		*d = Time{}
+		return err
+	}
+
+	if !TimeJSONStrict && len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		if t, ok := parseJSONTime(data[1 : len(data)-1]); ok {
+			d.t = t
+			return nil
+		}
+	}
+
 	t, err := time.Parse(quotedTimeLayout, string(data))
 	if err != nil {
-		return fmt.Errorf("failed to unmarshal time (%q): %w", data, err)
+		return &ParseError{Op: "unmarshal", Kind: "time", Input: string(data), Layout: quotedTimeLayout, Err: err}
 	}
 	d.t = t
 	return nil
 }
 
-// UnmarshalText parses a byte string with ISO8601 Time / RFC3339 full-time
+// UnmarshalText parses a byte string with ISO8601 Time / RFC3339 full-time,
+// or any of the other TimeConfigLayouts.
 func (d *Time) UnmarshalText(data []byte) error {
-	t, err := time.Parse(timeLayout, string(data))
+	t, err := parseConfigString(string(data), TimeConfigLayouts)
 	if err != nil {
-		return fmt.Errorf("failed to unmarshal time (%q): %w", data, err)
+		return &ParseError{Op: "unmarshal", Kind: "time", Input: string(data), Err: err}
 	}
-	d.t = t
+	*d = TimeFromStdTime(t)
 	return nil
 }
 
@@ -219,7 +260,7 @@ func (t Time) Clock() (hour, min, sec int) {
 
 // Format using a layout string from time.Time. This can accidentally pull
 // zero'd date information from the underlying time.Time so caution must be
-// used.
+// used, see SafeFormat.
 func (t Time) Format(layout string) string {
 	return t.t.Format(layout)
 }
@@ -239,7 +280,29 @@ func (t Time) IsDST() bool {
 	return t.t.IsDST()
 }
 
-// IsZero returns true if the Date is the zero value.
+// IsAfter returns true if t is after rhs, the same as After
+func (t Time) IsAfter(rhs Time) bool {
+	return t.After(rhs)
+}
+
+// IsBefore returns true if t is before rhs, the same as Before
+func (t Time) IsBefore(rhs Time) bool {
+	return t.Before(rhs)
+}
+
+// IsOnOrAfter returns true if t is equal to or after rhs, the same as
+// AfterOrEqual
+func (t Time) IsOnOrAfter(rhs Time) bool {
+	return t.AfterOrEqual(rhs)
+}
+
+// IsOnOrBefore returns true if t is equal to or before rhs, the same as
+// BeforeOrEqual
+func (t Time) IsOnOrBefore(rhs Time) bool {
+	return t.BeforeOrEqual(rhs)
+}
+
+// IsZero returns true if the Time is the zero value.
 func (t Time) IsZero() bool {
 	return t.t.IsZero()
 }
@@ -264,11 +327,28 @@ func (t Time) Nanosecond() int {
 	return t.t.Nanosecond()
 }
 
+// On returns the datetime on d at the wall clock time of t in loc, the
+// location of t itself is ignored. See Date.At to keep it.
+func (t Time) On(d Date, loc *time.Location) DateTime {
+	year, month, day := d.t.Date()
+	hour, min, sec := t.t.Clock()
+	return DateTime{t: time.Date(year, month, day, hour, min, sec, t.t.Nanosecond(), loc)}
+}
+
 // Round to the duration unit specified
 func (t Time) Round(dur time.Duration) Time {
 	return Time{t: t.t.Round(dur)}
 }
 
+// SafeFormat is like Format but returns a *LayoutError instead of printing
+// the zero date if the layout contains date tokens, eg. "2006-01-02".
+func (t Time) SafeFormat(layout string) (string, error) {
+	if err := checkLayout(layout, "time", TokenYear, TokenMonth, TokenDay, TokenYearDay, TokenWeekday); err != nil {
+		return "", err
+	}
+	return t.t.Format(layout), nil
+}
+
 // Second returns the second of the minute
 func (t Time) Second() int {
 	return t.t.Second()
@@ -293,12 +373,15 @@ func (t Time) Zone() (name string, offset int) {
 	return t.t.Zone()
 }
 
-// Value implements driver.Valuer
+// Value implements driver.Valuer using the current Dialect's TimeLayout
 func (t Time) Value() (driver.Value, error) {
-	return t.t.Format(TimeSQLLayout), nil
+	return t.t.Format(currentDialect().TimeLayout), nil
 }
 
 // Scan implements sql.Scanner. SQL requires the use of ISO8601.
+// Offsets with minutes (+05:30) are also accepted since that is what postgres
+// returns for zones that aren't a whole number of hours, as are the current
+// Dialect's TimeScanLayouts.
 func (t *Time) Scan(value any) error {
 	if value == nil {
 		t.t = time.Time{}
@@ -315,16 +398,32 @@ func (t *Time) Scan(value any) error {
 		*t = TimeFromUnix(int64(v), 0)
 		return nil
 	case string:
+		if newt, ok := parseSQLTime(v); ok {
+			t.t = newt
+			return nil
+		}
 		newt, err := time.Parse(TimeSQLLayout, v)
 		if err != nil {
-			return fmt.Errorf("failed to scan time (%q): %w", v, err)
+			if newt, ok := parseLayouts(v, currentDialect().TimeScanLayouts); ok {
+				*t = TimeFromStdTime(newt)
+				return nil
+			}
+			return &ParseError{Op: "scan", Kind: "time", Input: v, Layout: TimeSQLLayout, Err: err}
 		}
 		t.t = newt
 		return nil
 	case []byte:
+		if newt, ok := parseSQLTime(v); ok {
+			t.t = newt
+			return nil
+		}
 		newt, err := time.Parse(TimeSQLLayout, string(v))
 		if err != nil {
-			return fmt.Errorf("failed to scan time (%q): %w", v, err)
+			if newt, ok := parseLayouts(string(v), currentDialect().TimeScanLayouts); ok {
+				*t = TimeFromStdTime(newt)
+				return nil
+			}
+			return &ParseError{Op: "scan", Kind: "time", Input: string(v), Layout: TimeSQLLayout, Err: err}
 		}
 		t.t = newt
 		return nil
@@ -333,5 +432,5 @@ func (t *Time) Scan(value any) error {
 		return nil
 	}
 
-	return fmt.Errorf("failed to scan type '%T' into time", value)
+	return &TypeError{Op: "scan", Kind: "time", Value: value}
 }
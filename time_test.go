@@ -2,6 +2,7 @@ package chrono_test
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 	"time"
 
@@ -286,6 +287,86 @@ func TestTimeMarshalling(t *testing.T) {
 	}
 }
 
+func TestTimeUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	plus530 := time.FixedZone("", 5*60*60+30*60)
+	tests := []struct {
+		In   string
+		Want chrono.Time
+	}{
+		{`"03:04"`, chrono.NewTime(3, 4, 0, 0, time.UTC)},
+		{`"03:04:05"`, chrono.NewTime(3, 4, 5, 0, time.UTC)},
+		{`"03:04:05Z"`, chrono.NewTime(3, 4, 5, 0, time.UTC)},
+		{`"03:04:05.123"`, chrono.NewTime(3, 4, 5, 123000000, time.UTC)},
+		{`"03:04:05.123456789Z"`, chrono.NewTime(3, 4, 5, 123456789, time.UTC)},
+		{`"03:04:05+05:30"`, chrono.NewTime(3, 4, 5, 0, plus530)},
+		{`"03:04:05.5-07:00"`, chrono.NewTime(3, 4, 5, 500000000, time.FixedZone("", -7*60*60))},
+		{`"03:04+05:30"`, chrono.NewTime(3, 4, 0, 0, plus530)},
+	}
+
+	for _, test := range tests {
+		var got chrono.Time
+		if err := got.UnmarshalJSON([]byte(test.In)); err != nil {
+			t.Errorf("%s: %v", test.In, err)
+			continue
+		}
+		if !got.Equal(test.Want) {
+			t.Errorf("%s: want %v, got %v", test.In, test.Want, got)
+		}
+		_, gotOff := got.Zone()
+		_, wantOff := test.Want.Zone()
+		if gotOff != wantOff {
+			t.Errorf("%s: want offset %d, got %d", test.In, wantOff, gotOff)
+		}
+	}
+
+	bad := []string{
+		`03:04:05Z`, `"03:04:05Z`, `"3:04"`, `"24:00"`, `"03:60"`, `"03:04:60"`,
+		`"03:04:05."`, `"03:04:05.1234567891"`, `"03:04:05X"`, `"03:04:05+5"`,
+	}
+	for _, in := range bad {
+		var got chrono.Time
+		err := got.UnmarshalJSON([]byte(in))
+		var perr *chrono.ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("%s: expected a parse error, got: %v", in, err)
+		}
+	}
+}
+
+func TestTimeUnmarshalJSONStrict(t *testing.T) {
+	// Not parallel, changes package level configuration
+	chrono.TimeJSONStrict = true
+	defer func() { chrono.TimeJSONStrict = false }()
+
+	for _, in := range []string{`"03:04"`, `"03:04:05"`, `"03:04:05.123"`, `"03:04+05:30"`} {
+		var got chrono.Time
+		if err := got.UnmarshalJSON([]byte(in)); err == nil {
+			t.Errorf("%s: expected an error in strict mode", in)
+		}
+	}
+
+	want := chrono.NewTime(3, 4, 5, 123000000, time.UTC)
+	for _, in := range []string{`"03:04:05.123Z"`, `"08:34:05.123+05:30"`} {
+		var got chrono.Time
+		if err := got.UnmarshalJSON([]byte(in)); err != nil {
+			t.Errorf("%s: %v", in, err)
+		} else if !got.Equal(want) {
+			t.Errorf("%s: want %v, got %v", in, want, got)
+		}
+	}
+}
+
+func BenchmarkTimeUnmarshalJSON(b *testing.B) {
+	data := []byte(`"03:04:05.123456+05:30"`)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var t chrono.Time
+		_ = t.UnmarshalJSON(data)
+	}
+}
+
 func TestTimeSQL(t *testing.T) {
 	t.Parallel()
 
@@ -336,3 +417,61 @@ func TestTimeSQL(t *testing.T) {
 		t.Error("value was wrong")
 	}
 }
+
+func TestTimeOn(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("", -3*60*60)
+	tm := chrono.TimeFromStdTime(time.Date(0, 1, 1, 3, 4, 5, 6, time.UTC))
+
+	got := tm.On(chrono.NewDate(2000, 1, 2), loc)
+	if want := time.Date(2000, 1, 2, 3, 4, 5, 6, loc); !got.ToStdTime().Equal(want) || got.Location() != loc {
+		t.Error("on wrong:", got)
+	}
+}
+
+func TestNewTimeLocation(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("", 2*60*60)
+	got := chrono.NewTime(3, 4, 5, 6, loc)
+	if got.Location() != loc {
+		t.Error("location wrong:", got.Location())
+	}
+	if h, m, s := got.Clock(); h != 3 || m != 4 || s != 5 {
+		t.Error("clock wrong:", h, m, s)
+	}
+	if !got.Equal(chrono.NewTime(1, 4, 5, 6, time.UTC)) {
+		t.Error("should be the same moment as 01:04:05 UTC:", got)
+	}
+}
+
+func TestTimeSafeFormat(t *testing.T) {
+	t.Parallel()
+
+	tm := chrono.NewTime(15, 4, 5, 0, time.UTC)
+	if got, err := tm.SafeFormat("3:04PM Z07:00"); err != nil || got != "3:04PM Z" {
+		t.Error("wrong format:", got, err)
+	}
+
+	for _, layout := range []string{"2006 15:04", "Jan 15:04", "Mon 15:04", "15:04 002", "_2 15:04"} {
+		_, err := tm.SafeFormat(layout)
+		var lerr *chrono.LayoutError
+		if !errors.As(err, &lerr) || lerr.Type != "time" {
+			t.Errorf("%q: expected a layout error, got: %v", layout, err)
+		}
+	}
+}
+
+func TestTimeEqualClock(t *testing.T) {
+	t.Parallel()
+
+	a := chrono.NewTime(10, 0, 0, 500, time.FixedZone("", 60*60))
+	b := chrono.NewTime(10, 0, 0, 500, time.FixedZone("", -5*60*60))
+	if !a.EqualClock(b) || a.Equal(b) {
+		t.Error("same clock in different zones is not equal")
+	}
+	if a.EqualClock(a.Add(time.Nanosecond)) {
+		t.Error("nanoseconds are part of the clock")
+	}
+}
@@ -0,0 +1,194 @@
+package chrono
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const dayDuration = 24 * time.Hour
+
+// TimeRange is a half-open range of the time of day [Start, End). When End is
+// before Start the range crosses midnight, eg. 22:00-06:00 is an overnight
+// range. When Start and End are equal the range covers the entire day.
+//
+// Only the wall clock of Start and End is used, their locations are ignored.
+type TimeRange struct {
+	Start Time
+	End   Time
+}
+
+// NewTimeRange creates a time range
+func NewTimeRange(start, end Time) TimeRange {
+	return TimeRange{Start: start, End: end}
+}
+
+// TimeRangeFromString parses a range in the form "15:04-15:04" where the
+// seconds are optional on either side, eg. "22:00-06:00" or
+// "09:00:30-17:00".
+func TimeRangeFromString(str string) (TimeRange, error) {
+	startStr, endStr, ok := strings.Cut(str, "-")
+	if !ok {
+		return TimeRange{}, &ParseError{Op: "parse", Kind: "time range", Input: str, Err: errors.New("missing '-'")}
+	}
+
+	start, err := parseClock(startStr)
+	if err != nil {
+		return TimeRange{}, &ParseError{Op: "parse", Kind: "time range", Input: str, Err: err}
+	}
+	end, err := parseClock(endStr)
+	if err != nil {
+		return TimeRange{}, &ParseError{Op: "parse", Kind: "time range", Input: str, Err: err}
+	}
+
+	return TimeRange{Start: start, End: end}, nil
+}
+
+// Contains returns true if the wall clock of t is within the range
+func (r TimeRange) Contains(t Time) bool {
+	start, end, at := clockOffset(r.Start), clockOffset(r.End), clockOffset(t)
+	switch {
+	case start == end:
+		return true
+	case start < end:
+		return at >= start && at < end
+	default:
+		return at >= start || at < end
+	}
+}
+
+// Duration returns the length of the range
+func (r TimeRange) Duration() time.Duration {
+	dur := clockOffset(r.End) - clockOffset(r.Start)
+	if dur <= 0 {
+		dur += dayDuration
+	}
+	return dur
+}
+
+// IsOvernight returns true if the range crosses midnight
+func (r TimeRange) IsOvernight() bool {
+	return clockOffset(r.End) < clockOffset(r.Start)
+}
+
+// Overlaps returns true if the two ranges share any time of day
+func (r TimeRange) Overlaps(o TimeRange) bool {
+	for _, a := range r.segments() {
+		for _, b := range o.segments() {
+			if a[0] < b[1] && b[0] < a[1] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GoString implements fmt.GoStringer
+func (r TimeRange) GoString() string {
+	return fmt.Sprintf("chrono.TimeRange(%s)", r)
+}
+
+// String returns the range as "15:04-15:04", seconds are included for
+// either side only when they're not zero.
+func (r TimeRange) String() string {
+	return formatClock(r.Start) + "-" + formatClock(r.End)
+}
+
+// MarshalJSON implements json.Marshaller
+func (r TimeRange) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + r.String() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaller
+func (r TimeRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalJSON parses a quoted time range
+func (r *TimeRange) UnmarshalJSON(data []byte) error {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return &ParseError{Op: "unmarshal", Kind: "time range", Input: string(data), Err: errors.New("expected a string")}
+	}
+	return r.UnmarshalText(data[1 : len(data)-1])
+}
+
+// UnmarshalText parses a time range
+func (r *TimeRange) UnmarshalText(data []byte) error {
+	tr, err := TimeRangeFromString(string(data))
+	if err != nil {
+		return err
+	}
+	*r = tr
+	return nil
+}
+
+// Value implements driver.Valuer, the range is stored as text
+func (r TimeRange) Value() (driver.Value, error) {
+	return r.String(), nil
+}
+
+// Scan implements sql.Scanner
+func (r *TimeRange) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*r = TimeRange{}
+		return nil
+	case string:
+		return r.UnmarshalText([]byte(v))
+	case []byte:
+		return r.UnmarshalText(v)
+	}
+
+	return &TypeError{Op: "scan", Kind: "time range", Value: value}
+}
+
+// segments splits the range into non-wrapping [start, end) offsets from
+// midnight
+func (r TimeRange) segments() [][2]time.Duration {
+	start, end := clockOffset(r.Start), clockOffset(r.End)
+	switch {
+	case start == end:
+		return [][2]time.Duration{{0, dayDuration}}
+	case start < end:
+		return [][2]time.Duration{{start, end}}
+	default:
+		return [][2]time.Duration{{start, dayDuration}, {0, end}}
+	}
+}
+
+// clockOffset returns the wall clock of t as an offset from midnight
+func clockOffset(t Time) time.Duration {
+	hour, min, sec := t.Clock()
+	return time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute +
+		time.Duration(sec)*time.Second + time.Duration(t.Nanosecond())
+}
+
+// parseClock parses 15:04, 15:04:05 or 15:04:05.999999999 into a Time in
+// UTC
+func parseClock(str string) (Time, error) {
+	str = strings.TrimSpace(str)
+	layout := "15:04"
+	if len(str) > len(layout) {
+		// time.Parse accepts a fraction after the seconds
+		layout = "15:04:05"
+	}
+	t, err := time.Parse(layout, str)
+	if err != nil {
+		return Time{}, err
+	}
+	return TimeFromStdTime(t), nil
+}
+
+// formatClock formats as 15:04, or 15:04:05 if there are seconds and
+// 15:04:05.999999999 if there's a fraction of a second
+func formatClock(t Time) string {
+	if t.Nanosecond() != 0 {
+		return t.Format("15:04:05.999999999")
+	}
+	if t.Second() != 0 {
+		return t.Format("15:04:05")
+	}
+	return t.Format("15:04")
+}
@@ -0,0 +1,151 @@
+package chrono_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestTimeRangeContains(t *testing.T) {
+	t.Parallel()
+
+	day := chrono.NewTimeRange(chrono.NewTime(9, 0, 0, 0, time.UTC), chrono.NewTime(17, 0, 0, 0, time.UTC))
+	night := chrono.NewTimeRange(chrono.NewTime(22, 0, 0, 0, time.UTC), chrono.NewTime(6, 0, 0, 0, time.UTC))
+	all := chrono.NewTimeRange(chrono.NewTime(0, 0, 0, 0, time.UTC), chrono.NewTime(0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		Range chrono.TimeRange
+		At    chrono.Time
+		Want  bool
+	}{
+		{day, chrono.NewTime(9, 0, 0, 0, time.UTC), true},
+		{day, chrono.NewTime(16, 59, 59, 0, time.UTC), true},
+		{day, chrono.NewTime(17, 0, 0, 0, time.UTC), false},
+		{day, chrono.NewTime(8, 0, 0, 0, time.UTC), false},
+		{night, chrono.NewTime(23, 0, 0, 0, time.UTC), true},
+		{night, chrono.NewTime(0, 0, 0, 0, time.UTC), true},
+		{night, chrono.NewTime(5, 59, 0, 0, time.UTC), true},
+		{night, chrono.NewTime(6, 0, 0, 0, time.UTC), false},
+		{night, chrono.NewTime(12, 0, 0, 0, time.UTC), false},
+		{all, chrono.NewTime(12, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, test := range tests {
+		if got := test.Range.Contains(test.At); got != test.Want {
+			t.Errorf("%s contains %s: want %t, got %t", test.Range, test.At, test.Want, got)
+		}
+	}
+
+	if d := day.Duration(); d != 8*time.Hour {
+		t.Error("duration wrong", d)
+	}
+	if d := night.Duration(); d != 8*time.Hour {
+		t.Error("duration wrong", d)
+	}
+	if d := all.Duration(); d != 24*time.Hour {
+		t.Error("duration wrong", d)
+	}
+	if day.IsOvernight() || !night.IsOvernight() {
+		t.Error("overnight wrong")
+	}
+}
+
+func TestTimeRangeOverlaps(t *testing.T) {
+	t.Parallel()
+
+	parse := func(s string) chrono.TimeRange {
+		r, err := chrono.TimeRangeFromString(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	tests := []struct {
+		A, B string
+		Want bool
+	}{
+		{"09:00-17:00", "16:00-18:00", true},
+		{"09:00-17:00", "17:00-18:00", false},
+		{"22:00-06:00", "05:00-07:00", true},
+		{"22:00-06:00", "21:00-22:00", false},
+		{"22:00-06:00", "23:00-01:00", true},
+		{"09:00-17:00", "00:00-00:00", true},
+	}
+
+	for _, test := range tests {
+		if got := parse(test.A).Overlaps(parse(test.B)); got != test.Want {
+			t.Errorf("%s overlaps %s: want %t, got %t", test.A, test.B, test.Want, got)
+		}
+		if got := parse(test.B).Overlaps(parse(test.A)); got != test.Want {
+			t.Errorf("%s overlaps %s: want %t, got %t", test.B, test.A, test.Want, got)
+		}
+	}
+}
+
+func TestTimeRangeEncoding(t *testing.T) {
+	t.Parallel()
+
+	r, err := chrono.TimeRangeFromString("22:00-06:00:30")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := r.String(); s != "22:00-06:00:30" {
+		t.Error("string wrong", s)
+	}
+
+	js, err := json.Marshal(r)
+	if err != nil {
+		t.Error(err)
+	}
+	if string(js) != `"22:00-06:00:30"` {
+		t.Error("json wrong", string(js))
+	}
+	var unjs chrono.TimeRange
+	if err = json.Unmarshal(js, &unjs); err != nil {
+		t.Error(err)
+	}
+	if unjs.String() != r.String() {
+		t.Error("value wrong", unjs)
+	}
+
+	v, err := r.Value()
+	if err != nil {
+		t.Error(err)
+	}
+	var scanned chrono.TimeRange
+	if err = scanned.Scan([]byte(v.(string))); err != nil {
+		t.Error(err)
+	}
+	if scanned.String() != r.String() {
+		t.Error("value wrong", scanned)
+	}
+
+	// Spaces around the clocks and fractions of a second
+	r, err = chrono.TimeRangeFromString(" 09:00 - 17:00:00.25")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := r.String(); s != "09:00-17:00:00.25" {
+		t.Error("string wrong", s)
+	}
+	v, err = r.Value()
+	if err != nil {
+		t.Error(err)
+	}
+	scanned = chrono.TimeRange{}
+	if err = scanned.Scan(v); err != nil {
+		t.Error(err)
+	}
+	if scanned != r {
+		t.Error("fraction should round trip", scanned)
+	}
+
+	for _, bad := range []string{"22:00", "25:00-06:00", "22:00-6"} {
+		if _, err := chrono.TimeRangeFromString(bad); err == nil {
+			t.Error("expected an error for", bad)
+		}
+	}
+}
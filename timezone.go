@@ -0,0 +1,102 @@
+package chrono
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	locationCache sync.Map
+
+	abbreviationsOnce sync.Once
+	abbreviations     map[string][]string
+)
+
+// LoadLocation is time.LoadLocation with a cache, each zone is only read
+// from the tz database once. Failures are not cached.
+func LoadLocation(name string) (*time.Location, error) {
+	if loc, ok := locationCache.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := locationCache.LoadOrStore(name, loc)
+	return actual.(*time.Location), nil
+}
+
+// TimeZoneGroup is the time zones of a region, eg. Europe
+type TimeZoneGroup struct {
+	Region string
+	Zones  []string
+}
+
+// ListTimeZones returns the canonical IANA time zone names grouped by their
+// region (the part before the first /), both sorted alphabetically. It's
+// intended for building time zone pickers, aliases like US/Eastern and
+// deprecated zones are not included.
+func ListTimeZones() []TimeZoneGroup {
+	var groups []TimeZoneGroup
+	for _, name := range timeZoneNames {
+		region := name[:strings.IndexByte(name, '/')]
+		if len(groups) == 0 || groups[len(groups)-1].Region != region {
+			groups = append(groups, TimeZoneGroup{Region: region})
+		}
+		group := &groups[len(groups)-1]
+		group.Zones = append(group.Zones, name)
+	}
+	return groups
+}
+
+// AbbreviationToZones returns the names of the time zones that use the
+// abbreviation, eg. PST, in either winter or summer of the current year
+// according to DefaultClock. Abbreviations are ambiguous (IST is used in
+// India, Ireland and Israel) so there can be several, the result is sorted
+// and nil when nothing matches. Zones the tz database on this system doesn't
+// have are skipped.
+func AbbreviationToZones(abbr string) []string {
+	abbreviationsOnce.Do(loadAbbreviations)
+	zones := abbreviations[strings.ToUpper(abbr)]
+	if zones == nil {
+		return nil
+	}
+	return append([]string(nil), zones...)
+}
+
+func loadAbbreviations() {
+	year := DefaultClock.Now().Year()
+	january := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	july := time.Date(year, time.July, 1, 0, 0, 0, 0, time.UTC)
+
+	abbreviations = make(map[string][]string)
+	for _, name := range timeZoneNames {
+		loc, err := LoadLocation(name)
+		if err != nil {
+			continue
+		}
+		winter, _ := january.In(loc).Zone()
+		summer, _ := july.In(loc).Zone()
+		abbreviations[winter] = append(abbreviations[winter], name)
+		if summer != winter {
+			abbreviations[summer] = append(abbreviations[summer], name)
+		}
+	}
+	for _, zones := range abbreviations {
+		sort.Strings(zones)
+	}
+}
+
+// OffsetAt returns the offset from UTC of the named zone at d, eg. -5h for
+// America/New_York in winter
+func OffsetAt(zone string, d DateTime) (time.Duration, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return 0, err
+	}
+	_, offset := d.t.In(loc).Zone()
+	return time.Duration(offset) * time.Second, nil
+}
@@ -0,0 +1,101 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestLoadLocation(t *testing.T) {
+	t.Parallel()
+
+	a, err := chrono.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := chrono.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b || a.String() != "Europe/Paris" {
+		t.Error("location should be cached:", a, b)
+	}
+
+	if _, err := chrono.LoadLocation("Nowhere/Special"); err == nil {
+		t.Error("expected an error for an unknown zone")
+	}
+}
+
+func TestListTimeZones(t *testing.T) {
+	t.Parallel()
+
+	groups := chrono.ListTimeZones()
+	regions := map[string][]string{}
+	for i, group := range groups {
+		if i > 0 && groups[i-1].Region >= group.Region {
+			t.Error("regions should be sorted:", groups[i-1].Region, group.Region)
+		}
+		regions[group.Region] = group.Zones
+	}
+
+	found := false
+	for _, zone := range regions["Europe"] {
+		if zone == "Europe/London" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Europe/London should be in Europe:", regions["Europe"])
+	}
+	if len(regions["America"]) < 100 {
+		t.Error("expected many zones in America:", len(regions["America"]))
+	}
+	if _, ok := regions["US"]; ok {
+		t.Error("aliases should not be listed")
+	}
+}
+
+func TestAbbreviationToZones(t *testing.T) {
+	t.Parallel()
+
+	zones := chrono.AbbreviationToZones("pst")
+	found := false
+	for _, zone := range zones {
+		if zone == "America/Los_Angeles" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("PST should include America/Los_Angeles:", zones)
+	}
+
+	zones = chrono.AbbreviationToZones("IST")
+	if len(zones) < 3 {
+		t.Error("IST is used in several countries:", zones)
+	}
+
+	if zones := chrono.AbbreviationToZones("NOPE"); zones != nil {
+		t.Error("expected no zones:", zones)
+	}
+}
+
+func TestOffsetAt(t *testing.T) {
+	t.Parallel()
+
+	winter := chrono.NewDateTime(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	summer := chrono.NewDateTime(2024, 7, 15, 12, 0, 0, 0, time.UTC)
+
+	if got, err := chrono.OffsetAt("America/New_York", winter); err != nil || got != -5*time.Hour {
+		t.Error("winter offset wrong:", got, err)
+	}
+	if got, err := chrono.OffsetAt("America/New_York", summer); err != nil || got != -4*time.Hour {
+		t.Error("summer offset wrong:", got, err)
+	}
+	if got, err := chrono.OffsetAt("Asia/Kolkata", summer); err != nil || got != 5*time.Hour+30*time.Minute {
+		t.Error("kolkata offset wrong:", got, err)
+	}
+	if _, err := chrono.OffsetAt("Nowhere/Special", summer); err == nil {
+		t.Error("expected an error for an unknown zone")
+	}
+}
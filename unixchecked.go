@@ -0,0 +1,109 @@
+package chrono
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// The checked unix conversions report values outside of MinDateTime and
+// MaxDateTime, or datetimes that don't fit in an int64 of the unit, with an
+// error wrapping ErrTimestampRange rather than silently wrapping around.
+// Every int64 of milliseconds or microseconds is within range so
+// DateTimeFromUnixMilli and DateTimeFromUnixMicro need no checked variants.
+
+// unixBounds returns the unix seconds of MinDateTime and MaxDateTime
+func unixBounds() (min, max int64) {
+	return MinDateTime.t.Unix(), MaxDateTime.t.Unix()
+}
+
+// checkUnix returns false if sec seconds plus nsec nanoseconds is outside of
+// MinDateTime and MaxDateTime
+func checkUnix(sec, nsec int64) bool {
+	min, max := unixBounds()
+	extra := floorDiv(nsec, int64(time.Second))
+	return sec >= min-extra && sec <= max-extra
+}
+
+// DateTimeFromUnixChecked is like DateTimeFromUnix but returns an error if
+// the result would be outside of MinDateTime and MaxDateTime
+func DateTimeFromUnixChecked(sec int64, nsec int64) (DateTime, error) {
+	if !checkUnix(sec, nsec) {
+		return DateTime{}, fmt.Errorf("unix time %d.%09d: %w", sec, nsec, ErrTimestampRange)
+	}
+	return DateTimeFromUnix(sec, nsec), nil
+}
+
+// DateFromUnixChecked is like DateFromUnix but returns an error if the result
+// would be outside of MinDate and MaxDate
+func DateFromUnixChecked(sec int64, nsec int64) (Date, error) {
+	if !checkUnix(sec, nsec) {
+		return Date{}, fmt.Errorf("unix time %d.%09d: %w", sec, nsec, ErrTimestampRange)
+	}
+	return DateFromUnix(sec, nsec), nil
+}
+
+// DateFromEpochDaysChecked is like DateFromEpochDays but returns an error if
+// the result would be outside of MinDate and MaxDate
+func DateFromEpochDaysChecked(days int) (Date, error) {
+	if int64(days) < MinDate.epochDays() || int64(days) > MaxDate.epochDays() {
+		return Date{}, fmt.Errorf("epoch days %d: %w", days, ErrTimestampRange)
+	}
+	return DateFromEpochDays(days), nil
+}
+
+// UnixMilliChecked is like UnixMilli but returns an error if d can't be
+// represented in an int64 of milliseconds
+func (d DateTime) UnixMilliChecked() (int64, error) {
+	return unixChecked(d.t, time.Millisecond)
+}
+
+// UnixMicroChecked is like UnixMicro but returns an error if d can't be
+// represented in an int64 of microseconds
+func (d DateTime) UnixMicroChecked() (int64, error) {
+	return unixChecked(d.t, time.Microsecond)
+}
+
+// UnixNanoChecked is like UnixNano but returns an error if d can't be
+// represented in an int64 of nanoseconds, which is only possible for the
+// years 1678 to 2262
+func (d DateTime) UnixNanoChecked() (int64, error) {
+	return unixChecked(d.t, time.Nanosecond)
+}
+
+// UnixMilliChecked is like UnixMilli but returns an error if d can't be
+// represented in an int64 of milliseconds
+func (d Date) UnixMilliChecked() (int64, error) {
+	return unixChecked(d.t, time.Millisecond)
+}
+
+// UnixMicroChecked is like UnixMicro but returns an error if d can't be
+// represented in an int64 of microseconds
+func (d Date) UnixMicroChecked() (int64, error) {
+	return unixChecked(d.t, time.Microsecond)
+}
+
+// UnixNanoChecked is like UnixNano but returns an error if d can't be
+// represented in an int64 of nanoseconds
+func (d Date) UnixNanoChecked() (int64, error) {
+	return unixChecked(d.t, time.Nanosecond)
+}
+
+// unixChecked returns t as a number of units since the unix epoch or an
+// error if it doesn't fit in an int64
+func unixChecked(t time.Time, unit time.Duration) (int64, error) {
+	perSec := int64(time.Second / unit)
+	sec, frac := t.Unix(), int64(t.Nanosecond())/int64(unit)
+
+	// lo is the most negative whole second that fits, the second before it
+	// may still fit when its fraction is large enough
+	lo := math.MinInt64 / perSec
+	fits := sec <= (math.MaxInt64-frac)/perSec && sec >= lo-1
+	if sec == lo-1 {
+		fits = perSec-frac <= lo*perSec-math.MinInt64
+	}
+	if !fits {
+		return 0, fmt.Errorf("%s in %s: %w", t.Format(time.RFC3339Nano), unit, ErrTimestampRange)
+	}
+	return sec*perSec + frac, nil
+}
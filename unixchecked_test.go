@@ -0,0 +1,104 @@
+package chrono_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+const maxInt = int(^uint(0) >> 1)
+
+func TestUnixChecked(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 6, time.UTC)
+	if got, err := chrono.DateTimeFromUnixChecked(ref.Unix(), 6); err != nil || !got.Equal(ref) {
+		t.Error("wrong datetime:", got, err)
+	}
+	if got, err := chrono.DateFromUnixChecked(ref.Unix(), 0); err != nil || got != chrono.NewDate(2000, 1, 2) {
+		t.Error("wrong date:", got, err)
+	}
+	if got, err := chrono.DateFromEpochDaysChecked(10957); err != nil || got != chrono.NewDate(2000, 1, 1) {
+		t.Error("wrong date:", got, err)
+	}
+
+	if _, err := chrono.DateTimeFromUnixChecked(math.MaxInt64, 0); !errors.Is(err, chrono.ErrTimestampRange) {
+		t.Error("expected range error:", err)
+	}
+	if _, err := chrono.DateTimeFromUnixChecked(math.MinInt64, 0); !errors.Is(err, chrono.ErrTimestampRange) {
+		t.Error("expected range error:", err)
+	}
+	if _, err := chrono.DateFromUnixChecked(math.MaxInt64, 0); !errors.Is(err, chrono.ErrTimestampRange) {
+		t.Error("expected range error:", err)
+	}
+	// An int of days only reaches the limits on 64 bit platforms
+	if strconv.IntSize == 64 {
+		if _, err := chrono.DateFromEpochDaysChecked(maxInt); !errors.Is(err, chrono.ErrTimestampRange) {
+			t.Error("expected range error:", err)
+		}
+		if got, err := chrono.DateFromEpochDaysChecked(chrono.MaxDate.EpochDays()); err != nil || got != chrono.MaxDate {
+			t.Error("max date should be in range:", got, err)
+		}
+	}
+
+	if n, err := ref.UnixNanoChecked(); err != nil || n != ref.UnixNano() {
+		t.Error("wrong nanos:", n, err)
+	}
+	if n, err := ref.UnixMilliChecked(); err != nil || n != ref.UnixMilli() {
+		t.Error("wrong millis:", n, err)
+	}
+	if n, err := ref.UnixMicroChecked(); err != nil || n != ref.UnixMicro() {
+		t.Error("wrong micros:", n, err)
+	}
+	if _, err := chrono.NewDateTime(3000, 1, 1, 0, 0, 0, 0, time.UTC).UnixNanoChecked(); !errors.Is(err, chrono.ErrTimestampRange) {
+		t.Error("expected range error:", err)
+	}
+	if _, err := chrono.NewDate(1600, 1, 1).UnixNanoChecked(); !errors.Is(err, chrono.ErrTimestampRange) {
+		t.Error("expected range error:", err)
+	}
+	if _, err := chrono.MaxDateTime.UnixMilliChecked(); !errors.Is(err, chrono.ErrTimestampRange) {
+		t.Error("expected range error:", err)
+	}
+
+	// The smallest int64 of nanoseconds still fits
+	min := chrono.DateTimeFromUnix(0, math.MinInt64)
+	if n, err := min.UnixNanoChecked(); err != nil || n != math.MinInt64 {
+		t.Error("wrong nanos:", n, err)
+	}
+	if _, err := min.Add(-1).UnixNanoChecked(); !errors.Is(err, chrono.ErrTimestampRange) {
+		t.Error("expected range error:", err)
+	}
+}
+
+func TestDateFromUnixClamps(t *testing.T) {
+	t.Parallel()
+
+	// Clamped to the day inside the limits so it isn't infinity
+	maxFinite, minFinite := chrono.MaxDate.AddDate(0, 0, -1), chrono.MinDate.AddDate(0, 0, 1)
+	if got := chrono.DateFromUnix(math.MaxInt64, 0); got != maxFinite || got.IsInfinite() {
+		t.Error("should clamp to the day before max date:", got)
+	}
+	if got := chrono.DateFromUnix(math.MinInt64, 0); got != minFinite || got.IsInfinite() {
+		t.Error("should clamp to the day after min date:", got)
+	}
+	if v, err := chrono.DateFromUnix(math.MaxInt64, 0).Value(); err != nil || v == "infinity" {
+		t.Error("garbage should not be written as infinity:", v, err)
+	}
+
+	// An int of days only reaches the limits on 64 bit platforms
+	if strconv.IntSize == 64 {
+		if got := chrono.DateFromEpochDays(maxInt); got != maxFinite {
+			t.Error("should clamp to the day before max date:", got)
+		}
+		if got := chrono.DateFromEpochDays(-maxInt - 1); got != minFinite {
+			t.Error("should clamp to the day after min date:", got)
+		}
+		if v, err := chrono.DateFromEpochDays(maxInt / 2).Value(); err != nil || v == "infinity" {
+			t.Error("garbage should not be written as infinity:", v, err)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package chrono
+
+import (
+	"strconv"
+	"time"
+)
+
+// UnixDateTime is a DateTime that encodes to JSON as a number of seconds since
+// the unix epoch instead of an RFC3339 string. Sub-second precision is
+// truncated when encoding. It can be used as a drop-in field type for APIs
+// that require numeric timestamps.
+type UnixDateTime struct {
+	DateTime
+}
+
+// UnixMilliDateTime is a DateTime that encodes to JSON as a number of
+// milliseconds since the unix epoch instead of an RFC3339 string.
+// Sub-millisecond precision is truncated when encoding.
+type UnixMilliDateTime struct {
+	DateTime
+}
+
+// MarshalJSON implements json.Marshaller
+func (u UnixDateTime) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, u.t.Unix(), 10), nil
+}
+
+// UnmarshalJSON parses a number of seconds since the unix epoch, the result
+// is in UTC.
+func (u *UnixDateTime) UnmarshalJSON(data []byte) error {
+	sec, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return &ParseError{Op: "unmarshal", Kind: "unix datetime", Input: string(data), Err: err}
+	}
+	u.t = time.Unix(sec, 0).UTC()
+	return nil
+}
+
+// MarshalJSON implements json.Marshaller
+func (u UnixMilliDateTime) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, u.t.UnixMilli(), 10), nil
+}
+
+// UnmarshalJSON parses a number of milliseconds since the unix epoch, the
+// result is in UTC.
+func (u *UnixMilliDateTime) UnmarshalJSON(data []byte) error {
+	msec, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return &ParseError{Op: "unmarshal", Kind: "unix milli datetime", Input: string(data), Err: err}
+	}
+	u.t = time.UnixMilli(msec).UTC()
+	return nil
+}
@@ -0,0 +1,47 @@
+package chrono_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestUnixJSON(t *testing.T) {
+	t.Parallel()
+
+	ref := chrono.NewDateTime(2000, 1, 2, 3, 4, 30, 123000000, time.UTC)
+
+	type payload struct {
+		Sec   chrono.UnixDateTime      `json:"sec"`
+		Milli chrono.UnixMilliDateTime `json:"milli"`
+	}
+
+	in := payload{
+		Sec:   chrono.UnixDateTime{DateTime: ref},
+		Milli: chrono.UnixMilliDateTime{DateTime: ref},
+	}
+	js, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(js) != `{"sec":946782270,"milli":946782270123}` {
+		t.Error("value wrong", string(js))
+	}
+
+	var out payload
+	if err = json.Unmarshal(js, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !out.Sec.Equal(ref.Truncate(time.Second)) {
+		t.Error("value wrong", out.Sec)
+	}
+	if !out.Milli.Equal(ref) {
+		t.Error("value wrong", out.Milli)
+	}
+
+	if err = json.Unmarshal([]byte(`{"sec":"2000-01-02"}`), &out); err == nil {
+		t.Error("expected an error")
+	}
+}
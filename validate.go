@@ -0,0 +1,213 @@
+package chrono
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ValidationError describes why a string did not match a layout
+type ValidationError struct {
+	Layout string
+	Value  string
+	// Component is the part of the value that was invalid (year, month,
+	// day, hour, minute, second, fraction or offset) and empty if it
+	// could not be determined.
+	Component string
+	// Err is the underlying error from time.Parse
+	Err error
+}
+
+// Error implements error
+func (v *ValidationError) Error() string {
+	if len(v.Component) == 0 {
+		return fmt.Sprintf("invalid value (%s) for layout (%s): %v", v.Value, v.Layout, v.Err)
+	}
+	return fmt.Sprintf("invalid %s in value (%s) for layout (%s): %v", v.Component, v.Value, v.Layout, v.Err)
+}
+
+// Unwrap returns the underlying error
+func (v *ValidationError) Unwrap() error {
+	return v.Err
+}
+
+// IsValidDate returns a *RangeError if the components do not form a real date,
+// eg. February 30th. It returns nil for valid dates.
+func IsValidDate(year int, month time.Month, day int) error {
+	return checkDate(year, month, day)
+}
+
+// IsValidTime returns a *RangeError if any of the components are outside of
+// the valid range for a time of day. It returns nil for valid times.
+func IsValidTime(hour, min, sec, nsec int) error {
+	return checkClock(hour, min, sec, nsec)
+}
+
+// IsValidDateString returns a *ValidationError if str cannot be parsed as a
+// date using layout
+func IsValidDateString(layout, str string) error {
+	return validateString(layout, str)
+}
+
+// IsValidTimeString returns a *ValidationError if str cannot be parsed as a
+// time using layout
+func IsValidTimeString(layout, str string) error {
+	return validateString(layout, str)
+}
+
+// IsValidDateTimeString returns a *ValidationError if str cannot be parsed as a
+// datetime using layout
+func IsValidDateTimeString(layout, str string) error {
+	return validateString(layout, str)
+}
+
+func validateString(layout, str string) error {
+	_, err := time.Parse(layout, str)
+	if err == nil {
+		return nil
+	}
+
+	verr := &ValidationError{Layout: layout, Value: str, Err: err}
+	var perr *time.ParseError
+	if errors.As(err, &perr) {
+		verr.Component = parseErrorComponent(perr)
+	}
+	return verr
+}
+
+// parseErrorComponent figures out which component of the value a
+// time.ParseError is about
+func parseErrorComponent(err *time.ParseError) string {
+	if strings.HasSuffix(err.Message, " out of range") {
+		what := strings.TrimSuffix(strings.TrimPrefix(err.Message, ": "), " out of range")
+		switch {
+		case strings.HasPrefix(what, "time zone"):
+			return "offset"
+		case what == "fractional second":
+			return "fraction"
+		case what == "day-of-year":
+			return "day"
+		}
+		return what
+	}
+
+	switch elem := err.LayoutElem; {
+	case elem == "2006" || elem == "06":
+		return "year"
+	case elem == "01" || elem == "1" || elem == "Jan" || elem == "January":
+		return "month"
+	case elem == "02" || elem == "2" || elem == "_2" || elem == "002" || elem == "__2":
+		return "day"
+	case elem == "Mon" || elem == "Monday":
+		return "weekday"
+	case elem == "15" || elem == "03" || elem == "3":
+		return "hour"
+	case elem == "04" || elem == "4":
+		return "minute"
+	case elem == "05" || elem == "5":
+		return "second"
+	case elem == "PM" || elem == "pm":
+		return "meridiem"
+	case strings.HasPrefix(elem, ".") || strings.HasPrefix(elem, ","):
+		return "fraction"
+	case elem == "MST" || strings.HasPrefix(elem, "Z0") || strings.HasPrefix(elem, "-0"):
+		return "offset"
+	}
+	return ""
+}
+
+// ConstraintError is returned by a Validator when the value doesn't meet a
+// constraint
+type ConstraintError struct {
+	// Kind is the kind of value, date or datetime
+	Kind string
+	// Constraint is the one that failed: non-zero, after, after or equal to,
+	// before or before or equal to
+	Constraint string
+	Value      string
+	// Bound is what the value was compared to, empty for non-zero
+	Bound string
+}
+
+// Error implements error
+func (c *ConstraintError) Error() string {
+	if len(c.Bound) == 0 {
+		return fmt.Sprintf("%s must be %s", c.Kind, c.Constraint)
+	}
+	return fmt.Sprintf("%s %s must be %s %s", c.Kind, c.Value, c.Constraint, c.Bound)
+}
+
+// validatable is implemented by Date and DateTime
+type validatable[T any] interface {
+	After(T) bool
+	Before(T) bool
+	IsZero() bool
+	String() string
+}
+
+// Validator checks a value against a chain of constraints, stopping at the
+// first one that fails, eg:
+//
+//	err := chrono.Validate(start).NotZero().After(min).Before(max).Err()
+type Validator[T validatable[T]] struct {
+	kind  string
+	value T
+	err   *ConstraintError
+}
+
+// Validate starts a chain of constraints on a DateTime
+func Validate(d DateTime) *Validator[DateTime] {
+	return &Validator[DateTime]{kind: "datetime", value: d}
+}
+
+// ValidateDate starts a chain of constraints on a Date
+func ValidateDate(d Date) *Validator[Date] {
+	return &Validator[Date]{kind: "date", value: d}
+}
+
+// NotZero fails if the value is the zero value
+func (v *Validator[T]) NotZero() *Validator[T] {
+	return v.check(!v.value.IsZero(), "non-zero", "")
+}
+
+// After fails unless the value is strictly after min
+func (v *Validator[T]) After(min T) *Validator[T] {
+	return v.check(v.value.After(min), "after", min.String())
+}
+
+// AfterOrEqual fails if the value is before min
+func (v *Validator[T]) AfterOrEqual(min T) *Validator[T] {
+	return v.check(!v.value.Before(min), "after or equal to", min.String())
+}
+
+// Before fails unless the value is strictly before max
+func (v *Validator[T]) Before(max T) *Validator[T] {
+	return v.check(v.value.Before(max), "before", max.String())
+}
+
+// BeforeOrEqual fails if the value is after max
+func (v *Validator[T]) BeforeOrEqual(max T) *Validator[T] {
+	return v.check(!v.value.After(max), "before or equal to", max.String())
+}
+
+// Between fails unless min <= value <= max
+func (v *Validator[T]) Between(min, max T) *Validator[T] {
+	return v.AfterOrEqual(min).BeforeOrEqual(max)
+}
+
+// Err returns the *ConstraintError for the first constraint that failed, or
+// nil if they all passed
+func (v *Validator[T]) Err() error {
+	if v.err == nil {
+		return nil
+	}
+	return v.err
+}
+
+func (v *Validator[T]) check(ok bool, constraint, bound string) *Validator[T] {
+	if v.err == nil && !ok {
+		v.err = &ConstraintError{Kind: v.kind, Constraint: constraint, Value: v.value.String(), Bound: bound}
+	}
+	return v
+}
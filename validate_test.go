@@ -0,0 +1,114 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestIsValidDateAndTime(t *testing.T) {
+	t.Parallel()
+
+	if err := chrono.IsValidDate(2024, time.February, 29); err != nil {
+		t.Error(err)
+	}
+	if err := chrono.IsValidTime(23, 59, 59, 999999999); err != nil {
+		t.Error(err)
+	}
+
+	var rerr *chrono.RangeError
+	if err := chrono.IsValidDate(2023, time.February, 29); !errors.As(err, &rerr) || rerr.Component != "day" {
+		t.Error("expected day error, got", err)
+	}
+	if err := chrono.IsValidTime(12, 60, 0, 0); !errors.As(err, &rerr) || rerr.Component != "minute" {
+		t.Error("expected minute error, got", err)
+	}
+	if err := chrono.IsValidTime(-1, 0, 0, 0); !errors.As(err, &rerr) || rerr.Component != "hour" {
+		t.Error("expected hour error, got", err)
+	}
+}
+
+func TestIsValidStrings(t *testing.T) {
+	t.Parallel()
+
+	if err := chrono.IsValidDateString("2006-01-02", "2024-02-29"); err != nil {
+		t.Error(err)
+	}
+	if err := chrono.IsValidTimeString("15:04", "23:59"); err != nil {
+		t.Error(err)
+	}
+	if err := chrono.IsValidDateTimeString(time.RFC3339, "2024-02-29T23:59:59Z"); err != nil {
+		t.Error(err)
+	}
+
+	tests := []struct {
+		Layout    string
+		In        string
+		Component string
+	}{
+		{"2006-01-02", "2023-02-29", "day"},
+		{"2006-01-02", "2023-13-01", "month"},
+		{"2006-01-02", "2023-01-32", "day"},
+		{"2006-01-02", "20x3-01-01", "year"},
+		{"01/02/2006", "1/02/2023", "month"},
+		{"15:04", "24:00", "hour"},
+		{"15:04", "12:60", "minute"},
+		{"15:04:05", "12:00:61", "second"},
+		{"3:04PM", "3:04XM", "meridiem"},
+		{time.RFC3339, "2023-01-01T00:00:00+25:00", "offset"},
+		{time.RFC3339, "2023-01-01T00:00:00", "offset"},
+		{"2006-01-02", "2023-01-01x", ""},
+	}
+
+	for _, test := range tests {
+		err := chrono.IsValidDateTimeString(test.Layout, test.In)
+		var verr *chrono.ValidationError
+		if !errors.As(err, &verr) {
+			t.Errorf("%q: expected a validation error, got %v", test.In, err)
+			continue
+		}
+		if verr.Component != test.Component {
+			t.Errorf("%q: want component %q, got %q (%v)", test.In, test.Component, verr.Component, verr)
+		}
+	}
+}
+
+func TestValidator(t *testing.T) {
+	t.Parallel()
+
+	min := chrono.NewDateTime(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	max := chrono.NewDateTime(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+	mid := chrono.NewDateTime(2000, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	if err := chrono.Validate(mid).NotZero().After(min).Before(max).Between(min, max).Err(); err != nil {
+		t.Error(err)
+	}
+	if err := chrono.Validate(min).AfterOrEqual(min).BeforeOrEqual(min).Err(); err != nil {
+		t.Error(err)
+	}
+
+	var cerr *chrono.ConstraintError
+	err := chrono.Validate(chrono.DateTime{}).NotZero().After(min).Err()
+	if !errors.As(err, &cerr) || cerr.Constraint != "non-zero" || err.Error() != "datetime must be non-zero" {
+		t.Error("expected not zero error, got:", err)
+	}
+
+	err = chrono.Validate(max).After(min).Before(max).After(max).Err()
+	if !errors.As(err, &cerr) || cerr.Constraint != "before" || cerr.Bound != max.String() {
+		t.Error("expected the first failure, got:", err)
+	}
+	if err.Error() != "datetime 2001-01-01T00:00:00Z must be before 2001-01-01T00:00:00Z" {
+		t.Error("wrong message:", err)
+	}
+
+	d := chrono.NewDate(2000, 1, 2)
+	if err := chrono.ValidateDate(d).NotZero().Between(d, d).Err(); err != nil {
+		t.Error(err)
+	}
+	err = chrono.ValidateDate(d).Between(chrono.NewDate(2000, 1, 3), chrono.NewDate(2000, 2, 1)).Err()
+	if !errors.As(err, &cerr) || cerr.Kind != "date" || cerr.Constraint != "after or equal to" {
+		t.Error("expected a range error, got:", err)
+	}
+}
@@ -0,0 +1,121 @@
+package chrono
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// PartialDateFromVCard parses a vCard date such as a BDAY or ANNIVERSARY. The
+// reduced forms of RFC 6350 are accepted: 19850412, 1985-04, 1985, --0412
+// (no year), --04 and ---12 (only a day), as are the extended forms from
+// vCard 3 like 1985-04-12 and --04-12. A time following a T is ignored.
+func PartialDateFromVCard(str string) (PartialDate, error) {
+	p, err := parseVCardDate(str)
+	if err == nil {
+		err = p.validate()
+	}
+	if err != nil {
+		return PartialDate{}, &ParseError{Op: "parse", Kind: "vcard date", Input: str, Err: err}
+	}
+	return p, nil
+}
+
+// FormatVCard formats p as an RFC 6350 date, eg. --0412 for a date without a
+// year. A year and day without a month can't be represented, the day is left
+// out. It returns a *RangeError if a known component is out of range, eg. year
+// 12345, since it couldn't be parsed back.
+func (p PartialDate) FormatVCard() (string, error) {
+	if err := p.validate(); err != nil {
+		return "", err
+	}
+
+	b := make([]byte, 0, 8)
+	switch {
+	case p.HasYear():
+		b = appendInt4(b, p.Year)
+		if !p.HasMonth() {
+			return string(b), nil
+		}
+		if !p.HasDay() {
+			b = append(b, '-')
+			return string(appendInt2(b, int(p.Month))), nil
+		}
+		b = appendInt2(b, int(p.Month))
+		return string(appendInt2(b, p.Day)), nil
+	case p.HasMonth():
+		b = append(b, "--"...)
+		b = appendInt2(b, int(p.Month))
+		if p.HasDay() {
+			b = appendInt2(b, p.Day)
+		}
+		return string(b), nil
+	case p.HasDay():
+		return string(appendInt2(append(b, "---"...), p.Day)), nil
+	}
+	return "", nil
+}
+
+func parseVCardDate(str string) (PartialDate, error) {
+	if i := strings.IndexByte(str, 'T'); i >= 0 {
+		str = str[:i]
+	}
+
+	ok := true
+	num := func(s string, n int) int {
+		v, good := atoiN(s, n)
+		ok = ok && good && len(s) == n
+		return v
+	}
+
+	var p PartialDate
+	var hasYear, hasMonth, hasDay bool
+	switch {
+	case strings.HasPrefix(str, "---"):
+		p.Day = num(str[3:], 2)
+		hasDay = true
+	case strings.HasPrefix(str, "--"):
+		// --04, --0412 or the vCard 3 style --04-12
+		rest := str[2:]
+		if len(rest) == 5 && rest[2] == '-' {
+			rest = rest[:2] + rest[3:]
+		}
+		if len(rest) != 2 && len(rest) != 4 {
+			ok = false
+			break
+		}
+		p.Month = time.Month(num(rest[:2], 2))
+		hasMonth = true
+		if len(rest) == 4 {
+			p.Day = num(rest[2:], 2)
+			hasDay = true
+		}
+	case len(str) == 4:
+		p.Year = num(str, 4)
+		hasYear = true
+	case len(str) == 7 && str[4] == '-':
+		p.Year = num(str[:4], 4)
+		p.Month = time.Month(num(str[5:], 2))
+		hasYear, hasMonth = true, true
+	default:
+		if len(str) == 10 && str[4] == '-' && str[7] == '-' {
+			str = str[:4] + str[5:7] + str[8:]
+		}
+		if len(str) != 8 {
+			ok = false
+			break
+		}
+		p.Year = num(str[:4], 4)
+		p.Month = time.Month(num(str[4:6], 2))
+		p.Day = num(str[6:], 2)
+		hasYear, hasMonth, hasDay = true, true, true
+	}
+
+	if !ok {
+		return PartialDate{}, errors.New("not a vcard date")
+	}
+	if err := checkPartialZeros(p, hasYear, hasMonth, hasDay); err != nil {
+		return PartialDate{}, err
+	}
+	return p, nil
+}
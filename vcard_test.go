@@ -0,0 +1,61 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestPartialDateFromVCard(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In     string
+		Want   chrono.PartialDate
+		Format string
+	}{
+		{"19850412", chrono.PartialDate{Year: 1985, Month: 4, Day: 12}, "19850412"},
+		{"1985-04-12", chrono.PartialDate{Year: 1985, Month: 4, Day: 12}, "19850412"},
+		{"19531015T231000Z", chrono.PartialDate{Year: 1953, Month: 10, Day: 15}, "19531015"},
+		{"1985-04", chrono.PartialDate{Year: 1985, Month: 4}, "1985-04"},
+		{"1985", chrono.PartialDate{Year: 1985}, "1985"},
+		{"--0412", chrono.PartialDate{Month: 4, Day: 12}, "--0412"},
+		{"--04-12", chrono.PartialDate{Month: 4, Day: 12}, "--0412"},
+		{"--0229", chrono.PartialDate{Month: 2, Day: 29}, "--0229"},
+		{"--04", chrono.PartialDate{Month: 4}, "--04"},
+		{"---12", chrono.PartialDate{Day: 12}, "---12"},
+	}
+
+	for _, test := range tests {
+		got, err := chrono.PartialDateFromVCard(test.In)
+		if err != nil {
+			t.Errorf("%s: %v", test.In, err)
+			continue
+		}
+		if got != test.Want {
+			t.Errorf("%s: want %#v, got %#v", test.In, test.Want, got)
+		}
+		if s, err := got.FormatVCard(); err != nil || s != test.Format {
+			t.Errorf("%s: want format %s, got %s: %v", test.In, test.Format, s, err)
+		}
+	}
+
+	bad := []string{"", "-", "--", "---", "---1", "--4", "--041", "--1301", "--0230", "19850230", "198504", "1985-4", "abcd", "19850412x",
+		"20240015", "20240300", "00000412", "0000", "2024-00", "--00", "--0400", "---00"}
+	for _, in := range bad {
+		_, err := chrono.PartialDateFromVCard(in)
+		var perr *chrono.ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("%q: expected a parse error, got: %v", in, err)
+		}
+	}
+
+	// Out of range years can't be written with 4 digits and parsed back
+	for _, year := range []int{12345, -5} {
+		var rerr *chrono.RangeError
+		if _, err := (chrono.PartialDate{Year: year}).FormatVCard(); !errors.As(err, &rerr) || rerr.Component != "year" {
+			t.Errorf("%d: expected a year range error, got: %v", year, err)
+		}
+	}
+}
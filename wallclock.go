@@ -0,0 +1,72 @@
+package chrono
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// WallClockPolicy decides what happens to a wall clock time that doesn't
+// exist in a location because a DST transition skips over it
+type WallClockPolicy int
+
+// Wall clock policies. The examples are for 02:30 on a day the clocks
+// spring forward from 02:00 to 03:00.
+const (
+	// WallClockShiftForward moves the time forward by the length of the gap,
+	// 02:30 becomes 03:30
+	WallClockShiftForward WallClockPolicy = iota
+	// WallClockNextValid uses the moment the clocks changed, 02:30 becomes
+	// 03:00
+	WallClockNextValid
+	// WallClockReject returns an error wrapping ErrNonexistentTime
+	WallClockReject
+)
+
+// SameWallClockIn returns the datetime with the same calendar date and wall
+// clock time as d but in loc, eg. 09:00 in New York becomes 09:00 in London.
+// It's the opposite of In which keeps the instant and changes the wall
+// clock. When the wall clock time is repeated in loc because the clocks went
+// back the earlier of the two is returned, when it's skipped the policy
+// decides.
+func (d DateTime) SameWallClockIn(loc *time.Location, policy WallClockPolicy) (DateTime, error) {
+	year, month, day := d.t.Date()
+	hour, min, sec := d.t.Clock()
+	wall := time.Date(year, month, day, hour, min, sec, d.t.Nanosecond(), time.UTC)
+
+	// A day either side is enough to see the offsets on both sides of any
+	// transition affecting this wall clock time
+	_, before := wall.Add(-26 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(26 * time.Hour).In(loc).Zone()
+
+	early, late := before, after
+	if early < late {
+		early, late = late, early
+	}
+	for _, offset := range []int{early, late} {
+		// The wall clock only reads the same if loc really has this offset
+		// at the resulting instant
+		t := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if offsetOf(t) == offset {
+			return DateTime{t: t}, nil
+		}
+	}
+
+	switch policy {
+	case WallClockReject:
+		return DateTime{}, fmt.Errorf("%s in %s: %w", wall.Format("2006-01-02T15:04:05"), loc, ErrNonexistentTime)
+	case WallClockNextValid:
+		lo := wall.Add(-time.Duration(after) * time.Second).Unix()
+		hi := wall.Add(-time.Duration(before) * time.Second).Unix()
+		sec := lo + int64(sort.Search(int(hi-lo)+1, func(i int) bool {
+			return offsetOf(time.Unix(lo+int64(i), 0).In(loc)) == after
+		}))
+		return DateTime{t: time.Unix(sec, 0).In(loc)}, nil
+	}
+	return DateTime{t: wall.Add(-time.Duration(before) * time.Second).In(loc)}, nil
+}
+
+func offsetOf(t time.Time) int {
+	_, offset := t.Zone()
+	return offset
+}
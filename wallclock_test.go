@@ -0,0 +1,84 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestSameWallClockIn(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meeting := chrono.NewDateTime(2024, 6, 3, 9, 0, 0, 0, newYork)
+	got, err := meeting.SameWallClockIn(london, chrono.WallClockReject)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 6, 3, 9, 0, 0, 0, london); !got.ToStdTime().Equal(want) || got.Location() != london {
+		t.Error("wall clock wrong:", got)
+	}
+
+	// 01:30 happens twice in New York on 2024-11-03, the earlier is EDT
+	repeated := chrono.NewDateTime(2024, 11, 3, 1, 30, 0, 0, time.UTC)
+	got, err = repeated.SameWallClockIn(newYork, chrono.WallClockReject)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC); !got.ToStdTime().Equal(want) {
+		t.Error("repeated wall clock should use the earlier time:", got)
+	}
+}
+
+func TestSameWallClockInGap(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	santiago, err := time.LoadLocation("America/Santiago")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Name   string
+		Wall   chrono.DateTime
+		Loc    *time.Location
+		Policy chrono.WallClockPolicy
+		Want   time.Time
+	}{
+		{"shift", chrono.NewDateTime(2024, 3, 10, 2, 30, 0, 0, time.UTC), newYork, chrono.WallClockShiftForward, time.Date(2024, 3, 10, 3, 30, 0, 0, newYork)},
+		{"next", chrono.NewDateTime(2024, 3, 10, 2, 30, 0, 0, time.UTC), newYork, chrono.WallClockNextValid, time.Date(2024, 3, 10, 3, 0, 0, 0, newYork)},
+		// Midnight is skipped in Santiago, time.Date would go back to 23:00
+		{"midnight shift", chrono.NewDateTime(2022, 9, 11, 0, 15, 0, 0, time.UTC), santiago, chrono.WallClockShiftForward, time.Date(2022, 9, 11, 4, 15, 0, 0, time.UTC)},
+		{"midnight next", chrono.NewDateTime(2022, 9, 11, 0, 15, 0, 0, time.UTC), santiago, chrono.WallClockNextValid, time.Date(2022, 9, 11, 4, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		got, err := test.Wall.SameWallClockIn(test.Loc, test.Policy)
+		if err != nil {
+			t.Errorf("%s: %v", test.Name, err)
+			continue
+		}
+		if !got.ToStdTime().Equal(test.Want) || got.Location() != test.Loc {
+			t.Errorf("%s: want: %v, got: %v", test.Name, test.Want, got)
+		}
+	}
+
+	_, err = chrono.NewDateTime(2024, 3, 10, 2, 30, 0, 0, time.UTC).SameWallClockIn(newYork, chrono.WallClockReject)
+	if !errors.Is(err, chrono.ErrNonexistentTime) {
+		t.Error("expected a nonexistent time error:", err)
+	}
+}
@@ -0,0 +1,74 @@
+package chrono
+
+import "time"
+
+// WeekScheme is a convention for numbering the weeks of a year
+type WeekScheme int
+
+// Week numbering schemes
+const (
+	// WeekISO numbers weeks according to ISO8601, weeks start on Monday and
+	// week 1 is the week containing the first Thursday of the year. Days
+	// near the start or end of a year may belong to a week of the adjacent
+	// year, see ISOWeek.
+	WeekISO WeekScheme = iota
+	// WeekUS numbers weeks starting on Sunday where week 1 is the week
+	// containing January 1st.
+	WeekUS
+	// WeekMiddleEastern numbers weeks starting on Saturday where week 1 is the
+	// week containing January 1st.
+	WeekMiddleEastern
+)
+
+// FirstDay returns the day the week starts on in the scheme
+func (w WeekScheme) FirstDay() time.Weekday {
+	switch w {
+	case WeekUS:
+		return time.Sunday
+	case WeekMiddleEastern:
+		return time.Saturday
+	default:
+		return time.Monday
+	}
+}
+
+// String returns the name of the scheme
+func (w WeekScheme) String() string {
+	switch w {
+	case WeekISO:
+		return "ISO"
+	case WeekUS:
+		return "US"
+	case WeekMiddleEastern:
+		return "MiddleEastern"
+	default:
+		return "WeekScheme(unknown)"
+	}
+}
+
+// WeekOfMonth returns the week of the month that d falls in (starting at 1)
+// where weeks begin on weekStart. The week containing the 1st of the month
+// is always week 1 even if it is a partial week.
+func (d Date) WeekOfMonth(weekStart time.Weekday) int {
+	return weekNumber(d.Day(), d.t.AddDate(0, 0, 1-d.Day()).Weekday(), weekStart)
+}
+
+// WeekOfYear returns the week of the year that d falls in according to the
+// scheme. For WeekISO this is the same as the week returned by ISOWeek and
+// it may belong to the previous or next year. For all other schemes the week
+// containing January 1st is week 1 even if it is a partial week.
+func (d Date) WeekOfYear(scheme WeekScheme) int {
+	if scheme == WeekISO {
+		_, week := d.t.ISOWeek()
+		return week
+	}
+
+	return weekNumber(d.YearDay(), time.Date(d.Year(), 1, 1, 0, 0, 0, 0, time.UTC).Weekday(), scheme.FirstDay())
+}
+
+// weekNumber returns which week day (1-based) falls in given the weekday of
+// day 1 and the weekday that weeks start on
+func weekNumber(day int, firstWeekday, weekStart time.Weekday) int {
+	offset := (int(firstWeekday) - int(weekStart) + 7) % 7
+	return (day-1+offset)/7 + 1
+}
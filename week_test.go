@@ -0,0 +1,61 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestWeekOfMonth(t *testing.T) {
+	t.Parallel()
+
+	// 2022-06-01 is a Wednesday
+	tests := []struct {
+		Date  chrono.Date
+		Start time.Weekday
+		Want  int
+	}{
+		{chrono.NewDate(2022, 6, 1), time.Monday, 1},
+		{chrono.NewDate(2022, 6, 5), time.Monday, 1},
+		{chrono.NewDate(2022, 6, 6), time.Monday, 2},
+		{chrono.NewDate(2022, 6, 5), time.Sunday, 2},
+		{chrono.NewDate(2022, 6, 4), time.Sunday, 1},
+		{chrono.NewDate(2022, 6, 4), time.Saturday, 2},
+		{chrono.NewDate(2022, 6, 30), time.Monday, 5},
+		{chrono.NewDate(2022, 5, 31), time.Sunday, 5},
+		{chrono.NewDate(2022, 5, 31), time.Monday, 6},
+	}
+
+	for _, test := range tests {
+		if got := test.Date.WeekOfMonth(test.Start); got != test.Want {
+			t.Errorf("%s (%s start): want %d, got %d", test.Date, test.Start, test.Want, got)
+		}
+	}
+}
+
+func TestWeekOfYear(t *testing.T) {
+	t.Parallel()
+
+	// 2022-01-01 is a Saturday
+	tests := []struct {
+		Date   chrono.Date
+		Scheme chrono.WeekScheme
+		Want   int
+	}{
+		{chrono.NewDate(2022, 1, 1), chrono.WeekISO, 52},
+		{chrono.NewDate(2022, 1, 3), chrono.WeekISO, 1},
+		{chrono.NewDate(2022, 1, 1), chrono.WeekUS, 1},
+		{chrono.NewDate(2022, 1, 2), chrono.WeekUS, 2},
+		{chrono.NewDate(2022, 12, 31), chrono.WeekUS, 53},
+		{chrono.NewDate(2022, 1, 1), chrono.WeekMiddleEastern, 1},
+		{chrono.NewDate(2022, 1, 7), chrono.WeekMiddleEastern, 1},
+		{chrono.NewDate(2022, 1, 8), chrono.WeekMiddleEastern, 2},
+	}
+
+	for _, test := range tests {
+		if got := test.Date.WeekOfYear(test.Scheme); got != test.Want {
+			t.Errorf("%s (%s): want %d, got %d", test.Date, test.Scheme, test.Want, got)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package chrono
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Weekdays is a set of weekdays stored as a bitmask where bit n is
+// time.Weekday(n), eg. a schedule that runs Monday, Wednesday and Friday.
+// It's written as the iCalendar (RFC5545) BYDAY codes, eg. "MO,WE,FR".
+type Weekdays uint8
+
+// Common sets of weekdays
+const (
+	WeekdaysNone     Weekdays = 0
+	WeekdaysWorkweek Weekdays = 1<<time.Monday | 1<<time.Tuesday | 1<<time.Wednesday | 1<<time.Thursday | 1<<time.Friday
+	WeekdaysWeekend  Weekdays = 1<<time.Saturday | 1<<time.Sunday
+	WeekdaysAll               = WeekdaysWorkweek | WeekdaysWeekend
+)
+
+// weekdayCodes are the iCalendar codes for each time.Weekday
+var weekdayCodes = [7]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// NewWeekdays creates a set containing days
+func NewWeekdays(days ...time.Weekday) Weekdays {
+	var w Weekdays
+	for _, d := range days {
+		w = w.Add(d)
+	}
+	return w
+}
+
+// ParseWeekdays parses a comma separated list of weekdays. Each day may be an
+// iCalendar code (MO), an abbreviation (Mon) or a full name (Monday), case
+// is ignored. An empty string is the empty set.
+func ParseWeekdays(str string) (Weekdays, error) {
+	var w Weekdays
+	if len(strings.TrimSpace(str)) == 0 {
+		return w, nil
+	}
+
+	for _, elem := range strings.Split(str, ",") {
+		d, ok := parseWeekdayName(strings.TrimSpace(elem))
+		if !ok {
+			return 0, &ParseError{Op: "parse", Kind: "weekdays", Input: str, Err: fmt.Errorf("unknown weekday %q", elem)}
+		}
+		w = w.Add(d)
+	}
+	return w, nil
+}
+
+// parseWeekdayName parses an iCalendar code, abbreviation or full name
+func parseWeekdayName(str string) (time.Weekday, bool) {
+	if len(str) < 2 {
+		return 0, false
+	}
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		name := d.String()
+		switch {
+		case strings.EqualFold(str, weekdayCodes[d]),
+			strings.EqualFold(str, name[:3]),
+			strings.EqualFold(str, name):
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// Add returns the set with d added
+func (w Weekdays) Add(d time.Weekday) Weekdays {
+	return w | 1<<uint(d%7)
+}
+
+// Remove returns the set with d removed
+func (w Weekdays) Remove(d time.Weekday) Weekdays {
+	return w &^ (1 << uint(d%7))
+}
+
+// Contains returns true if d is in the set
+func (w Weekdays) Contains(d time.Weekday) bool {
+	return w&(1<<uint(d%7)) != 0
+}
+
+// ContainsDate returns true if the weekday of d is in the set
+func (w Weekdays) ContainsDate(d Date) bool {
+	return w.Contains(d.Weekday())
+}
+
+// Len returns the number of days in the set
+func (w Weekdays) Len() int {
+	n := 0
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if w.Contains(d) {
+			n++
+		}
+	}
+	return n
+}
+
+// Days returns the days in the set starting from Monday
+func (w Weekdays) Days() []time.Weekday {
+	days := make([]time.Weekday, 0, 7)
+	for i := 1; i <= 7; i++ {
+		if d := time.Weekday(i % 7); w.Contains(d) {
+			days = append(days, d)
+		}
+	}
+	return days
+}
+
+// Next returns the first date after from whose weekday is in the set. If the
+// set is empty the zero Date is returned.
+func (w Weekdays) Next(from Date) Date {
+	if w&WeekdaysAll == 0 {
+		return Date{}
+	}
+	for i := 1; ; i++ {
+		if d := from.AddDate(0, 0, i); w.ContainsDate(d) {
+			return d
+		}
+	}
+}
+
+// String returns the iCalendar codes of the days starting from Monday, eg.
+// "MO,WE,FR". The empty set is an empty string.
+func (w Weekdays) String() string {
+	var b strings.Builder
+	for _, d := range w.Days() {
+		if b.Len() != 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(weekdayCodes[d])
+	}
+	return b.String()
+}
+
+// MarshalJSON implements json.Marshaler using String
+func (w Weekdays) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + w.String() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler using String
+func (w Weekdays) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it accepts the same forms as
+// ParseWeekdays
+func (w *Weekdays) UnmarshalJSON(data []byte) error {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return &ParseError{Op: "unmarshal", Kind: "weekdays", Input: string(data), Err: errors.New("expected a string")}
+	}
+	return w.UnmarshalText(data[1 : len(data)-1])
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it accepts the same
+// forms as ParseWeekdays
+func (w *Weekdays) UnmarshalText(data []byte) error {
+	days, err := ParseWeekdays(string(data))
+	if err != nil {
+		return err
+	}
+	*w = days
+	return nil
+}
+
+// Value implements driver.Valuer using String
+func (w Weekdays) Value() (driver.Value, error) {
+	return w.String(), nil
+}
+
+// Scan implements sql.Scanner. Strings are parsed with ParseWeekdays and
+// integers are taken to be the bitmask. NULL is the empty set.
+func (w *Weekdays) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*w = 0
+		return nil
+	case int64:
+		if v < 0 || v > int64(WeekdaysAll) {
+			return &ParseError{Op: "scan", Kind: "weekdays", Input: fmt.Sprint(v), Err: errors.New("bitmask out of range")}
+		}
+		*w = Weekdays(v)
+		return nil
+	case string:
+		days, err := ParseWeekdays(v)
+		if err != nil {
+			return err
+		}
+		*w = days
+		return nil
+	case []byte:
+		days, err := ParseWeekdays(string(v))
+		if err != nil {
+			return err
+		}
+		*w = days
+		return nil
+	}
+
+	return &TypeError{Op: "scan", Kind: "weekdays", Value: value}
+}
@@ -0,0 +1,129 @@
+package chrono_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestWeekdays(t *testing.T) {
+	t.Parallel()
+
+	w := chrono.NewWeekdays(time.Monday, time.Wednesday, time.Friday)
+	if !w.Contains(time.Monday) || w.Contains(time.Tuesday) {
+		t.Error("wrong contents:", w)
+	}
+	if w.Len() != 3 {
+		t.Error("wrong len:", w.Len())
+	}
+	if got := w.Add(time.Sunday).String(); got != "MO,WE,FR,SU" {
+		t.Error("wrong string:", got)
+	}
+	if got := w.Remove(time.Wednesday).String(); got != "MO,FR" {
+		t.Error("wrong string:", got)
+	}
+	if chrono.WeekdaysAll.Len() != 7 || chrono.WeekdaysWorkweek.Contains(time.Saturday) {
+		t.Error("wrong constants")
+	}
+	if chrono.WeekdaysNone.String() != "" {
+		t.Error("empty set should be an empty string")
+	}
+}
+
+func TestWeekdaysNext(t *testing.T) {
+	t.Parallel()
+
+	w := chrono.NewWeekdays(time.Monday, time.Wednesday, time.Friday)
+	tests := []struct {
+		From chrono.Date
+		Want chrono.Date
+	}{
+		{chrono.NewDate(2024, 1, 1), chrono.NewDate(2024, 1, 3)}, // Mon -> Wed
+		{chrono.NewDate(2024, 1, 2), chrono.NewDate(2024, 1, 3)}, // Tue -> Wed
+		{chrono.NewDate(2024, 1, 5), chrono.NewDate(2024, 1, 8)}, // Fri -> Mon
+		{chrono.NewDate(2024, 1, 6), chrono.NewDate(2024, 1, 8)}, // Sat -> Mon
+	}
+	for _, test := range tests {
+		if got := w.Next(test.From); got != test.Want {
+			t.Errorf("%s: want %s, got %s", test.From, test.Want, got)
+		}
+	}
+
+	if got := chrono.WeekdaysNone.Next(chrono.NewDate(2024, 1, 1)); !got.IsZero() {
+		t.Error("empty set should be zero:", got)
+	}
+}
+
+func TestParseWeekdays(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   string
+		Want string
+	}{
+		{"MO,WE,FR", "MO,WE,FR"},
+		{"fr, mo ,We", "MO,WE,FR"},
+		{"Sat,Sunday", "SA,SU"},
+		{"", ""},
+	}
+	for _, test := range tests {
+		w, err := chrono.ParseWeekdays(test.In)
+		if err != nil {
+			t.Error(test.In, err)
+		} else if w.String() != test.Want {
+			t.Errorf("%q: want %s, got %s", test.In, test.Want, w)
+		}
+	}
+
+	for _, bad := range []string{"MO,", "XX", "M", "MO;TU"} {
+		if _, err := chrono.ParseWeekdays(bad); err == nil {
+			t.Errorf("%q: expected an error", bad)
+		}
+	}
+}
+
+func TestWeekdaysJSON(t *testing.T) {
+	t.Parallel()
+
+	w := chrono.NewWeekdays(time.Tuesday, time.Thursday)
+	b, err := json.Marshal(w)
+	if err != nil || string(b) != `"TU,TH"` {
+		t.Error("wrong json:", string(b), err)
+	}
+
+	var got chrono.Weekdays
+	if err := json.Unmarshal(b, &got); err != nil || got != w {
+		t.Error("did not round trip:", got, err)
+	}
+	if err := json.Unmarshal([]byte(`5`), &got); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestWeekdaysSQL(t *testing.T) {
+	t.Parallel()
+
+	w := chrono.NewWeekdays(time.Monday, time.Friday)
+	v, err := w.Value()
+	if err != nil || v != "MO,FR" {
+		t.Error("wrong value:", v, err)
+	}
+
+	var got chrono.Weekdays
+	for _, in := range []any{v, []byte("MO,FR"), int64(w)} {
+		if err := got.Scan(in); err != nil || got != w {
+			t.Errorf("%v: wrong scan %s %v", in, got, err)
+		}
+	}
+	if err := got.Scan(nil); err != nil || got != 0 {
+		t.Error("null should be empty:", got, err)
+	}
+	if err := got.Scan(int64(128)); err == nil {
+		t.Error("expected an error")
+	}
+	if err := got.Scan(1.5); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -0,0 +1,96 @@
+package chrono
+
+import "time"
+
+// unixEpoch is the start of the windows used by WindowStart
+var unixEpoch = time.Unix(0, 0).UTC()
+
+// WindowIndex returns which fixed window of size d falls in, counting from
+// epochStart. Window 0 is [epochStart, epochStart+size) and datetimes before
+// epochStart have negative indexes. d must be within about 292 years of
+// epochStart. It panics if size is not positive.
+func (d DateTime) WindowIndex(epochStart DateTime, size time.Duration) int64 {
+	return windowIndex(d.t, epochStart.t, size)
+}
+
+// WindowStart returns the start of the fixed window of size that d falls in,
+// windows are aligned to the unix epoch. Unlike Truncate, which aligns to the
+// zero time, this gives the same windows as dividing a unix timestamp. The
+// result is in d's location. It panics if size is not positive.
+func (d DateTime) WindowStart(size time.Duration) DateTime {
+	idx := windowIndex(d.t, unixEpoch, size)
+	return DateTime{t: unixEpoch.Add(time.Duration(idx) * size).In(d.t.Location())}
+}
+
+func windowIndex(t, epoch time.Time, size time.Duration) int64 {
+	if size <= 0 {
+		panic("chrono: window size must be positive")
+	}
+	elapsed := t.Sub(epoch)
+	idx := int64(elapsed / size)
+	if elapsed%size < 0 {
+		idx--
+	}
+	return idx
+}
+
+// SlidingWindow estimates the number of events in the trailing window of
+// Size, eg. for rate limiting. Rather than remembering each event it keeps
+// the counts of the current and previous fixed windows and weights the
+// previous one by how much of it still overlaps the sliding window. It is not
+// safe for concurrent use.
+type SlidingWindow struct {
+	Size time.Duration
+
+	index    int64
+	current  int64
+	previous int64
+}
+
+// NewSlidingWindow creates a sliding window of size. It panics if size is not
+// positive.
+func NewSlidingWindow(size time.Duration) *SlidingWindow {
+	if size <= 0 {
+		panic("chrono: window size must be positive")
+	}
+	return &SlidingWindow{Size: size}
+}
+
+// Add records n events at the given time. Times must not go backwards
+// further than the previous window, those events are dropped.
+func (s *SlidingWindow) Add(at DateTime, n int64) {
+	idx := s.advance(at)
+	switch idx {
+	case s.index:
+		s.current += n
+	case s.index - 1:
+		s.previous += n
+	}
+}
+
+// Count returns the estimated number of events in the window of Size that
+// ends at the given time
+func (s *SlidingWindow) Count(at DateTime) float64 {
+	s.advance(at)
+	elapsed := at.t.Sub(unixEpoch.Add(time.Duration(s.index) * s.Size))
+	overlap := 1 - float64(elapsed)/float64(s.Size)
+	if overlap > 1 {
+		// at is in an earlier window than the last event
+		overlap = 1
+	}
+	return float64(s.current) + float64(s.previous)*overlap
+}
+
+// advance moves the windows forward to contain at and returns its index
+func (s *SlidingWindow) advance(at DateTime) int64 {
+	idx := windowIndex(at.t, unixEpoch, s.Size)
+	switch {
+	case idx == s.index+1:
+		s.previous, s.current = s.current, 0
+		s.index = idx
+	case idx > s.index+1:
+		s.previous, s.current = 0, 0
+		s.index = idx
+	}
+	return idx
+}
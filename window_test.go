@@ -0,0 +1,82 @@
+package chrono_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestWindowStart(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("", 5*60*60+30*60)
+	d := chrono.NewDateTime(2000, 1, 2, 3, 4, 5, 0, loc)
+
+	got := d.WindowStart(time.Hour)
+	if !got.Equal(chrono.NewDateTime(2000, 1, 2, 3, 0, 0, 0, loc).Add(-30*time.Minute)) || got.Location() != loc {
+		t.Error("hour window wrong:", got)
+	}
+	// 7 minutes doesn't divide a day so this differs from Truncate
+	if got := d.WindowStart(7 * time.Minute); got.ToStdTime().Unix()%(7*60) != 0 || d.Sub(got) >= 7*time.Minute || d.Before(got) {
+		t.Error("7 minute window wrong:", got)
+	}
+
+	before := chrono.NewDateTime(1969, 12, 31, 23, 59, 30, 0, time.UTC)
+	if got := before.WindowStart(time.Minute); !got.Equal(chrono.NewDateTime(1969, 12, 31, 23, 59, 0, 0, time.UTC)) {
+		t.Error("window before epoch wrong:", got)
+	}
+}
+
+func TestWindowIndex(t *testing.T) {
+	t.Parallel()
+
+	start := chrono.NewDateTime(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		At   chrono.DateTime
+		Want int64
+	}{
+		{start, 0},
+		{start.Add(59 * time.Second), 0},
+		{start.Add(time.Minute), 1},
+		{start.Add(90 * time.Minute), 90},
+		{start.Add(-time.Nanosecond), -1},
+		{start.Add(-time.Minute), -1},
+		{start.Add(-time.Minute - 1), -2},
+	}
+	for _, test := range tests {
+		if got := test.At.WindowIndex(start, time.Minute); got != test.Want {
+			t.Errorf("%v: want %d, got %d", test.At, test.Want, got)
+		}
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	t.Parallel()
+
+	w := chrono.NewSlidingWindow(time.Minute)
+	start := chrono.NewDateTime(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	w.Add(start.Add(10*time.Second), 6)
+	w.Add(start.Add(50*time.Second), 4)
+	if got := w.Count(start.Add(59 * time.Second)); got != 10 {
+		t.Error("count wrong:", got)
+	}
+
+	// A quarter of the way into the next window, 3/4 of the last one counts
+	w.Add(start.Add(75*time.Second), 2)
+	if got := w.Count(start.Add(75 * time.Second)); math.Abs(got-9.5) > 1e-9 {
+		t.Error("weighted count wrong:", got)
+	}
+
+	// Late event for the previous window
+	w.Add(start.Add(55*time.Second), 4)
+	if got := w.Count(start.Add(90 * time.Second)); math.Abs(got-9) > 1e-9 {
+		t.Error("count with late event wrong:", got)
+	}
+
+	if got := w.Count(start.Add(10 * time.Minute)); got != 0 {
+		t.Error("count should have expired:", got)
+	}
+}
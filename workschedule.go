@@ -0,0 +1,174 @@
+package chrono
+
+import (
+	"sort"
+	"time"
+)
+
+// WorkSchedule describes when work happens: on which days, between which
+// hours and in what location. It's used to compute things like support
+// ticket SLA deadlines where the clock only runs during business hours.
+type WorkSchedule struct {
+	// Days are the weekdays on which work happens
+	Days []time.Weekday
+	// Hours are the ranges of working time on each working day. Like
+	// OpeningHours a range belongs to the day it starts on, an overnight range
+	// such as 22:00-06:00 runs into the next day and a range whose start and
+	// end are equal is 24 hours long.
+	Hours []TimeRange
+	// Location the hours are in, nil means UTC
+	Location *time.Location
+	// Holidays is optional, days that are holidays are not worked
+	Holidays Calendar
+}
+
+// IsWorkingDay returns true if work happens on the date
+func (w WorkSchedule) IsWorkingDay(d Date) bool {
+	working := false
+	for _, wd := range w.Days {
+		if wd == d.Weekday() {
+			working = true
+			break
+		}
+	}
+	return working && (w.Holidays == nil || !w.Holidays.IsHoliday(d))
+}
+
+// IsWorkingTime returns true if dt falls within working hours
+func (w WorkSchedule) IsWorkingTime(dt DateTime) bool {
+	t := dt.t.In(w.location())
+	day := DateFromStdTime(t)
+	for _, r := range w.periods(day.AddDate(0, 0, -1), day) {
+		if !t.Before(r[0]) && t.Before(r[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// workScheduleIdleDays is how many days in a row AddWorkingDuration searches
+// for working time before giving up, eg. when every day is a holiday
+const workScheduleIdleDays = 366
+
+// AddWorkingDuration returns the moment at which dur of working time will
+// have elapsed after dt, eg. the deadline of a ticket that must be answered
+// within 8 business hours. If dt is outside of working hours the clock starts
+// at the beginning of the next working period. If dur is not positive dt is
+// returned.
+//
+// False is returned if the schedule has no working time, or a year passes
+// without any because of holidays.
+func (w WorkSchedule) AddWorkingDuration(dt DateTime, dur time.Duration) (DateTime, bool) {
+	if dur <= 0 {
+		return dt, true
+	}
+	if len(w.Days) == 0 || len(w.Hours) == 0 {
+		return DateTime{}, false
+	}
+
+	loc := w.location()
+	cursor := dt.t.In(loc)
+	idle := 0
+	// Ranges from the day before can run into the first day, each week after
+	// that is a separate batch of periods
+	for first := DateFromStdTime(cursor).AddDate(0, 0, -1); idle < workScheduleIdleDays; first = first.AddDate(0, 0, 7) {
+		periods := w.periods(first, first.AddDate(0, 0, 6))
+		if len(periods) == 0 {
+			idle += 7
+			continue
+		}
+		idle = 0
+
+		for _, r := range periods {
+			if !cursor.Before(r[1]) {
+				continue
+			}
+			from := r[0]
+			if cursor.After(from) {
+				from = cursor
+			}
+
+			avail := r[1].Sub(from)
+			if dur <= avail {
+				return DateTime{t: from.Add(dur)}, true
+			}
+			dur -= avail
+			cursor = r[1]
+		}
+	}
+	return DateTime{}, false
+}
+
+// WorkingDurationBetween returns the amount of working time in [a, b). If b
+// is before a the result is negative.
+func (w WorkSchedule) WorkingDurationBetween(a, b DateTime) time.Duration {
+	sign := time.Duration(1)
+	if b.Before(a) {
+		a, b, sign = b, a, -1
+	}
+
+	loc := w.location()
+	start, end := a.t.In(loc), b.t.In(loc)
+	var total time.Duration
+	for _, r := range w.periods(DateFromStdTime(start).AddDate(0, 0, -1), DateFromStdTime(end)) {
+		from, to := r[0], r[1]
+		if start.After(from) {
+			from = start
+		}
+		if end.Before(to) {
+			to = end
+		}
+		if to.After(from) {
+			total += to.Sub(from)
+		}
+	}
+	return sign * total
+}
+
+// periods returns the working time of the days from first to last as sorted
+// and merged ranges of time.Times. Overnight ranges end on the next day so the
+// last period can extend past last.
+func (w WorkSchedule) periods(first, last Date) [][2]time.Time {
+	loc := w.location()
+	var out [][2]time.Time
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if !w.IsWorkingDay(day) {
+			continue
+		}
+		year, month, dom := day.Date()
+		for _, h := range w.Hours {
+			endDom := dom
+			if clockOffset(h.End) <= clockOffset(h.Start) {
+				endDom++
+			}
+			start := time.Date(year, month, dom, h.Start.Hour(), h.Start.Minute(), h.Start.Second(), h.Start.Nanosecond(), loc)
+			end := time.Date(year, month, endDom, h.End.Hour(), h.End.Minute(), h.End.Second(), h.End.Nanosecond(), loc)
+			// A short range inside a DST gap can come out empty
+			if end.After(start) {
+				out = append(out, [2]time.Time{start, end})
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i][0].Before(out[j][0])
+	})
+
+	merged := out[:0]
+	for _, p := range out {
+		if n := len(merged); n > 0 && !p[0].After(merged[n-1][1]) {
+			if p[1].After(merged[n-1][1]) {
+				merged[n-1][1] = p[1]
+			}
+			continue
+		}
+		merged = append(merged, p)
+	}
+	return merged
+}
+
+func (w WorkSchedule) location() *time.Location {
+	if w.Location == nil {
+		return time.UTC
+	}
+	return w.Location
+}
@@ -0,0 +1,175 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func testWorkSchedule() chrono.WorkSchedule {
+	holiday := chrono.NewDate(2022, 7, 4)
+	return chrono.WorkSchedule{
+		Days: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+		Hours: []chrono.TimeRange{
+			{Start: chrono.NewTime(13, 0, 0, 0, time.UTC), End: chrono.NewTime(17, 0, 0, 0, time.UTC)},
+			{Start: chrono.NewTime(9, 0, 0, 0, time.UTC), End: chrono.NewTime(12, 0, 0, 0, time.UTC)},
+		},
+		Location: time.UTC,
+		Holidays: chrono.CalendarFunc(func(d chrono.Date) bool {
+			return d.Equal(holiday)
+		}),
+	}
+}
+
+func TestWorkScheduleAddWorkingDuration(t *testing.T) {
+	t.Parallel()
+
+	w := testWorkSchedule()
+	// 2022-07-01 is a Friday, the following Monday is a holiday
+	tests := []struct {
+		Start chrono.DateTime
+		Dur   time.Duration
+		Want  chrono.DateTime
+	}{
+		{chrono.NewDateTime(2022, 7, 1, 9, 0, 0, 0, time.UTC), time.Hour, chrono.NewDateTime(2022, 7, 1, 10, 0, 0, 0, time.UTC)},
+		{chrono.NewDateTime(2022, 7, 1, 11, 0, 0, 0, time.UTC), 2 * time.Hour, chrono.NewDateTime(2022, 7, 1, 14, 0, 0, 0, time.UTC)},
+		{chrono.NewDateTime(2022, 7, 1, 12, 30, 0, 0, time.UTC), time.Hour, chrono.NewDateTime(2022, 7, 1, 14, 0, 0, 0, time.UTC)},
+		{chrono.NewDateTime(2022, 7, 1, 7, 0, 0, 0, time.UTC), 7 * time.Hour, chrono.NewDateTime(2022, 7, 1, 17, 0, 0, 0, time.UTC)},
+		{chrono.NewDateTime(2022, 7, 1, 16, 0, 0, 0, time.UTC), 2 * time.Hour, chrono.NewDateTime(2022, 7, 5, 10, 0, 0, 0, time.UTC)},
+		{chrono.NewDateTime(2022, 7, 2, 12, 0, 0, 0, time.UTC), 8 * time.Hour, chrono.NewDateTime(2022, 7, 6, 10, 0, 0, 0, time.UTC)},
+		{chrono.NewDateTime(2022, 7, 1, 18, 0, 0, 0, time.UTC), 0, chrono.NewDateTime(2022, 7, 1, 18, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		if got, ok := w.AddWorkingDuration(test.Start, test.Dur); !ok || !got.Equal(test.Want) {
+			t.Errorf("%s + %s: want %s, got %s", test.Start, test.Dur, test.Want, got)
+		}
+	}
+}
+
+func TestWorkScheduleWorkingDurationBetween(t *testing.T) {
+	t.Parallel()
+
+	w := testWorkSchedule()
+	a := chrono.NewDateTime(2022, 7, 1, 11, 0, 0, 0, time.UTC)
+	b := chrono.NewDateTime(2022, 7, 5, 10, 0, 0, 0, time.UTC)
+
+	if d := w.WorkingDurationBetween(a, b); d != 6*time.Hour {
+		t.Error("value wrong", d)
+	}
+	if d := w.WorkingDurationBetween(b, a); d != -6*time.Hour {
+		t.Error("value wrong", d)
+	}
+	if d := w.WorkingDurationBetween(a, a.Add(30*time.Minute)); d != 30*time.Minute {
+		t.Error("value wrong", d)
+	}
+
+	if !w.IsWorkingTime(a) {
+		t.Error("should be working time")
+	}
+	if w.IsWorkingTime(chrono.NewDateTime(2022, 7, 1, 12, 30, 0, 0, time.UTC)) {
+		t.Error("lunch is not working time")
+	}
+	if w.IsWorkingTime(chrono.NewDateTime(2022, 7, 4, 10, 0, 0, 0, time.UTC)) {
+		t.Error("holidays are not working time")
+	}
+}
+
+func TestWorkScheduleLocation(t *testing.T) {
+	t.Parallel()
+
+	est := time.FixedZone("EST", -5*60*60)
+	w := testWorkSchedule()
+	w.Location = est
+
+	start := chrono.NewDateTime(2022, 7, 1, 14, 0, 0, 0, time.UTC)
+	want := chrono.NewDateTime(2022, 7, 1, 10, 0, 0, 0, est)
+	if got, ok := w.AddWorkingDuration(start, time.Hour); !ok || !got.Equal(want) {
+		t.Error("value wrong", got)
+	}
+}
+
+func TestWorkScheduleOvernight(t *testing.T) {
+	t.Parallel()
+
+	// A night shift from Monday to Friday nights, 2022-07-04 is a Monday
+	w := chrono.WorkSchedule{
+		Days: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+		Hours: []chrono.TimeRange{
+			{Start: chrono.NewTime(22, 0, 0, 0, time.UTC), End: chrono.NewTime(6, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	tests := []struct {
+		Start chrono.DateTime
+		Dur   time.Duration
+		Want  chrono.DateTime
+	}{
+		{chrono.NewDateTime(2022, 7, 4, 12, 0, 0, 0, time.UTC), 4 * time.Hour, chrono.NewDateTime(2022, 7, 5, 2, 0, 0, 0, time.UTC)},
+		{chrono.NewDateTime(2022, 7, 5, 3, 0, 0, 0, time.UTC), 4 * time.Hour, chrono.NewDateTime(2022, 7, 5, 23, 0, 0, 0, time.UTC)},
+		// Friday night runs into Saturday, then nothing until Monday night
+		{chrono.NewDateTime(2022, 7, 9, 2, 0, 0, 0, time.UTC), 6 * time.Hour, chrono.NewDateTime(2022, 7, 12, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		if got, ok := w.AddWorkingDuration(test.Start, test.Dur); !ok || !got.Equal(test.Want) {
+			t.Errorf("%s + %s: want %s, got %s", test.Start, test.Dur, test.Want, got)
+		}
+	}
+
+	if !w.IsWorkingTime(chrono.NewDateTime(2022, 7, 9, 5, 0, 0, 0, time.UTC)) {
+		t.Error("friday's shift should run into saturday")
+	}
+	if w.IsWorkingTime(chrono.NewDateTime(2022, 7, 4, 5, 0, 0, 0, time.UTC)) {
+		t.Error("sunday has no shift")
+	}
+	a := chrono.NewDateTime(2022, 7, 4, 0, 0, 0, 0, time.UTC)
+	if d := w.WorkingDurationBetween(a, a.AddDate(0, 0, 7)); d != 40*time.Hour {
+		t.Error("value wrong", d)
+	}
+}
+
+func TestWorkScheduleAllDay(t *testing.T) {
+	t.Parallel()
+
+	// Start == End is around the clock
+	w := chrono.WorkSchedule{
+		Days: []time.Weekday{time.Monday, time.Tuesday},
+		Hours: []chrono.TimeRange{
+			{Start: chrono.NewTime(0, 0, 0, 0, time.UTC), End: chrono.NewTime(0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	start := chrono.NewDateTime(2022, 7, 4, 12, 0, 0, 0, time.UTC)
+	if got, _ := w.AddWorkingDuration(start, 30*time.Hour); !got.Equal(chrono.NewDateTime(2022, 7, 5, 18, 0, 0, 0, time.UTC)) {
+		t.Error("value wrong", got)
+	}
+	if got, _ := w.AddWorkingDuration(start, 40*time.Hour); !got.Equal(chrono.NewDateTime(2022, 7, 11, 4, 0, 0, 0, time.UTC)) {
+		t.Error("value wrong", got)
+	}
+	if d := w.WorkingDurationBetween(start, start.AddDate(0, 0, 7)); d != 48*time.Hour {
+		t.Error("value wrong", d)
+	}
+}
+
+func TestWorkScheduleNoWorkingTime(t *testing.T) {
+	t.Parallel()
+
+	start := chrono.NewDateTime(2022, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	w := testWorkSchedule()
+	w.Days = nil
+	if got, ok := w.AddWorkingDuration(start, time.Hour); ok {
+		t.Error("no working days should fail:", got)
+	}
+
+	w = testWorkSchedule()
+	w.Holidays = chrono.CalendarFunc(func(chrono.Date) bool { return true })
+	if got, ok := w.AddWorkingDuration(start, time.Hour); ok {
+		t.Error("only holidays should fail:", got)
+	}
+
+	if got, ok := w.AddWorkingDuration(start, 0); !ok || !got.Equal(start) {
+		t.Error("no duration should return the start:", got, ok)
+	}
+}
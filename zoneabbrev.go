@@ -0,0 +1,157 @@
+package chrono
+
+import (
+	"fmt"
+	"time"
+)
+
+// ZoneAbbreviations decides which time zone an ambiguous abbreviation means
+// when parsing with DateTimeFromLayoutZoneAbbrev, eg. IST is used in India,
+// Ireland and Israel but is taken to be Asia/Kolkata. Abbreviations that
+// aren't in the table are looked up with AbbreviationToZones and must belong
+// to exactly one zone. It's safe to add to or change during program
+// initialization.
+var ZoneAbbreviations = map[string]string{
+	"UTC": "Etc/UTC",
+	"GMT": "Etc/GMT",
+
+	"EST":  "America/New_York",
+	"EDT":  "America/New_York",
+	"CST":  "America/Chicago",
+	"CDT":  "America/Chicago",
+	"MST":  "America/Denver",
+	"MDT":  "America/Denver",
+	"PST":  "America/Los_Angeles",
+	"PDT":  "America/Los_Angeles",
+	"AKST": "America/Anchorage",
+	"AKDT": "America/Anchorage",
+	"HST":  "Pacific/Honolulu",
+	"AST":  "America/Halifax",
+	"ADT":  "America/Halifax",
+	"NST":  "America/St_Johns",
+	"NDT":  "America/St_Johns",
+
+	"BST":  "Europe/London",
+	"IST":  "Asia/Kolkata",
+	"WET":  "Europe/Lisbon",
+	"WEST": "Europe/Lisbon",
+	"CET":  "Europe/Berlin",
+	"CEST": "Europe/Berlin",
+	"EET":  "Europe/Athens",
+	"EEST": "Europe/Athens",
+	"MSK":  "Europe/Moscow",
+
+	"SAST": "Africa/Johannesburg",
+	"CAT":  "Africa/Maputo",
+	"EAT":  "Africa/Nairobi",
+	"WAT":  "Africa/Lagos",
+
+	"PKT": "Asia/Karachi",
+	"WIB": "Asia/Jakarta",
+	"HKT": "Asia/Hong_Kong",
+	"JST": "Asia/Tokyo",
+	"KST": "Asia/Seoul",
+
+	"AWST": "Australia/Perth",
+	"ACST": "Australia/Adelaide",
+	"ACDT": "Australia/Adelaide",
+	"AEST": "Australia/Sydney",
+	"AEDT": "Australia/Sydney",
+	"NZST": "Pacific/Auckland",
+	"NZDT": "Pacific/Auckland",
+}
+
+// ZoneAbbrev returns the abbreviated name of the zone in effect at d, eg.
+// PDT for America/Los_Angeles in summer. Zones without an abbreviation in
+// the tz database use their offset, eg. +08.
+func (d DateTime) ZoneAbbrev() string {
+	name, _ := d.t.Zone()
+	return name
+}
+
+// FormatWithZoneName is like Format but writes the name of d's location, eg.
+// America/Los_Angeles, where the layout has a zone abbreviation (MST).
+func (d DateTime) FormatWithZoneName(layout string) string {
+	var b []byte
+	for _, tok := range DescribeLayout(layout).Tokens {
+		switch {
+		case tok.Kind == TokenLiteral:
+			b = append(b, tok.Text...)
+		case tok.Zone == ZoneAbbreviation:
+			b = append(b, d.t.Location().String()...)
+		default:
+			b = d.t.AppendFormat(b, tok.Text)
+		}
+	}
+	return string(b)
+}
+
+// DateTimeFromLayoutZoneAbbrev is like DateTimeFromLayout but the zone
+// abbreviation (MST) in the layout is looked up in ZoneAbbreviations instead
+// of only being understood when it's the abbreviation of the local zone. The
+// result is in the zone the abbreviation belongs to, or in a fixed zone with
+// the abbreviation's offset when the zone isn't using it at that time, eg.
+// EST in July.
+func DateTimeFromLayoutZoneAbbrev(layout, str string) (DateTime, error) {
+	t, err := time.Parse(layout, str)
+	if err != nil {
+		return DateTime{}, &ParseError{Op: "parse", Kind: "datetime", Input: str, Layout: layout, Err: err}
+	}
+	var hasAbbr, hasOffset bool
+	for _, tok := range DescribeLayout(layout).Tokens {
+		hasAbbr = hasAbbr || tok.Zone == ZoneAbbreviation
+		hasOffset = hasOffset || tok.Zone == ZoneOffset || tok.Zone == ZoneOffsetZ
+	}
+	if !hasAbbr {
+		return DateTime{t: t}, nil
+	}
+
+	abbr, offset := t.Zone()
+	loc, err := abbreviationLocation(abbr)
+	if err != nil {
+		return DateTime{}, &ParseError{Op: "parse", Kind: "datetime", Input: str, Layout: layout, Err: err}
+	}
+
+	// Without a numeric offset in the layout the clock was parsed as if it
+	// was UTC and has to be moved by the abbreviation's offset
+	if !hasOffset {
+		var ok bool
+		if offset, ok = abbreviationOffset(loc, abbr, t.Year()); !ok {
+			return DateTime{}, &ParseError{Op: "parse", Kind: "datetime", Input: str, Layout: layout,
+				Err: fmt.Errorf("zone abbreviation %q is not used by %s", abbr, loc)}
+		}
+		year, month, day := t.Date()
+		hour, min, sec := t.Clock()
+		t = time.Date(year, month, day, hour, min, sec, t.Nanosecond(), time.UTC).Add(-time.Duration(offset) * time.Second)
+	}
+
+	if name, off := t.In(loc).Zone(); name == abbr && off == offset {
+		return DateTime{t: t.In(loc)}, nil
+	}
+	return DateTime{t: t.In(time.FixedZone(abbr, offset))}, nil
+}
+
+// abbreviationLocation returns the location an abbreviation stands for
+func abbreviationLocation(abbr string) (*time.Location, error) {
+	name, ok := ZoneAbbreviations[abbr]
+	if !ok {
+		zones := AbbreviationToZones(abbr)
+		if len(zones) != 1 {
+			return nil, fmt.Errorf("zone abbreviation %q is unknown or ambiguous", abbr)
+		}
+		name = zones[0]
+	}
+	return LoadLocation(name)
+}
+
+// abbreviationOffset finds the offset in seconds loc uses with abbr in the
+// winter or summer of year
+func abbreviationOffset(loc *time.Location, abbr string, year int) (int, bool) {
+	for _, month := range [...]time.Month{time.January, time.July} {
+		name, offset := time.Date(year, month, 1, 0, 0, 0, 0, loc).Zone()
+		if name == abbr {
+			return offset, true
+		}
+	}
+	return 0, false
+}
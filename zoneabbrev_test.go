@@ -0,0 +1,78 @@
+package chrono_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestDateTimeZoneAbbrev(t *testing.T) {
+	t.Parallel()
+
+	la, err := chrono.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	summer := chrono.NewDateTime(2023, 7, 1, 12, 0, 0, 0, la)
+	if abbr := summer.ZoneAbbrev(); abbr != "PDT" {
+		t.Error("wrong abbreviation:", abbr)
+	}
+	if abbr := summer.AddDate(0, 6, 0).ZoneAbbrev(); abbr != "PST" {
+		t.Error("wrong abbreviation:", abbr)
+	}
+
+	got := summer.FormatWithZoneName("2006-01-02 15:04 MST (-07:00)")
+	if want := "2023-07-01 12:00 America/Los_Angeles (-07:00)"; got != want {
+		t.Errorf("want: %s, got: %s", want, got)
+	}
+	if got := summer.UTC().FormatWithZoneName(time.RFC1123); got != "Sat, 01 Jul 2023 19:00:00 UTC" {
+		t.Error("wrong format:", got)
+	}
+}
+
+func TestDateTimeFromLayoutZoneAbbrev(t *testing.T) {
+	t.Parallel()
+
+	const layout = "2006-01-02 15:04 MST"
+	tests := []struct {
+		In   string
+		Want time.Time
+		Zone string
+	}{
+		{"2023-07-01 12:00 PDT", time.Date(2023, 7, 1, 19, 0, 0, 0, time.UTC), "America/Los_Angeles"},
+		{"2023-01-01 12:00 PST", time.Date(2023, 1, 1, 20, 0, 0, 0, time.UTC), "America/Los_Angeles"},
+		{"2023-07-01 12:00 IST", time.Date(2023, 7, 1, 6, 30, 0, 0, time.UTC), "Asia/Kolkata"},
+		{"2023-07-01 12:00 CEST", time.Date(2023, 7, 1, 10, 0, 0, 0, time.UTC), "Europe/Berlin"},
+		{"2023-07-01 12:00 UTC", time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC), "Etc/UTC"},
+		// EST isn't in use in New York in July so the offset is still -5
+		{"2023-07-01 12:00 EST", time.Date(2023, 7, 1, 17, 0, 0, 0, time.UTC), "EST"},
+	}
+
+	for i, test := range tests {
+		got, err := chrono.DateTimeFromLayoutZoneAbbrev(layout, test.In)
+		if err != nil {
+			t.Errorf("%d) %v", i, err)
+			continue
+		}
+		if !got.ToStdTime().Equal(test.Want) {
+			t.Errorf("%d) want: %s, got: %s", i, test.Want, got)
+		}
+		if zone := got.Location().String(); zone != test.Zone {
+			t.Errorf("%d) zone wrong, want: %s, got: %s", i, test.Zone, zone)
+		}
+	}
+
+	got, err := chrono.DateTimeFromLayoutZoneAbbrev("2006-01-02 15:04 -0700 MST", "2023-07-01 12:00 -0700 PDT")
+	if err != nil || got.Location().String() != "America/Los_Angeles" || got.Hour() != 12 {
+		t.Error("wrong datetime:", got, err)
+	}
+
+	if _, err := chrono.DateTimeFromLayoutZoneAbbrev(layout, "2023-07-01 12:00 NOPE"); err == nil {
+		t.Error("expected an error for an unknown abbreviation")
+	}
+	if _, err := chrono.DateTimeFromLayoutZoneAbbrev(layout, "2023-07-01"); err == nil {
+		t.Error("expected a parse error")
+	}
+}
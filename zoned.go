@@ -0,0 +1,103 @@
+package chrono
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNoZoneName is returned (wrapped) by ZonedDateTime.Values when the
+// location can't be written by name
+var ErrNoZoneName = errors.New("location has no IANA zone name")
+
+// ZonedDateTime is a DateTime whose location is stored in its own column by
+// IANA name, eg. Europe/Paris. Databases only keep the offset of a
+// timestamp (if anything) which isn't enough to do calendar arithmetic in
+// the original zone later on, so the pair of columns is scanned and written
+// together:
+//
+//	var z chrono.ZonedDateTime
+//	ts, zone := z.Scanners()
+//	err := db.QueryRow("SELECT starts_at, starts_tz FROM events").Scan(ts, zone)
+//
+//	tsValue, zoneValue, err := chrono.ZonedDateTime{DateTime: startsAt}.Values()
+//	_, err = db.Exec("INSERT INTO events (starts_at, starts_tz) VALUES ($1, $2)", tsValue, zoneValue)
+type ZonedDateTime struct {
+	DateTime
+
+	loc *time.Location
+}
+
+// Scanners returns the sql.Scanners for the timestamp and zone name columns.
+// They can be scanned in either order, the datetime is converted to the zone
+// once both are set. A NULL zone leaves the timestamp as the driver
+// returned it.
+func (z *ZonedDateTime) Scanners() (timestamp, zone sql.Scanner) {
+	return zonedTimestampScanner{z: z}, zonedZoneScanner{z: z}
+}
+
+// Values returns the driver.Values for the timestamp and zone name columns.
+// The timestamp is written in UTC using DateTime.Value. It's an error if the
+// location doesn't have an IANA name, eg. time.Local or a time.FixedZone,
+// it wraps ErrNoZoneName.
+func (z ZonedDateTime) Values() (timestamp, zone driver.Value, err error) {
+	name := z.Location().String()
+	if _, err := LoadLocation(name); err != nil || name == "" || name == "Local" {
+		return nil, nil, fmt.Errorf("location %q: %w", name, ErrNoZoneName)
+	}
+
+	timestamp, err = z.UTC().Value()
+	if err != nil {
+		return nil, nil, err
+	}
+	return timestamp, name, nil
+}
+
+func (z *ZonedDateTime) applyZone() {
+	if z.loc != nil && !z.IsZero() {
+		z.DateTime = z.In(z.loc)
+	}
+}
+
+type zonedTimestampScanner struct {
+	z *ZonedDateTime
+}
+
+// Scan implements sql.Scanner with DateTime.Scan
+func (s zonedTimestampScanner) Scan(value any) error {
+	if err := s.z.DateTime.Scan(value); err != nil {
+		return err
+	}
+	s.z.applyZone()
+	return nil
+}
+
+type zonedZoneScanner struct {
+	z *ZonedDateTime
+}
+
+// Scan implements sql.Scanner, it loads the zone by name
+func (s zonedZoneScanner) Scan(value any) error {
+	var name string
+	switch v := value.(type) {
+	case nil:
+		s.z.loc = nil
+		return nil
+	case string:
+		name = v
+	case []byte:
+		name = string(v)
+	default:
+		return &TypeError{Op: "scan", Kind: "zone", Value: value}
+	}
+
+	loc, err := LoadLocation(name)
+	if err != nil {
+		return &ParseError{Op: "scan", Kind: "zone", Input: name, Err: err}
+	}
+	s.z.loc = loc
+	s.z.applyZone()
+	return nil
+}
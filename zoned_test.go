@@ -0,0 +1,89 @@
+package chrono_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/chrono"
+)
+
+func TestZonedDateTimeValues(t *testing.T) {
+	t.Parallel()
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	z := chrono.ZonedDateTime{DateTime: chrono.NewDateTime(2024, 6, 3, 9, 0, 0, 0, paris)}
+	ts, zone, err := z.Values()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts != "2024-06-03 07:00:00+00" {
+		t.Error("timestamp wrong:", ts)
+	}
+	if zone != "Europe/Paris" {
+		t.Error("zone wrong:", zone)
+	}
+
+	for _, loc := range []*time.Location{time.Local, time.FixedZone("", 60*60), time.FixedZone("XYZ", 60*60)} {
+		z := chrono.ZonedDateTime{DateTime: chrono.NewDateTime(2024, 6, 3, 9, 0, 0, 0, loc)}
+		if _, _, err := z.Values(); !errors.Is(err, chrono.ErrNoZoneName) {
+			t.Errorf("%s: expected an error: %v", loc, err)
+		}
+	}
+}
+
+func TestZonedDateTimeScan(t *testing.T) {
+	t.Parallel()
+
+	want := time.Date(2024, 6, 3, 7, 0, 0, 0, time.UTC)
+
+	// Either order works
+	var z chrono.ZonedDateTime
+	ts, zone := z.Scanners()
+	if err := ts.Scan(want); err != nil {
+		t.Fatal(err)
+	}
+	if err := zone.Scan([]byte("Europe/Paris")); err != nil {
+		t.Fatal(err)
+	}
+	if !z.ToStdTime().Equal(want) || z.Location().String() != "Europe/Paris" || z.Hour() != 9 {
+		t.Error("value wrong:", z)
+	}
+
+	var z2 chrono.ZonedDateTime
+	ts, zone = z2.Scanners()
+	if err := zone.Scan("America/New_York"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.Scan("2024-06-03 07:00:00Z"); err != nil {
+		t.Fatal(err)
+	}
+	if !z2.ToStdTime().Equal(want) || z2.Location().String() != "America/New_York" || z2.Hour() != 3 {
+		t.Error("value wrong:", z2)
+	}
+
+	var z3 chrono.ZonedDateTime
+	ts, zone = z3.Scanners()
+	if err := zone.Scan(nil); err != nil {
+		t.Error(err)
+	}
+	if err := ts.Scan(want); err != nil {
+		t.Error(err)
+	}
+	if !z3.ToStdTime().Equal(want) || z3.Location() != time.UTC {
+		t.Error("null zone should keep the timestamp as is:", z3)
+	}
+
+	var perr *chrono.ParseError
+	if err := zone.Scan("Nowhere/Special"); !errors.As(err, &perr) {
+		t.Error("expected a parse error:", err)
+	}
+	var terr *chrono.TypeError
+	if err := zone.Scan(5); !errors.As(err, &terr) {
+		t.Error("expected a type error:", err)
+	}
+}